@@ -17,6 +17,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -40,6 +41,96 @@ type ApplicationSpec struct {
 	SourceType SourceType `json:"source_type,omitempty"`
 	// HelmTargetNamespace is the namespace in which to deploy an added Helm Chart
 	HelmTargetNamespace string `json:"helm_target_namespace,omitempty"`
+	// HelmChartVersion is the semver constraint of the versions of the Helm Chart, the latest version when empty
+	// +optional
+	HelmChartVersion string `json:"helm_chart_version,omitempty"`
+	// GitAuth is how the git repositories of the application are accessed, with deploy keys when empty
+	GitAuth GitAuthType `json:"git_auth,omitempty"`
+	// GitCredentialsSecret is the Secret holding the credentials of the https and github-app git auth.
+	// It is in the namespace of the application.
+	GitCredentialsSecret string `json:"git_credentials_secret,omitempty"`
+	// HelmValues overrides the default values of the Helm Chart
+	// +optional
+	HelmValues *apiextensionsv1.JSON `json:"helm_values,omitempty"`
+	// HelmValuesFrom references the ConfigMaps and Secrets holding values of the Helm Chart,
+	// which are merged in order before HelmValues
+	// +optional
+	HelmValuesFrom []HelmValuesReference `json:"helm_values_from,omitempty"`
+	// Kustomization holds the settings of the Kustomization of an application deployed with kustomize
+	// +optional
+	Kustomization *KustomizationSettings `json:"kustomization,omitempty"`
+	// DependsOn are the Applications of the namespace which must be ready before the application is deployed.
+	// They are deployed the same way as the application, the Kustomizations and HelmReleases being ordered by Flux.
+	// +optional
+	DependsOn []string `json:"depends_on,omitempty"`
+	// Environments are the targets of the config repository the application is deployed to,
+	// in the order the revisions and chart versions are promoted through
+	// +optional
+	Environments []string `json:"environments,omitempty"`
+}
+
+// HelmValuesReference is a ConfigMap or a Secret holding Helm values
+type HelmValuesReference struct {
+	// Kind of the values object
+	// +kubebuilder:validation:Enum=ConfigMap;Secret
+	Kind string `json:"kind"`
+	// Name of the values object, in the namespace of the application
+	Name string `json:"name"`
+	// ValuesKey is the entry of the values object holding the values, values.yaml when empty
+	// +optional
+	ValuesKey string `json:"values_key,omitempty"`
+}
+
+// KustomizationSettings are the optional settings of the Kustomization of an application
+type KustomizationSettings struct {
+	// TargetNamespace is the namespace the objects are deployed in, overriding their own namespace
+	// +optional
+	TargetNamespace string `json:"target_namespace,omitempty"`
+	// Prune deletes the objects removed from the source, true when unset
+	// +optional
+	Prune *bool `json:"prune,omitempty"`
+	// Interval is how often the source is applied, one minute when unset
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+	// HealthChecks are the objects which must be ready for the application to be ready
+	// +optional
+	HealthChecks []KustomizationHealthCheck `json:"health_checks,omitempty"`
+	// DependsOn are the Kustomizations which must be ready before the application is applied
+	// +optional
+	DependsOn []KustomizationDependency `json:"depends_on,omitempty"`
+	// Substitute holds the variables replaced in the manifests after they are built
+	// +optional
+	Substitute map[string]string `json:"substitute,omitempty"`
+	// SubstituteFrom references the ConfigMaps and Secrets holding variables, which Substitute overrides
+	// +optional
+	SubstituteFrom []SubstituteReference `json:"substitute_from,omitempty"`
+}
+
+// KustomizationHealthCheck is an object deployed by an application whose readiness is checked
+type KustomizationHealthCheck struct {
+	APIVersion string `json:"api_version,omitempty"`
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+	// Namespace of the object, the namespace of the application when empty
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// KustomizationDependency is a Kustomization an application depends on
+type KustomizationDependency struct {
+	Name string `json:"name"`
+	// Namespace of the Kustomization, the namespace of the application when empty
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// SubstituteReference is a ConfigMap or a Secret holding post build variables
+type SubstituteReference struct {
+	// Kind of the variables object
+	// +kubebuilder:validation:Enum=ConfigMap;Secret
+	Kind string `json:"kind"`
+	// Name of the variables object, in the namespace of the application
+	Name string `json:"name"`
 }
 
 // +kubebuilder:validation:Enum=helm;kustomize
@@ -58,6 +149,18 @@ const (
 	SourceTypeHelm SourceType = "helm"
 )
 
+// +kubebuilder:validation:Enum=deploy-key;https;github-app
+type GitAuthType string
+
+const (
+	// GitAuthDeployKey uses an SSH deploy key uploaded to the git provider
+	GitAuthDeployKey GitAuthType = "deploy-key"
+	// GitAuthHTTPS uses the username and password of a basic auth Secret over https
+	GitAuthHTTPS GitAuthType = "https"
+	// GitAuthGitHubApp uses installation tokens of a GitHub App over https
+	GitAuthGitHubApp GitAuthType = "github-app"
+)
+
 // SuspendAction defines the command run to pause/unpause an application
 type SuspendActionType string
 
@@ -68,15 +171,30 @@ const (
 
 const DefaultNamespace = "wego-system"
 
+const (
+	// SourceReadyCondition reflects the Ready condition of the application source
+	SourceReadyCondition string = "SourceReady"
+	// AutomationReadyCondition reflects the Ready condition of the application Kustomization or HelmRelease
+	AutomationReadyCondition string = "AutomationReady"
+)
+
 // ApplicationStatus defines the observed state of Application
 type ApplicationStatus struct {
-	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
-	// Important: Run "make" to regenerate code after modifying this file
+	// ObservedGeneration is the last generation of the Application reconciled by the controller
+	ObservedGeneration int64 `json:"observed_generation,omitempty"`
+	// Conditions holds the conditions of the Application and of the flux objects reconciling it
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// LastAppliedRevision is the revision of the source last applied by the Kustomization or HelmRelease
+	LastAppliedRevision string `json:"last_applied_revision,omitempty"`
 }
 
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 //+kubebuilder:resource:singular=app,path=apps
+//+kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+//+kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].message"
+//+kubebuilder:printcolumn:name="Revision",type="string",JSONPath=".status.last_applied_revision"
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // Application is the Schema for the applications API
 type Application struct {
@@ -9,6 +9,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/weaveworks/weave-gitops/pkg/server"
+	"github.com/weaveworks/weave-gitops/pkg/services/auth"
 )
 
 func main() {
@@ -23,10 +24,39 @@ func main() {
 
 const addr = "0.0.0.0:8000"
 
+// defaultRBACPrefix is prepended to the names and the groups of the OIDC users, as the API server does,
+// so a group such as system:masters claimed by the OIDC provider grants nothing in the cluster.
+// Setting a prefix to "-" disables it.
+const defaultRBACPrefix = "oidc:"
+
+type rbacOptions struct {
+	enabled        bool
+	usernamePrefix string
+	groupsPrefix   string
+}
+
+type oidcOptions struct {
+	issuerURL     string
+	clientID      string
+	clientSecret  string
+	redirectURL   string
+	usernameClaim string
+	groupsClaim   string
+	scopes        []string
+}
+
 func NewAPIServerCommand() *cobra.Command {
+	rbac := rbacOptions{}
+	oidc := oidcOptions{}
+
 	cmd := &cobra.Command{
-		Use:  "gitops-server",
-		Long: `The gitops-server handles HTTP requests for Weave GitOps Applications`,
+		Use: "gitops-server",
+		Long: `The gitops-server handles HTTP requests for Weave GitOps Applications.
+
+With --rbac, the names and the groups of OIDC users are checked against Kubernetes RBAC with the "oidc:" prefix,
+e.g. oidc:jane, the way the API server prefixes them. Use --rbac-username-prefix=- and --rbac-groups-prefix=-
+to check them as the OIDC provider returns them. Git provider users are checked with the name of their provider
+as prefix, e.g. github:octocat.`,
 
 		SilenceUsage: true,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -35,6 +65,25 @@ func NewAPIServerCommand() *cobra.Command {
 				return err
 			}
 
+			if rbac.enabled {
+				cfg.Authorizer = auth.NewSubjectAccessReviewAuthorizer(rbacPrefix(rbac.usernamePrefix), rbacPrefix(rbac.groupsPrefix))
+			}
+
+			if oidc.issuerURL != "" {
+				cfg.OIDC, err = auth.NewOIDCAuthenticator(context.Background(), http.DefaultClient, cfg.JwtClient, auth.OIDCConfig{
+					IssuerURL:     oidc.issuerURL,
+					ClientID:      oidc.clientID,
+					ClientSecret:  oidc.clientSecret,
+					RedirectURL:   oidc.redirectURL,
+					Scopes:        oidc.scopes,
+					UsernameClaim: oidc.usernameClaim,
+					GroupsClaim:   oidc.groupsClaim,
+				})
+				if err != nil {
+					return err
+				}
+			}
+
 			s, err := server.NewApplicationsHandler(context.Background(), cfg)
 			if err != nil {
 				return err
@@ -45,5 +94,25 @@ func NewAPIServerCommand() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().BoolVar(&rbac.enabled, "rbac", false, "Only return the objects the user of a request is allowed to see in Kubernetes RBAC")
+	cmd.Flags().StringVar(&rbac.usernamePrefix, "rbac-username-prefix", defaultRBACPrefix, "Prefix of the OIDC user names checked against Kubernetes RBAC, \"-\" to use the names as they are")
+	cmd.Flags().StringVar(&rbac.groupsPrefix, "rbac-groups-prefix", defaultRBACPrefix, "Prefix of the OIDC group names checked against Kubernetes RBAC, \"-\" to use the names as they are")
+	cmd.Flags().StringVar(&oidc.issuerURL, "oidc-issuer-url", "", "URL of the OpenID Connect provider users log in with")
+	cmd.Flags().StringVar(&oidc.clientID, "oidc-client-id", "", "Client ID of the server at the OpenID Connect provider")
+	cmd.Flags().StringVar(&oidc.clientSecret, "oidc-client-secret", "", "Client secret of the server at the OpenID Connect provider")
+	cmd.Flags().StringVar(&oidc.redirectURL, "oidc-redirect-url", "", "URL of "+server.OIDCCallbackPath+" on this server, as registered with the OpenID Connect provider")
+	cmd.Flags().StringVar(&oidc.usernameClaim, "oidc-username-claim", "sub", "ID token claim holding the name of the user")
+	cmd.Flags().StringVar(&oidc.groupsClaim, "oidc-groups-claim", "groups", "ID token claim holding the groups of the user")
+	cmd.Flags().StringSliceVar(&oidc.scopes, "oidc-scopes", []string{"profile", "email", "groups"}, "Scopes requested on top of openid")
+
 	return cmd
 }
+
+// rbacPrefix returns the prefix of a --rbac-*-prefix flag, "-" standing for no prefix
+func rbacPrefix(flag string) string {
+	if flag == "-" {
+		return ""
+	}
+
+	return flag
+}
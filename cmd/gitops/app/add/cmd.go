@@ -11,19 +11,27 @@ import (
 	"github.com/lithammer/dedent"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/version"
 	"github.com/weaveworks/weave-gitops/pkg/apputils"
 	"github.com/weaveworks/weave-gitops/pkg/git"
 	"github.com/weaveworks/weave-gitops/pkg/git/wrapper"
 	"github.com/weaveworks/weave-gitops/pkg/services/app"
+	"github.com/weaveworks/weave-gitops/pkg/services/auth"
 	"github.com/weaveworks/weave-gitops/pkg/utils"
+	"sigs.k8s.io/yaml"
 )
 
 const (
 	SSHAuthSock = "SSH_AUTH_SOCK"
 )
 
-var params app.AddParams
+var (
+	params  app.AddParams
+	gitAuth string
+	prune   bool
+	preview bool
+)
 
 var Cmd = &cobra.Command{
 	Use:   "add [--name <name>] [--url <url>] [--branch <branch>] [--path <path within repository>] [--private-key <keyfile>] <repository directory>",
@@ -38,6 +46,24 @@ var Cmd = &cobra.Command{
   # Add podinfo application to gitops control from github repository
   gitops app add --url git@github.com:myorg/podinfo
 
+  # Add podinfo application from a repository cloned over https with the credentials of the my-git-credentials Secret
+  gitops app add --url https://github.com/myorg/podinfo --git-auth https --git-credentials-secret my-git-credentials
+
+  # Add the 2.x versions of the loki helm chart
+  gitops app add --url https://charts.kube-ops.io --chart loki --chart-version "^2.0.0"
+
+  # Add loki helm chart with values from a file, a value set on the command line and values from a ConfigMap
+  gitops app add --url https://charts.kube-ops.io --chart loki --values values.yaml --set replicaCount=2 --values-from configmap/loki-values
+
+  # Add podinfo application deployed in the podinfo namespace once the infrastructure Kustomization is ready
+  gitops app add --url git@github.com:myorg/podinfo --target-namespace podinfo --depends-on infrastructure --substitute cluster_env=dev
+
+  # Add podinfo application once the redis application is ready
+  gitops app add --url git@github.com:myorg/podinfo --depends-on-app redis
+
+  # Show the files written to the repository and the objects applied to the cluster, without adding the application
+  gitops app add --url git@github.com:myorg/podinfo --preview
+
   # Get status of podinfo application
   gitops app status podinfo
 `,
@@ -56,10 +82,26 @@ func init() {
 	Cmd.Flags().StringVar(&params.Branch, "branch", "", "Branch to watch within git repository")
 	Cmd.Flags().StringVar(&params.DeploymentType, "deployment-type", app.DefaultDeploymentType, "deployment type [kustomize, helm]")
 	Cmd.Flags().StringVar(&params.Chart, "chart", "", "Specify chart for helm source")
+	Cmd.Flags().StringVar(&params.ChartVersion, "chart-version", "", "Semver constraint of the versions of the chart, e.g. 1.2.3 or ~1.2; defaults to the latest version")
 	Cmd.Flags().StringVar(&params.PrivateKey, "private-key", "", "Private key to access git repository over ssh")
 	Cmd.Flags().StringVar(&params.AppConfigUrl, "app-config-url", "", "URL of external repository (if any) which will hold automation manifests; NONE to store only in the cluster")
 	Cmd.Flags().StringVar(&params.HelmReleaseTargetNamespace, "helm-release-target-namespace", "", "Namespace in which to deploy a helm chart; defaults to the gitops installation namespace")
+	Cmd.Flags().StringVar(&gitAuth, "git-auth", string(wego.GitAuthDeployKey), "How the git repositories are accessed [deploy-key, https, github-app]")
+	Cmd.Flags().StringVar(&params.GitCredentialsSecret, "git-credentials-secret", "", "Secret holding the username and password of the https git auth, or the app id, installation id and private key of the github-app git auth")
+	Cmd.Flags().StringArrayVar(&params.ValuesFiles, "values", nil, "Values file of the helm chart; can be repeated, later files taking precedence")
+	Cmd.Flags().StringArrayVar(&params.SetValues, "set", nil, "Value of the helm chart as <key>=<value>, the key being a dotted path; can be repeated, taking precedence over --values")
+	Cmd.Flags().StringArrayVar(&params.ValuesFrom, "values-from", nil, "ConfigMap or Secret holding values of the helm chart, as <configmap|secret>/<name>[:<values key>]; can be repeated")
+	Cmd.Flags().StringArrayVar(&params.DependsOn, "depends-on-app", nil, "Application of the namespace which must be ready before this one is deployed; can be repeated")
+	Cmd.Flags().StringSliceVar(&params.Environments, "environments", nil, "Targets of the config repository the application is promoted through with 'gitops app promote', in order (e.g. dev,staging,prod)")
+	Cmd.Flags().StringVar(&params.Kustomization.TargetNamespace, "target-namespace", "", "Namespace in which to deploy the objects of a kustomize application, overriding their own namespace")
+	Cmd.Flags().BoolVar(&prune, "prune", true, "Delete the objects of a kustomize application removed from its source")
+	Cmd.Flags().DurationVar(&params.Kustomization.Interval, "interval", 0, "How often the source of a kustomize application is applied; defaults to 1m")
+	Cmd.Flags().StringArrayVar(&params.Kustomization.HealthChecks, "health-check", nil, "Object of a kustomize application whose readiness is checked, as <kind>/<name>[.<namespace>]; can be repeated")
+	Cmd.Flags().StringArrayVar(&params.Kustomization.DependsOn, "depends-on", nil, "Kustomization which must be ready before a kustomize application is applied, as <name> or <namespace>/<name>; can be repeated")
+	Cmd.Flags().StringArrayVar(&params.Kustomization.Substitute, "substitute", nil, "Variable replaced in the manifests of a kustomize application, as <variable>=<value>; can be repeated")
+	Cmd.Flags().StringArrayVar(&params.Kustomization.SubstituteFrom, "substitute-from", nil, "ConfigMap or Secret holding variables replaced in the manifests of a kustomize application, as <configmap|secret>/<name>; can be repeated")
 	Cmd.Flags().BoolVar(&params.DryRun, "dry-run", false, "If set, 'gitops app add' will not make any changes to the system; it will just display the actions that would have been taken")
+	Cmd.Flags().BoolVar(&preview, "preview", false, "If set, 'gitops app add' will not make any changes to the system; it will show the files written to the repository, with their diff against the branch, and the objects applied to the cluster")
 	Cmd.Flags().BoolVar(&params.AutoMerge, "auto-merge", false, "If set, 'gitops app add' will merge automatically into the set --branch")
 }
 
@@ -103,13 +145,24 @@ func runCmd(cmd *cobra.Command, args []string) error {
 		return readyErr
 	}
 
+	if cmd.Flags().Changed("prune") {
+		params.Kustomization.Prune = &prune
+	}
+
 	isHelmRepository := params.Chart != ""
 
-	appService, appError := apputils.GetAppServiceForAdd(ctx, params.Url, params.AppConfigUrl, params.Namespace, isHelmRepository, params.DryRun)
+	params.GitAuth = wego.GitAuthType(gitAuth)
+	creds := auth.GitCredentials{Auth: params.GitAuth, SecretName: params.GitCredentialsSecret}
+
+	appService, appError := apputils.GetAppServiceForAdd(ctx, params.Url, params.AppConfigUrl, params.Namespace, isHelmRepository, params.DryRun, creds)
 	if appError != nil {
 		return fmt.Errorf("failed to create app service: %w", appError)
 	}
 
+	if preview {
+		return printPreview(appService)
+	}
+
 	utils.SetCommmitMessageFromArgs("gitops app add", params.Url, params.Path, params.Name)
 
 	if err := appService.Add(params); err != nil {
@@ -118,3 +171,45 @@ func runCmd(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+func printPreview(appService app.AppService) error {
+	result, err := appService.PreviewAdd(params)
+	if err != nil {
+		return errors.Wrapf(err, "failed to preview the app %s", params.Name)
+	}
+
+	log := apputils.GetLogger()
+
+	if result.RepoURL != "" {
+		log.Println("Files written to %s on branch %s:\n", result.RepoURL, result.Branch)
+		log.Println("%s", result.Tree())
+
+		for _, file := range result.Files {
+			if file.Diff != "" {
+				log.Println("%s", file.Diff)
+			}
+		}
+	}
+
+	log.Println("Objects applied to the cluster:\n")
+
+	for _, obj := range result.Objects {
+		manifest, err := yaml.Marshal(obj.Object)
+		if err != nil {
+			return fmt.Errorf("could not marshal %s %s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+
+		log.Println("---\n%s", manifest)
+	}
+
+	header := []string{"Kind", "Namespace", "Name"}
+	rows := [][]string{}
+
+	for _, obj := range result.Objects {
+		rows = append(rows, []string{obj.GetKind(), obj.GetNamespace(), obj.GetName()})
+	}
+
+	utils.PrintTable(log, header, rows)
+
+	return nil
+}
@@ -8,11 +8,20 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/app/add"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/app/diff"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/app/events"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/app/get"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/app/graph"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/app/list"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/app/pause"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/app/promote"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/app/remove"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/app/rotatekeys"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/app/status"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/app/sync"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/app/unpause"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/app/update"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/app/upgrade"
 	"github.com/weaveworks/weave-gitops/pkg/apputils"
 	"github.com/weaveworks/weave-gitops/pkg/logger"
 	"github.com/weaveworks/weave-gitops/pkg/services/app"
@@ -30,6 +39,15 @@ var ApplicationCmd = &cobra.Command{
   # Add an application to gitops from local git repository
   gitops app add . --name <app-name>
 
+  # Change the branch watched by an application
+  gitops app update <app-name> --branch <branch>
+
+  # List the chart versions of a helm application and upgrade it through a pull request
+  gitops app upgrade <app-name> --to <version>
+
+  # Open a pull request deploying to prod what staging deploys
+  gitops app promote <app-name> --from staging --to prod
+
   # Remove an application from gitops
   gitops app remove <app-name>
 
@@ -39,11 +57,32 @@ var ApplicationCmd = &cobra.Command{
   # List applications under gitops control
   gitops app list
 
+  # Show the dependencies between applications in the order they are deployed
+  gitops app graph
+
+  # Show the objects of an application changed in the cluster since it was deployed
+  gitops app diff <app-name>
+
+  # Show an application as YAML
+  gitops app get <app-name> -o yaml
+
+  # Show the warning events of an application
+  gitops app events <app-name> --type Warning
+
   # Pause gitops automation
   gitops app pause <app-name>
 
   # Unpause gitops automation
-  gitops app unpause <app-name>`,
+  gitops app unpause <app-name>
+
+  # Pause gitops automation of the applications matching a label selector
+  gitops app pause -l team=payments --reason <reason>
+
+  # Reconcile an application now and wait for the new revision
+  gitops app sync <app-name> --wait
+
+  # Replace the deploy keys of every application
+  gitops app rotate-keys --all`,
 	Args: cobra.MinimumNArgs(3),
 	RunE: runCmd,
 }
@@ -52,9 +91,18 @@ func init() {
 	ApplicationCmd.AddCommand(add.Cmd)
 	ApplicationCmd.AddCommand(remove.Cmd)
 	ApplicationCmd.AddCommand(list.Cmd)
+	ApplicationCmd.AddCommand(get.Cmd)
 	ApplicationCmd.AddCommand(status.Cmd)
 	ApplicationCmd.AddCommand(pause.Cmd)
 	ApplicationCmd.AddCommand(unpause.Cmd)
+	ApplicationCmd.AddCommand(update.Cmd)
+	ApplicationCmd.AddCommand(events.Cmd)
+	ApplicationCmd.AddCommand(sync.Cmd)
+	ApplicationCmd.AddCommand(rotatekeys.Cmd)
+	ApplicationCmd.AddCommand(upgrade.Cmd)
+	ApplicationCmd.AddCommand(graph.Cmd)
+	ApplicationCmd.AddCommand(promote.Cmd)
+	ApplicationCmd.AddCommand(diff.Cmd)
 }
 
 func runCmd(cmd *cobra.Command, args []string) error {
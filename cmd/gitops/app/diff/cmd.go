@@ -0,0 +1,82 @@
+package diff
+
+// Provides support for comparing the objects of an application with its source.
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/lithammer/dedent"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/pkg/apputils"
+	"github.com/weaveworks/weave-gitops/pkg/services/app"
+	"github.com/weaveworks/weave-gitops/pkg/utils"
+)
+
+var params app.DiffParams
+
+var Cmd = &cobra.Command{
+	Use:   "diff <app name>",
+	Short: "Show the drift between an app and its source",
+	Long: strings.TrimSpace(dedent.Dedent(`
+        Renders the manifests of the source of an application the way Flux does, building its kustomization or running helm template,
+        and compares them with the objects of the cluster. Objects changed in the cluster, missing from the cluster or
+        no longer in the source are listed, with a unified diff of the fields set by the source.
+        The helm binary must be in the PATH to diff helm applications.
+    `)),
+	Example: `
+  # Show the objects of the podinfo application changed since it was deployed
+  gitops app diff podinfo
+`,
+	Args:          cobra.ExactArgs(1),
+	RunE:          runCmd,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+}
+
+func runCmd(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	params.Name = args[0]
+	params.Namespace, _ = cmd.Parent().Flags().GetString("namespace")
+
+	appService, appError := apputils.GetAppService(ctx, params.Name, params.Namespace)
+	if appError != nil {
+		return fmt.Errorf("failed to create app service: %w", appError)
+	}
+
+	result, err := appService.Diff(params)
+	if err != nil {
+		return errors.Wrapf(err, "failed to diff the app %s", params.Name)
+	}
+
+	log := apputils.GetLogger()
+
+	for _, obj := range result.Objects {
+		if obj.Diff != "" {
+			log.Println("%s", obj.Diff)
+		}
+	}
+
+	header := []string{"Kind", "Namespace", "Name", "Status"}
+	rows := [][]string{}
+
+	for _, obj := range result.Objects {
+		rows = append(rows, []string{obj.GroupVersionKind.Kind, obj.Namespace, obj.Name, string(obj.Status)})
+	}
+
+	utils.PrintTable(log, header, rows)
+
+	summary := result.Summary()
+	if !summary.Drifted() {
+		log.Successf("App %s is in sync with its source", params.Name)
+		return nil
+	}
+
+	log.Warningf("App %s drifted from its source: %d modified, %d missing, %d extra, %d in sync",
+		params.Name, summary.Modified, summary.Missing, summary.Extra, summary.InSync)
+
+	return nil
+}
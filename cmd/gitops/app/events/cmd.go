@@ -0,0 +1,124 @@
+package events
+
+// Provides support for showing the event history of an application.
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lithammer/dedent"
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/pkg/apputils"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/services/app"
+	"github.com/weaveworks/weave-gitops/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var (
+	eventType string
+	since     string
+	until     string
+)
+
+var Cmd = &cobra.Command{
+	Use:   "events <app-name>",
+	Short: "Show the events of an application",
+	Long: strings.TrimSpace(dedent.Dedent(`
+        Shows the Kubernetes events of an application, of its Flux source and automation objects
+        and of the objects they reconcile, oldest first
+    `)),
+	Example: `
+  # Show the events of the podinfo application
+  gitops app events podinfo
+
+  # Show the warnings of the last hour
+  gitops app events podinfo --type Warning --since 1h
+
+  # Show the events of a given day
+  gitops app events podinfo --since 2021-07-01T00:00:00Z --until 2021-07-02T00:00:00Z
+`,
+	Args:          cobra.ExactArgs(1),
+	RunE:          runCmd,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+}
+
+func init() {
+	Cmd.Flags().StringVar(&eventType, "type", "", "Only show the events of this type: Normal or Warning")
+	Cmd.Flags().StringVar(&since, "since", "", "Only show the events newer than a relative duration like 1h, or than an RFC3339 time")
+	Cmd.Flags().StringVar(&until, "until", "", "Only show the events older than a relative duration like 30m, or than an RFC3339 time")
+}
+
+func runCmd(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	params := app.EventsParams{
+		Name: args[0],
+		Type: eventType,
+	}
+	params.Namespace, _ = cmd.Parent().Parent().Flags().GetString("namespace")
+
+	now := time.Now()
+
+	var err error
+
+	if params.Since, err = parseTime(since, now); err != nil {
+		return fmt.Errorf("invalid --since: %w", err)
+	}
+
+	if params.Until, err = parseTime(until, now); err != nil {
+		return fmt.Errorf("invalid --until: %w", err)
+	}
+
+	kubeClient, _, err := kube.NewKubeHTTPClient()
+	if err != nil {
+		return fmt.Errorf("error initializing kubernetes client: %w", err)
+	}
+
+	events, err := app.ListEvents(ctx, kubeClient, params)
+	if err != nil {
+		return fmt.Errorf("failed getting application events: %w", err)
+	}
+
+	printEventTable(events, now)
+
+	return nil
+}
+
+// parseTime reads a time given as a duration before now or as an RFC3339 time. An empty value is the zero time.
+func parseTime(value string, now time.Time) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	if d, err := time.ParseDuration(value); err == nil {
+		return now.Add(-d), nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q is neither a duration nor an RFC3339 time", value)
+	}
+
+	return t, nil
+}
+
+func printEventTable(events []corev1.Event, now time.Time) {
+	header := []string{"Last Seen", "Type", "Reason", "Object", "Message"}
+	rows := [][]string{}
+
+	for _, e := range events {
+		rows = append(rows, []string{
+			now.Sub(app.EventTime(e)).Round(time.Second).String(),
+			e.Type,
+			e.Reason,
+			fmt.Sprintf("%s/%s", strings.ToLower(e.InvolvedObject.Kind), e.InvolvedObject.Name),
+			strings.TrimSpace(e.Message),
+		})
+	}
+
+	utils.PrintTable(apputils.GetLogger(), header, rows)
+}
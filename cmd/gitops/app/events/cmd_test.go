@@ -0,0 +1,27 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTime(t *testing.T) {
+	now := time.Date(2021, time.July, 1, 12, 0, 0, 0, time.UTC)
+
+	since, err := parseTime("1h", now)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2021, time.July, 1, 11, 0, 0, 0, time.UTC), since)
+
+	until, err := parseTime("2021-06-30T08:00:00Z", now)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2021, time.June, 30, 8, 0, 0, 0, time.UTC), until)
+
+	empty, err := parseTime("", now)
+	assert.NoError(t, err)
+	assert.True(t, empty.IsZero())
+
+	_, err = parseTime("yesterday", now)
+	assert.Error(t, err)
+}
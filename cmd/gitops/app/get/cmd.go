@@ -0,0 +1,59 @@
+package get
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/pkg/apputils"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/services/app"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var output string
+
+var Cmd = &cobra.Command{
+	Use:   "get <app-name>",
+	Short: "Display an application under wego control",
+	Args:  cobra.ExactArgs(1),
+	Example: `
+  # Show the podinfo application
+  gitops app get podinfo
+
+  # Show the podinfo application as JSON
+  gitops app get podinfo -o json
+`,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE:          runCmd,
+}
+
+func init() {
+	apputils.AddOutputFlag(Cmd, &output)
+}
+
+func runCmd(cmd *cobra.Command, args []string) error {
+	format, err := apputils.ParseOutputFormat(output)
+	if err != nil {
+		return err
+	}
+
+	kubeClient, _, err := kube.NewKubeHTTPClient()
+	if err != nil {
+		return fmt.Errorf("error initializing kubernetes client: %w", err)
+	}
+
+	ns, err := cmd.Parent().Parent().Flags().GetString("namespace")
+	if err != nil {
+		return err
+	}
+
+	info, err := app.GetAppInfo(context.Background(), kubeClient, types.NamespacedName{Name: args[0], Namespace: ns})
+	if err != nil {
+		return err
+	}
+
+	return apputils.PrintAppInfo(os.Stdout, format, *info)
+}
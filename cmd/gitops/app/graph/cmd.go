@@ -0,0 +1,101 @@
+package graph
+
+// Provides support for displaying the dependencies between applications.
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/pkg/apputils"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/services/app"
+	"github.com/weaveworks/weave-gitops/pkg/utils"
+)
+
+// The formats the graph is rendered in
+const (
+	outputText = "text"
+	outputDOT  = "dot"
+)
+
+var output string
+
+var Cmd = &cobra.Command{
+	Use:   "graph [-o text|dot]",
+	Short: "Display the dependencies between applications",
+	Example: `
+  # List the applications in the order they are deployed, with their dependencies
+  gitops app graph
+
+  # Render the dependency graph as an image with Graphviz
+  gitops app graph -o dot | dot -Tsvg > apps.svg
+`,
+	Args:          cobra.NoArgs,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE:          runCmd,
+}
+
+func init() {
+	Cmd.Flags().StringVarP(&output, "output", "o", outputText, "Output format [text, dot]")
+}
+
+func runCmd(cmd *cobra.Command, args []string) error {
+	if output != outputText && output != outputDOT {
+		return fmt.Errorf("unknown output format %q, can be %s or %s", output, outputText, outputDOT)
+	}
+
+	kubeClient, _, err := kube.NewKubeHTTPClient()
+	if err != nil {
+		return fmt.Errorf("error initializing kubernetes client: %w", err)
+	}
+
+	ns, err := cmd.Parent().Parent().Flags().GetString("namespace")
+	if err != nil {
+		return err
+	}
+
+	graph, err := app.GetDependencyGraph(context.Background(), kubeClient, ns)
+	if err != nil {
+		return err
+	}
+
+	if output == outputDOT {
+		fmt.Fprint(os.Stdout, graph.DOT())
+		return nil
+	}
+
+	order, err := graph.Order()
+	if err != nil {
+		return err
+	}
+
+	missing := map[string]bool{}
+	for _, name := range graph.Missing() {
+		missing[name] = true
+	}
+
+	header := []string{"Application", "Depends On"}
+	rows := [][]string{}
+
+	for _, name := range order {
+		deps := []string{}
+
+		for _, dep := range graph[name] {
+			if missing[dep] {
+				dep += " (missing)"
+			}
+
+			deps = append(deps, dep)
+		}
+
+		rows = append(rows, []string{name, strings.Join(deps, ", ")})
+	}
+
+	utils.PrintTable(apputils.GetLogger(), header, rows)
+
+	return nil
+}
@@ -3,19 +3,42 @@ package list
 import (
 	"context"
 	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/pkg/apputils"
 	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/services/app"
 )
 
+var output string
+
 var Cmd = &cobra.Command{
-	Use:     "list",
-	Short:   "List applications under wego control",
-	Example: "wego app list",
-	RunE:    runCmd,
+	Use:   "list",
+	Short: "List applications under wego control",
+	Example: `
+  # List the applications
+  gitops app list
+
+  # List the applications with their source and the message of their Ready condition
+  gitops app list -o wide
+
+  # List the applications as JSON
+  gitops app list -o json
+`,
+	RunE: runCmd,
+}
+
+func init() {
+	apputils.AddOutputFlag(Cmd, &output)
 }
 
 func runCmd(cmd *cobra.Command, args []string) error {
+	format, err := apputils.ParseOutputFormat(output)
+	if err != nil {
+		return err
+	}
+
 	kubeClient, _, err := kube.NewKubeHTTPClient()
 	if err != nil {
 		return fmt.Errorf("error initializing kubernetes client: %w", err)
@@ -26,16 +49,10 @@ func runCmd(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	apps, err := kubeClient.GetApplications(context.Background(), ns)
+	infos, err := app.ListAppInfo(context.Background(), kubeClient, ns)
 	if err != nil {
 		return err
 	}
 
-	fmt.Println("NAME")
-
-	for _, app := range apps {
-		fmt.Println(app.Name)
-	}
-
-	return nil
+	return apputils.PrintAppInfoList(os.Stdout, format, infos)
 }
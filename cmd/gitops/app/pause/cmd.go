@@ -3,21 +3,47 @@ package pause
 import (
 	"context"
 	"fmt"
+	"os"
+	"os/user"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/version"
 	"github.com/weaveworks/weave-gitops/pkg/apputils"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
 	"github.com/weaveworks/weave-gitops/pkg/services/app"
 )
 
-var params app.PauseParams
+var params app.PauseAppsParams
 
 var Cmd = &cobra.Command{
-	Use:           "pause <app-name>",
-	Short:         "Pause an application",
-	Args:          cobra.MinimumNArgs(1),
-	Example:       "gitops app pause podinfo",
+	Use:   "pause <app-name> | --all | --selector <label selector>",
+	Short: "Pause an application",
+	Args: func(cmd *cobra.Command, args []string) error {
+		if params.All || params.LabelSelector != "" {
+			if len(args) > 0 {
+				return fmt.Errorf("an app name cannot be given with --all or --selector")
+			}
+
+			return nil
+		}
+
+		return cobra.ExactArgs(1)(cmd, args)
+	},
+	Example: `
+  # Pause the podinfo application
+  gitops app pause podinfo
+
+  # Pause the podinfo application, recording why
+  gitops app pause podinfo --reason "database migration"
+
+  # Pause every application of the namespace, recording why
+  gitops app pause --all --reason "incident 42"
+
+  # Pause the applications of the payments team
+  gitops app pause -l team=payments
+`,
 	RunE:          runCmd,
 	SilenceUsage:  true,
 	SilenceErrors: true,
@@ -26,20 +52,42 @@ var Cmd = &cobra.Command{
 	},
 }
 
+func init() {
+	Cmd.Flags().BoolVar(&params.All, "all", false, "Pause every application of the namespace")
+	Cmd.Flags().StringVarP(&params.LabelSelector, "selector", "l", "", "Pause the applications of the namespace matching the label selector, e.g. team=payments")
+	Cmd.Flags().StringVar(&params.Reason, "reason", "", "Why the applications are paused, recorded in an annotation of their automation")
+}
+
 func runCmd(cmd *cobra.Command, args []string) error {
 	ctx := context.Background()
 
 	params.Namespace, _ = cmd.Parent().Flags().GetString("namespace")
-	params.Name = args[0]
 
-	appService, appError := apputils.GetAppService(ctx, params.Name, params.Namespace)
-	if appError != nil {
-		return fmt.Errorf("failed to create app service: %w", appError)
+	if len(args) > 0 {
+		params.Name = args[0]
 	}
 
-	if err := appService.Pause(params); err != nil {
-		return errors.Wrapf(err, "failed to pause the app %s", params.Name)
+	kubeClient, _, err := kube.NewKubeHTTPClient()
+	if err != nil {
+		return fmt.Errorf("error initializing kubernetes client: %w", err)
+	}
+
+	params.PausedBy = currentUser()
+
+	results, err := app.SuspendApplications(ctx, kubeClient, wego.SuspendAction, params)
+	if err != nil {
+		return errors.Wrap(err, "failed to pause the apps")
+	}
+
+	return apputils.PrintPauseResults(os.Stdout, results, "paused")
+}
+
+// currentUser returns the name of the user running the command, recorded as the one pausing the applications
+func currentUser() string {
+	u, err := user.Current()
+	if err != nil {
+		return ""
 	}
 
-	return nil
+	return u.Username
 }
@@ -0,0 +1,69 @@
+package promote
+
+// Provides support for promoting an application from an environment to the next one.
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/lithammer/dedent"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/version"
+	"github.com/weaveworks/weave-gitops/pkg/apputils"
+	"github.com/weaveworks/weave-gitops/pkg/services/app"
+	"github.com/weaveworks/weave-gitops/pkg/utils"
+)
+
+var params app.PromoteParams
+
+var Cmd = &cobra.Command{
+	Use:   "promote <app name> --from <environment> --to <environment>",
+	Short: "Promote an app to the next environment",
+	Long: strings.TrimSpace(dedent.Dedent(`
+        Opens a pull request to the config repository deploying to an environment of an application the git revision
+        or the chart version deployed to the previous environment. The environments are the targets of the config
+        repository set with --environments when adding or updating the application, in the order they are promoted through.
+    `)),
+	Example: `
+  # Deploy to prod what staging deploys for the podinfo application
+  gitops app promote podinfo --from staging --to prod
+`,
+	Args:          cobra.ExactArgs(1),
+	RunE:          runCmd,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	PostRun: func(cmd *cobra.Command, args []string) {
+		version.CheckVersion(version.CheckpointParamsWithFlags(version.CheckpointParams(), cmd))
+	},
+}
+
+func init() {
+	Cmd.Flags().StringVar(&params.From, "from", "", "Environment whose revision is promoted")
+	Cmd.Flags().StringVar(&params.To, "to", "", "Environment the revision is promoted to, following --from in the environments of the application")
+}
+
+func runCmd(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	params.Name = args[0]
+	params.Namespace, _ = cmd.Parent().Flags().GetString("namespace")
+
+	if params.From == "" || params.To == "" {
+		return fmt.Errorf("--from and --to must be specified")
+	}
+
+	appService, appError := apputils.GetAppService(ctx, params.Name, params.Namespace)
+	if appError != nil {
+		return fmt.Errorf("failed to create app service: %w", appError)
+	}
+
+	utils.SetCommmitMessage(fmt.Sprintf("gitops app promote %s from %s to %s", params.Name, params.From, params.To))
+
+	if err := appService.Promote(params); err != nil {
+		return errors.Wrapf(err, "failed to promote the app %s", params.Name)
+	}
+
+	return nil
+}
@@ -3,8 +3,10 @@ package remove
 // Provides support for removing an application from gitops management.
 
 import (
+	"bufio"
 	"context"
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/lithammer/dedent"
@@ -16,17 +18,29 @@ import (
 	"github.com/weaveworks/weave-gitops/pkg/utils"
 )
 
-var params app.RemoveParams
+var (
+	params app.RemoveParams
+	yes    bool
+)
 
 var Cmd = &cobra.Command{
 	Use:   "remove [--private-key <keyfile>] <app name>",
 	Short: "Remove an app from a gitops cluster",
 	Long: strings.TrimSpace(dedent.Dedent(`
-        Removes an application from a gitops cluster so it will no longer be managed via GitOps
+        Removes an application from a gitops cluster so it will no longer be managed via GitOps.
+        Flux then deletes the objects of the application from the cluster; they are listed for confirmation
+        and the removal waits until they are deleted. With --keep-workloads the automation of the application
+        is suspended first, so Flux leaves its objects running.
     `)),
 	Example: `
   # Remove application from gitops control via immediate commit
   gitops app remove podinfo
+
+  # Remove application from gitops control, leaving its workloads running in the cluster
+  gitops app remove podinfo --keep-workloads
+
+  # Remove application without confirmation, waiting up to 10 minutes for its objects to be deleted
+  gitops app remove podinfo --yes --timeout 10m
 `,
 	Args:          cobra.MinimumNArgs(1),
 	RunE:          runCmd,
@@ -40,6 +54,9 @@ var Cmd = &cobra.Command{
 func init() {
 	Cmd.Flags().StringVar(&params.PrivateKey, "private-key", "", "Private key to access git repository over ssh")
 	Cmd.Flags().BoolVar(&params.DryRun, "dry-run", false, "If set, 'gitops app remove' will not make any changes to the system; it will just display the actions that would have been taken")
+	Cmd.Flags().BoolVar(&params.KeepWorkloads, "keep-workloads", false, "Suspend the automation of the app before removing it, so Flux does not delete the objects it applied")
+	Cmd.Flags().BoolVar(&yes, "yes", false, "Remove the app without asking for confirmation of the objects deleted")
+	Cmd.Flags().DurationVar(&params.Timeout, "timeout", app.DefaultRemoveTimeout, "How long to wait for the objects of the app to be deleted")
 }
 
 func runCmd(cmd *cobra.Command, args []string) error {
@@ -53,6 +70,17 @@ func runCmd(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to create app service: %w", appError)
 	}
 
+	if !yes && !params.DryRun {
+		confirmed, err := confirmRemoval(appService, cmd.InOrStdin())
+		if err != nil {
+			return err
+		}
+
+		if !confirmed {
+			return fmt.Errorf("removal of the app %s was not confirmed", params.Name)
+		}
+	}
+
 	utils.SetCommmitMessage(fmt.Sprintf("gitops app remove %s", params.Name))
 
 	if err := appService.Remove(params); err != nil {
@@ -61,3 +89,39 @@ func runCmd(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+// confirmRemoval lists the objects Flux deletes with the app and asks for confirmation
+func confirmRemoval(appService app.AppService, in io.Reader) (bool, error) {
+	objects, err := appService.GarbageCollectedObjects(params)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to list the objects of the app %s", params.Name)
+	}
+
+	log := apputils.GetLogger()
+
+	if len(objects) == 0 {
+		log.Println("No objects will be deleted from the cluster")
+	} else {
+		log.Println("The following objects will be deleted from the cluster:\n")
+
+		header := []string{"Kind", "Namespace", "Name"}
+		rows := [][]string{}
+
+		for _, obj := range objects {
+			rows = append(rows, []string{obj.GetKind(), obj.GetNamespace(), obj.GetName()})
+		}
+
+		utils.PrintTable(log, header, rows)
+	}
+
+	log.Printf("Remove the app %s? [y/N] ", params.Name)
+
+	answer, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return false, fmt.Errorf("could not read confirmation: %w", err)
+	}
+
+	answer = strings.ToLower(strings.TrimSpace(answer))
+
+	return answer == "y" || answer == "yes", nil
+}
@@ -0,0 +1,103 @@
+package rotatekeys
+
+// Provides support for replacing the deploy keys of applications.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/lithammer/dedent"
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/version"
+	"github.com/weaveworks/weave-gitops/pkg/apputils"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/services/app"
+)
+
+var (
+	params app.RotateKeysParams
+	all    bool
+)
+
+var Cmd = &cobra.Command{
+	Use:   "rotate-keys [<app-name> | --all] [--timeout <duration>]",
+	Short: "Replace the deploy keys of applications",
+	Long: strings.TrimSpace(dedent.Dedent(`
+        Generates a new deploy key for the repositories of an application, uploads it to the git provider
+        and stores it in the flux git secret. The old key is deleted from the git provider once the sources
+        of the application cloned their repository with the new key.
+    `)),
+	Example: `
+  # Rotate the deploy keys of the podinfo application
+  gitops app rotate-keys podinfo
+
+  # Rotate the deploy keys of every application
+  gitops app rotate-keys --all
+`,
+	Args:          validateArgs,
+	RunE:          runCmd,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	PostRun: func(cmd *cobra.Command, args []string) {
+		version.CheckVersion(version.CheckpointParamsWithFlags(version.CheckpointParams(), cmd))
+	},
+}
+
+func init() {
+	Cmd.Flags().BoolVar(&all, "all", false, "Rotate the deploy keys of every application of the namespace")
+	Cmd.Flags().DurationVar(&params.Timeout, "timeout", app.DefaultSyncTimeout, "How long to wait for each source to reconcile with its new key")
+}
+
+func validateArgs(cmd *cobra.Command, args []string) error {
+	switch {
+	case all && len(args) > 0:
+		return errors.New("an application name cannot be given with --all")
+	case !all && len(args) != 1:
+		return errors.New("an application name or --all is required")
+	}
+
+	return nil
+}
+
+func runCmd(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	params.Namespace, _ = cmd.Parent().Flags().GetString("namespace")
+	params.Rotated = map[string]bool{}
+
+	names := args
+
+	if all {
+		kubeClient, _, err := kube.NewKubeHTTPClient()
+		if err != nil {
+			return fmt.Errorf("error initializing kubernetes client: %w", err)
+		}
+
+		apps, err := kubeClient.GetApplications(ctx, params.Namespace)
+		if err != nil {
+			return fmt.Errorf("could not list applications: %w", err)
+		}
+
+		for _, a := range apps {
+			names = append(names, a.Name)
+		}
+	}
+
+	for _, name := range names {
+		params.Name = name
+
+		// Each app gets the git provider of its repository
+		appService, appError := apputils.GetAppService(ctx, params.Name, params.Namespace)
+		if appError != nil {
+			return fmt.Errorf("failed to create app service: %w", appError)
+		}
+
+		if err := appService.RotateKeys(params); err != nil {
+			return fmt.Errorf("failed to rotate the deploy keys of the app %s: %w", params.Name, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package rotatekeys
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostRunDefined(t *testing.T) {
+	assert.NotNil(t, Cmd.PostRun, "PostRun should be defined")
+}
+
+func TestValidateArgs(t *testing.T) {
+	defer func() { all = false }()
+
+	assert.NoError(t, validateArgs(Cmd, []string{"podinfo"}))
+	assert.Error(t, validateArgs(Cmd, []string{}))
+
+	all = true
+	assert.NoError(t, validateArgs(Cmd, []string{}))
+	assert.Error(t, validateArgs(Cmd, []string{"podinfo"}))
+}
@@ -3,22 +3,37 @@ package status
 import (
 	"context"
 	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
 	"github.com/weaveworks/weave-gitops/pkg/apputils"
 	"github.com/weaveworks/weave-gitops/pkg/services/app"
+	"k8s.io/apimachinery/pkg/types"
 )
 
+var output string
+
 var Cmd = &cobra.Command{
 	Use:           "status <app-name>",
 	Short:         "Get status of a workload under wego control",
 	Args:          cobra.MinimumNArgs(1),
 	SilenceUsage:  true,
 	SilenceErrors: true,
-	Example:       "wego app status podinfo",
+	Example: `
+  # Show the status of the podinfo application and of its flux objects
+  gitops app status podinfo
+
+  # Show the status of the podinfo application as YAML
+  gitops app status podinfo -o yaml
+`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		ctx := context.Background()
 
+		format, err := apputils.ParseOutputFormat(output)
+		if err != nil {
+			return err
+		}
+
 		params := app.StatusParams{}
 
 		params.Name = args[0]
@@ -29,15 +44,33 @@ var Cmd = &cobra.Command{
 			return fmt.Errorf("failed to create app service: %w", appError)
 		}
 
+		info, err := appService.GetInfo(types.NamespacedName{Name: params.Name, Namespace: params.Namespace})
+		if err != nil {
+			return fmt.Errorf("failed getting application status: %w", err)
+		}
+
+		if format == apputils.OutputJSON || format == apputils.OutputYAML {
+			return apputils.PrintObject(os.Stdout, format, info)
+		}
+
 		fluxOutput, lastSuccessReconciliation, err := appService.Status(params)
 		if err != nil {
 			return fmt.Errorf("failed getting application status: %w", err)
 		}
 
 		logger := apputils.GetLogger()
-		logger.Printf("Last successful reconciliation: %s\n\n", lastSuccessReconciliation)
+
+		if err := apputils.PrintAppInfo(logger, format, *info); err != nil {
+			return err
+		}
+
+		logger.Printf("\nLast successful reconciliation: %s\n\n", lastSuccessReconciliation)
 		logger.Println(fluxOutput)
 
 		return nil
 	},
 }
+
+func init() {
+	apputils.AddOutputFlag(Cmd, &output)
+}
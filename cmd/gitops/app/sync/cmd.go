@@ -0,0 +1,80 @@
+package sync
+
+// Provides support for triggering the reconciliation of an application.
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/lithammer/dedent"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/version"
+	"github.com/weaveworks/weave-gitops/pkg/apputils"
+	"github.com/weaveworks/weave-gitops/pkg/logger"
+	"github.com/weaveworks/weave-gitops/pkg/services/app"
+)
+
+var params app.SyncParams
+
+var Cmd = &cobra.Command{
+	Use:   "sync <app-name> [--wait] [--timeout <duration>]",
+	Short: "Trigger the reconciliation of an application",
+	Long: strings.TrimSpace(dedent.Dedent(`
+        Asks Flux to reconcile the source of an application, then its Kustomization or HelmRelease,
+        without waiting for the next sync interval
+    `)),
+	Example: `
+  # Sync the podinfo application
+  gitops app sync podinfo
+
+  # Sync the podinfo application and wait until the new revision is ready
+  gitops app sync podinfo --wait --timeout 2m
+`,
+	Args:          cobra.ExactArgs(1),
+	RunE:          runCmd,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	PostRun: func(cmd *cobra.Command, args []string) {
+		version.CheckVersion(version.CheckpointParamsWithFlags(version.CheckpointParams(), cmd))
+	},
+}
+
+func init() {
+	Cmd.Flags().BoolVar(&params.Wait, "wait", false, "Wait until the source and the automation of the app are ready")
+	Cmd.Flags().DurationVar(&params.Timeout, "timeout", app.DefaultSyncTimeout, "How long to wait for the sync")
+}
+
+func runCmd(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	params.Namespace, _ = cmd.Parent().Flags().GetString("namespace")
+	params.Name = args[0]
+
+	appService, appError := apputils.GetAppService(ctx, params.Name, params.Namespace)
+	if appError != nil {
+		return fmt.Errorf("failed to create app service: %w", appError)
+	}
+
+	result, err := appService.Sync(params)
+	if err != nil {
+		return errors.Wrapf(err, "failed to sync the app %s", params.Name)
+	}
+
+	if params.Wait {
+		printRevision(apputils.GetLogger(), "Source revision", result.PreviousSourceRevision, result.SourceRevision)
+		printRevision(apputils.GetLogger(), "Applied revision", result.PreviousAppliedRevision, result.AppliedRevision)
+	}
+
+	return nil
+}
+
+func printRevision(log logger.Logger, label, previous, current string) {
+	if previous == current {
+		log.Println("%s: %s (unchanged)", label, current)
+		return
+	}
+
+	log.Println("%s: %s -> %s", label, previous, current)
+}
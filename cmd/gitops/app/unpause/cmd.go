@@ -3,21 +3,43 @@ package unpause
 import (
 	"context"
 	"fmt"
+	"os"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/version"
 	"github.com/weaveworks/weave-gitops/pkg/apputils"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
 	"github.com/weaveworks/weave-gitops/pkg/services/app"
 )
 
-var params app.UnpauseParams
+var params app.PauseAppsParams
 
 var Cmd = &cobra.Command{
-	Use:           "unpause <app-name>",
-	Short:         "Unpause an application",
-	Args:          cobra.MinimumNArgs(1),
-	Example:       "gitops app unpause podinfo",
+	Use:   "unpause <app-name> | --all | --selector <label selector>",
+	Short: "Unpause an application",
+	Args: func(cmd *cobra.Command, args []string) error {
+		if params.All || params.LabelSelector != "" {
+			if len(args) > 0 {
+				return fmt.Errorf("an app name cannot be given with --all or --selector")
+			}
+
+			return nil
+		}
+
+		return cobra.ExactArgs(1)(cmd, args)
+	},
+	Example: `
+  # Unpause the podinfo application
+  gitops app unpause podinfo
+
+  # Unpause every application of the namespace
+  gitops app unpause --all
+
+  # Unpause the applications of the payments team
+  gitops app unpause -l team=payments
+`,
 	RunE:          runCmd,
 	SilenceUsage:  true,
 	SilenceErrors: true,
@@ -26,20 +48,29 @@ var Cmd = &cobra.Command{
 	},
 }
 
+func init() {
+	Cmd.Flags().BoolVar(&params.All, "all", false, "Unpause every application of the namespace")
+	Cmd.Flags().StringVarP(&params.LabelSelector, "selector", "l", "", "Unpause the applications of the namespace matching the label selector, e.g. team=payments")
+}
+
 func runCmd(cmd *cobra.Command, args []string) error {
 	ctx := context.Background()
 
 	params.Namespace, _ = cmd.Parent().Flags().GetString("namespace")
-	params.Name = args[0]
 
-	appService, appError := apputils.GetAppService(ctx, params.Name, params.Namespace)
-	if appError != nil {
-		return fmt.Errorf("failed to create app service: %w", appError)
+	if len(args) > 0 {
+		params.Name = args[0]
+	}
+
+	kubeClient, _, err := kube.NewKubeHTTPClient()
+	if err != nil {
+		return fmt.Errorf("error initializing kubernetes client: %w", err)
 	}
 
-	if err := appService.Unpause(params); err != nil {
-		return errors.Wrapf(err, "failed to unpause the app %s", params.Name)
+	results, err := app.SuspendApplications(ctx, kubeClient, wego.ResumeAction, params)
+	if err != nil {
+		return errors.Wrap(err, "failed to unpause the apps")
 	}
 
-	return nil
+	return apputils.PrintPauseResults(os.Stdout, results, "unpaused")
 }
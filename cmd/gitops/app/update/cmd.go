@@ -0,0 +1,125 @@
+package update
+
+// Provides support for changing an application under gitops management.
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/lithammer/dedent"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/version"
+	"github.com/weaveworks/weave-gitops/pkg/apputils"
+	"github.com/weaveworks/weave-gitops/pkg/services/app"
+	"github.com/weaveworks/weave-gitops/pkg/utils"
+)
+
+var (
+	params app.UpdateParams
+	prune  bool
+)
+
+var Cmd = &cobra.Command{
+	Use:   "update <app name> [--branch <branch>] [--path <path within repository>] [--chart <chart>] [--helm-release-target-namespace <namespace>] [--values <file>] [--set <key>=<value>] [--values-from <kind>/<name>]",
+	Short: "Update an app in a gitops cluster",
+	Long: strings.TrimSpace(dedent.Dedent(`
+        Changes the source or deployment settings of an application and regenerates its GitOps automation
+    `)),
+	Example: `
+  # Watch a different branch of the podinfo repository
+  gitops app update podinfo --branch develop
+
+  # Change a value of the loki helm chart through a pull request to the config repository
+  gitops app update loki --set replicaCount=3
+
+  # Check the podinfo deployment and stop pruning the objects removed from the repository
+  gitops app update podinfo --health-check Deployment/podinfo.podinfo --prune=false
+
+  # Stop waiting for other applications before deploying podinfo
+  gitops app update podinfo --depends-on-app ""
+
+  # Deploy another chart from the same helm repository and merge the change straight away
+  gitops app update my-chart --chart other-chart --auto-merge
+`,
+	Args:          cobra.ExactArgs(1),
+	RunE:          runCmd,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	PostRun: func(cmd *cobra.Command, args []string) {
+		version.CheckVersion(version.CheckpointParamsWithFlags(version.CheckpointParams(), cmd))
+	},
+}
+
+func init() {
+	Cmd.Flags().StringVar(&params.Branch, "branch", "", "Branch to watch within git repository")
+	Cmd.Flags().StringVar(&params.Path, "path", "", "Path of files within git repository")
+	Cmd.Flags().StringVar(&params.Chart, "chart", "", "Chart to deploy from the helm repository")
+	Cmd.Flags().StringVar(&params.HelmReleaseTargetNamespace, "helm-release-target-namespace", "", "Namespace in which to deploy a helm chart")
+	Cmd.Flags().StringArrayVar(&params.ValuesFiles, "values", nil, "Values file merged over the current values of the helm chart; can be repeated")
+	Cmd.Flags().StringArrayVar(&params.SetValues, "set", nil, "Value of the helm chart as <key>=<value>, merged over the current values; can be repeated")
+	Cmd.Flags().StringArrayVar(&params.ValuesFrom, "values-from", nil, "ConfigMap or Secret holding values of the helm chart, as <configmap|secret>/<name>[:<values key>]; replaces the current ones")
+	Cmd.Flags().BoolVar(&params.ResetValues, "reset-values", false, "Drop the current values of the helm chart before applying --values, --set and --values-from")
+	Cmd.Flags().StringArrayVar(&params.DependsOn, "depends-on-app", nil, "Application of the namespace which must be ready before this one is deployed; can be repeated, replacing the current ones, or empty to remove them")
+	Cmd.Flags().StringSliceVar(&params.Environments, "environments", nil, "Targets of the config repository the application is promoted through with 'gitops app promote', in order (e.g. dev,staging,prod); replaces the current ones")
+	Cmd.Flags().StringVar(&params.Kustomization.TargetNamespace, "target-namespace", "", "Namespace in which to deploy the objects of a kustomize application, overriding their own namespace")
+	Cmd.Flags().BoolVar(&prune, "prune", true, "Delete the objects of a kustomize application removed from its source")
+	Cmd.Flags().DurationVar(&params.Kustomization.Interval, "interval", 0, "How often the source of a kustomize application is applied")
+	Cmd.Flags().StringArrayVar(&params.Kustomization.HealthChecks, "health-check", nil, "Object of a kustomize application whose readiness is checked, as <kind>/<name>[.<namespace>]; can be repeated, replacing the current ones")
+	Cmd.Flags().StringArrayVar(&params.Kustomization.DependsOn, "depends-on", nil, "Kustomization which must be ready before a kustomize application is applied, as <name> or <namespace>/<name>; can be repeated, replacing the current ones")
+	Cmd.Flags().StringArrayVar(&params.Kustomization.Substitute, "substitute", nil, "Variable replaced in the manifests of a kustomize application, as <variable>=<value>; can be repeated, replacing the current ones")
+	Cmd.Flags().StringArrayVar(&params.Kustomization.SubstituteFrom, "substitute-from", nil, "ConfigMap or Secret holding variables replaced in the manifests of a kustomize application, as <configmap|secret>/<name>; can be repeated, replacing the current ones")
+	Cmd.Flags().BoolVar(&params.DryRun, "dry-run", false, "If set, 'gitops app update' will not make any changes to the system; it will just display the actions that would have been taken")
+	Cmd.Flags().BoolVar(&params.AutoMerge, "auto-merge", false, "If set, 'gitops app update' will commit the changes directly instead of opening a pull request")
+}
+
+func valuesChanged() bool {
+	return len(params.ValuesFiles) > 0 || len(params.SetValues) > 0 || len(params.ValuesFrom) > 0 || params.ResetValues
+}
+
+// nonEmpty drops the empty values, given to remove the current ones
+func nonEmpty(values []string) []string {
+	result := []string{}
+
+	for _, value := range values {
+		if value != "" {
+			result = append(result, value)
+		}
+	}
+
+	return result
+}
+
+func runCmd(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	params.Name = args[0]
+	params.Namespace, _ = cmd.Parent().Flags().GetString("namespace")
+
+	if cmd.Flags().Changed("prune") {
+		params.Kustomization.Prune = &prune
+	}
+
+	if cmd.Flags().Changed("depends-on-app") {
+		params.SetDependsOn = true
+		params.DependsOn = nonEmpty(params.DependsOn)
+	}
+
+	if params.Branch == "" && params.Path == "" && params.Chart == "" && params.HelmReleaseTargetNamespace == "" && !valuesChanged() && !params.Kustomization.IsSet() && !params.SetDependsOn && len(params.Environments) == 0 {
+		return fmt.Errorf("at least one of --branch, --path, --chart, --helm-release-target-namespace, --values, --set, --values-from, --reset-values, --depends-on-app, --environments or a kustomization setting must be specified")
+	}
+
+	appService, appError := apputils.GetAppService(ctx, params.Name, params.Namespace)
+	if appError != nil {
+		return fmt.Errorf("failed to create app service: %w", appError)
+	}
+
+	utils.SetCommmitMessage(fmt.Sprintf("gitops app update %s", params.Name))
+
+	if err := appService.Update(params); err != nil {
+		return errors.Wrapf(err, "failed to update the app %s", params.Name)
+	}
+
+	return nil
+}
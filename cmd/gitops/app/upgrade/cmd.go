@@ -0,0 +1,144 @@
+package upgrade
+
+// Provides support for changing the chart version of a helm application.
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/lithammer/dedent"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/version"
+	"github.com/weaveworks/weave-gitops/pkg/apputils"
+	"github.com/weaveworks/weave-gitops/pkg/helm"
+	"github.com/weaveworks/weave-gitops/pkg/logger"
+	"github.com/weaveworks/weave-gitops/pkg/services/app"
+	"github.com/weaveworks/weave-gitops/pkg/utils"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var params app.UpdateParams
+
+var Cmd = &cobra.Command{
+	Use:   "upgrade <app name> [--to <version>]",
+	Short: "Upgrade the chart of a helm app",
+	Long: strings.TrimSpace(dedent.Dedent(`
+        Lists the versions of the chart of an application published in its helm repository.
+        With --to, replaces the version constraint of the application, through a pull request to the config repository unless --auto-merge is set.
+    `)),
+	Example: `
+  # List the versions of the chart of the loki application
+  gitops app upgrade loki
+
+  # Open a pull request upgrading the loki application to version 2.8.1
+  gitops app upgrade loki --to 2.8.1
+
+  # Follow the patch releases of 2.8 and merge the change straight away
+  gitops app upgrade loki --to "~2.8.0" --auto-merge
+`,
+	Args:          cobra.ExactArgs(1),
+	RunE:          runCmd,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	PostRun: func(cmd *cobra.Command, args []string) {
+		version.CheckVersion(version.CheckpointParamsWithFlags(version.CheckpointParams(), cmd))
+	},
+}
+
+func init() {
+	Cmd.Flags().StringVar(&params.ChartVersion, "to", "", "Version, or semver constraint of the versions, of the chart to deploy")
+	Cmd.Flags().BoolVar(&params.DryRun, "dry-run", false, "If set, 'gitops app upgrade' will not make any changes to the system; it will just display the actions that would have been taken")
+	Cmd.Flags().BoolVar(&params.AutoMerge, "auto-merge", false, "If set, 'gitops app upgrade' will commit the changes directly instead of opening a pull request")
+}
+
+func runCmd(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	params.Name = args[0]
+	params.Namespace, _ = cmd.Parent().Flags().GetString("namespace")
+
+	appService, appError := apputils.GetAppService(ctx, params.Name, params.Namespace)
+	if appError != nil {
+		return fmt.Errorf("failed to create app service: %w", appError)
+	}
+
+	name := types.NamespacedName{Name: params.Name, Namespace: params.Namespace}
+
+	application, err := appService.Get(name)
+	if err != nil {
+		return fmt.Errorf("unable to get application for %s %w", params.Name, err)
+	}
+
+	log := apputils.GetLogger()
+
+	versions, err := appService.ChartVersions(name)
+	if err != nil {
+		return errors.Wrapf(err, "failed to list the chart versions of the app %s", params.Name)
+	}
+
+	if err := printVersions(log, application.Spec.Path, application.Spec.HelmChartVersion, versions); err != nil {
+		return err
+	}
+
+	if params.ChartVersion == "" {
+		return nil
+	}
+
+	matching, err := helm.LatestMatchingVersion(versions, params.ChartVersion)
+	if err != nil {
+		return err
+	}
+
+	if matching == "" {
+		return fmt.Errorf("no version of chart %s matches %s", application.Spec.Path, params.ChartVersion)
+	}
+
+	log.Println("\nUpgrading to version %s\n", matching)
+
+	utils.SetCommmitMessage(fmt.Sprintf("gitops app upgrade %s to %s", params.Name, params.ChartVersion))
+
+	if err := appService.Update(params); err != nil {
+		return errors.Wrapf(err, "failed to upgrade the app %s", params.Name)
+	}
+
+	return nil
+}
+
+// printVersions lists the versions of a chart, flagging the one selected by the current constraint
+func printVersions(log logger.Logger, chart, constraint string, versions []string) error {
+	selected := ""
+
+	if constraint == "" {
+		constraint = "latest"
+
+		if len(versions) > 0 {
+			selected = versions[0]
+		}
+	} else {
+		matching, err := helm.LatestMatchingVersion(versions, constraint)
+		if err != nil {
+			return err
+		}
+
+		selected = matching
+	}
+
+	header := []string{"Version", "Selected"}
+	rows := [][]string{}
+
+	for _, version := range versions {
+		mark := ""
+		if version == selected {
+			mark = "*"
+		}
+
+		rows = append(rows, []string{version, mark})
+	}
+
+	log.Println("Versions of chart %s, version constraint %s:\n", chart, constraint)
+	utils.PrintTable(log, header, rows)
+
+	return nil
+}
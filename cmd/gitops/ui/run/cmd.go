@@ -17,13 +17,19 @@ import (
 	"github.com/pkg/browser"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/pkg/controllers"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
 	"github.com/weaveworks/weave-gitops/pkg/server"
+	"github.com/weaveworks/weave-gitops/pkg/services/auth"
 	"go.uber.org/zap"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 var (
-	port string
-	path string
+	port           string
+	path           string
+	runControllers bool
 )
 
 var Cmd = &cobra.Command{
@@ -65,6 +71,17 @@ func runCmd(cmd *cobra.Command, args []string) error {
 
 	mux.Handle("/v1/", appsHandler)
 
+	controllersCtx, stopControllers := context.WithCancel(context.Background())
+	defer stopControllers()
+
+	if runControllers {
+		namespace, _ := cmd.Flags().GetString("namespace")
+
+		if err := startControllers(controllersCtx, log, namespace); err != nil {
+			return fmt.Errorf("could not start controllers: %w", err)
+		}
+	}
+
 	mux.Handle("/", http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 		// Assume anything with a file extension in the name is a static asset.
 		extension := filepath.Ext(req.URL.Path)
@@ -134,6 +151,57 @@ func getAssets() fs.FS {
 	return f
 }
 
+// startControllers runs the controllers keeping the Application status up to date
+// until the context is cancelled. The controllers only watch the gitops runtime namespace.
+func startControllers(ctx context.Context, log logrus.FieldLogger, namespace string) error {
+	restCfg, err := ctrl.GetConfig()
+	if err != nil {
+		return fmt.Errorf("could not get kubernetes config: %w", err)
+	}
+
+	zapLog, err := zap.NewProduction()
+	if err != nil {
+		return fmt.Errorf("could not create zap logger: %w", err)
+	}
+
+	mgr, err := ctrl.NewManager(restCfg, ctrl.Options{
+		Scheme:             kube.CreateScheme(),
+		Namespace:          namespace,
+		MetricsBindAddress: "0",
+		Logger:             zapr.NewLogger(zapLog),
+	})
+	if err != nil {
+		return fmt.Errorf("could not create controller manager: %w", err)
+	}
+
+	// The token Secrets are read without the cache of the manager, so that Secrets are not watched
+	tokenClient, err := client.New(restCfg, client.Options{Scheme: kube.CreateScheme()})
+	if err != nil {
+		return fmt.Errorf("could not create kubernetes client: %w", err)
+	}
+
+	reconciler := &controllers.ApplicationReconciler{
+		Client:          mgr.GetClient(),
+		Log:             mgr.GetLogger().WithName("application"),
+		GitHubAppTokens: &auth.GitHubAppTokenIssuer{KubeClient: tokenClient},
+	}
+
+	if err := reconciler.SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("could not set up application controller: %w", err)
+	}
+
+	go func() {
+		log.Infof("Starting controllers")
+
+		if err := mgr.Start(ctx); err != nil {
+			log.Error(err, "controller manager exited")
+			os.Exit(1)
+		}
+	}()
+
+	return nil
+}
+
 // A redirector ensures that index.html always gets served.
 // The JS router will take care of actual navigation once the index.html page lands.
 func createRedirector(fsys fs.FS, log logrus.FieldLogger) http.HandlerFunc {
@@ -179,4 +247,5 @@ func createRedirector(fsys fs.FS, log logrus.FieldLogger) http.HandlerFunc {
 func init() {
 	Cmd.Flags().StringVar(&port, "port", "9001", "UI port")
 	Cmd.Flags().StringVar(&path, "path", "", "Path url")
+	Cmd.Flags().BoolVar(&runControllers, "controllers", false, "Run the controllers that keep the status of applications up to date")
 }
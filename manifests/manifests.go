@@ -58,6 +58,12 @@ var WegoAppRole []byte
 //go:embed wego-app/role-binding.yaml
 var WegoAppRoleBinding []byte
 
+//go:embed wego-app/cluster-role.yaml
+var WegoAppClusterRole []byte
+
+//go:embed wego-app/cluster-role-binding.yaml
+var WegoAppClusterRoleBinding []byte
+
 func init() {
 	Manifests = [][]byte{
 		AppCRD,
@@ -65,5 +71,7 @@ func init() {
 		WegoAppRoleBinding,
 		WegoAppRole,
 		WegoAppService,
+		WegoAppClusterRole,
+		WegoAppClusterRoleBinding,
 	}
 }
@@ -22,15 +22,46 @@ type ApplicationsClient interface {
 	// Authenticate generates jwt token using git provider name and git provider token arguments
 	Authenticate(ctx context.Context, in *AuthenticateRequest, opts ...grpc.CallOption) (*AuthenticateResponse, error)
 	//
+	// RefreshToken exchanges a refresh token for a new access token and a new refresh token.
+	// A refresh token can only be used once.
+	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error)
+	//
+	// Logout revokes the access token of the request and the given refresh token.
+	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error)
+	//
 	// ListApplications returns the list of WeGo applications that the authenticated user has access to.
 	ListApplications(ctx context.Context, in *ListApplicationsRequest, opts ...grpc.CallOption) (*ListApplicationsResponse, error)
 	//
 	// GetApplication returns a given application
 	GetApplication(ctx context.Context, in *GetApplicationRequest, opts ...grpc.CallOption) (*GetApplicationResponse, error)
 	//
+	// WatchApplications streams the changes made to applications and to their Flux source and automation objects.
+	// The HTTP API streams newline-delimited JSON, or server-sent events when the client accepts text/event-stream.
+	WatchApplications(ctx context.Context, in *WatchApplicationsRequest, opts ...grpc.CallOption) (Applications_WatchApplicationsClient, error)
+	//
 	// ListCommits returns the list of WeGo commits that the authenticated user has access to.
 	ListCommits(ctx context.Context, in *ListCommitsRequest, opts ...grpc.CallOption) (*ListCommitsResponse, error)
 	//
+	// ListEvents returns the Kubernetes events of an application, of its source and automation objects
+	// and of the objects reconciled by the automation, merged in a single timeline sorted by time.
+	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
+	//
+	// SyncApplication asks Flux to reconcile the source of an application, then its Kustomization or HelmRelease.
+	// When wait is set, the call returns once both handled the request and are Ready, with the new revisions.
+	SyncApplication(ctx context.Context, in *SyncApplicationRequest, opts ...grpc.CallOption) (*SyncApplicationResponse, error)
+	//
+	// DiffApplication renders the manifests of the source of an application the way Flux does
+	// and compares them with the objects of the cluster reconciled by the application.
+	DiffApplication(ctx context.Context, in *DiffApplicationRequest, opts ...grpc.CallOption) (*DiffApplicationResponse, error)
+	//
+	// PauseApplications suspends the Kustomization or HelmRelease of every application of a namespace,
+	// or of the applications matching a label selector. The user and the reason are recorded in annotations.
+	PauseApplications(ctx context.Context, in *PauseApplicationsRequest, opts ...grpc.CallOption) (*PauseApplicationsResponse, error)
+	//
+	// UnpauseApplications resumes the Kustomization or HelmRelease of every application of a namespace,
+	// or of the applications matching a label selector.
+	UnpauseApplications(ctx context.Context, in *UnpauseApplicationsRequest, opts ...grpc.CallOption) (*UnpauseApplicationsResponse, error)
+	//
 	// GetReconciledObjects returns a list of objects that were created as a result of the Application.
 	// This list is derived by looking at the Kustomization that is associated with an Application.
 	// Helm Releases are not currently supported.
@@ -68,6 +99,24 @@ func (c *applicationsClient) Authenticate(ctx context.Context, in *AuthenticateR
 	return out, nil
 }
 
+func (c *applicationsClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error) {
+	out := new(RefreshTokenResponse)
+	err := c.cc.Invoke(ctx, "/wego_server.v1.Applications/RefreshToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationsClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error) {
+	out := new(LogoutResponse)
+	err := c.cc.Invoke(ctx, "/wego_server.v1.Applications/Logout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *applicationsClient) ListApplications(ctx context.Context, in *ListApplicationsRequest, opts ...grpc.CallOption) (*ListApplicationsResponse, error) {
 	out := new(ListApplicationsResponse)
 	err := c.cc.Invoke(ctx, "/wego_server.v1.Applications/ListApplications", in, out, opts...)
@@ -86,6 +135,38 @@ func (c *applicationsClient) GetApplication(ctx context.Context, in *GetApplicat
 	return out, nil
 }
 
+func (c *applicationsClient) WatchApplications(ctx context.Context, in *WatchApplicationsRequest, opts ...grpc.CallOption) (Applications_WatchApplicationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Applications_ServiceDesc.Streams[0], "/wego_server.v1.Applications/WatchApplications", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &applicationsWatchApplicationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Applications_WatchApplicationsClient interface {
+	Recv() (*WatchApplicationsResponse, error)
+	grpc.ClientStream
+}
+
+type applicationsWatchApplicationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *applicationsWatchApplicationsClient) Recv() (*WatchApplicationsResponse, error) {
+	m := new(WatchApplicationsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *applicationsClient) ListCommits(ctx context.Context, in *ListCommitsRequest, opts ...grpc.CallOption) (*ListCommitsResponse, error) {
 	out := new(ListCommitsResponse)
 	err := c.cc.Invoke(ctx, "/wego_server.v1.Applications/ListCommits", in, out, opts...)
@@ -95,6 +176,51 @@ func (c *applicationsClient) ListCommits(ctx context.Context, in *ListCommitsReq
 	return out, nil
 }
 
+func (c *applicationsClient) ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error) {
+	out := new(ListEventsResponse)
+	err := c.cc.Invoke(ctx, "/wego_server.v1.Applications/ListEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationsClient) SyncApplication(ctx context.Context, in *SyncApplicationRequest, opts ...grpc.CallOption) (*SyncApplicationResponse, error) {
+	out := new(SyncApplicationResponse)
+	err := c.cc.Invoke(ctx, "/wego_server.v1.Applications/SyncApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationsClient) DiffApplication(ctx context.Context, in *DiffApplicationRequest, opts ...grpc.CallOption) (*DiffApplicationResponse, error) {
+	out := new(DiffApplicationResponse)
+	err := c.cc.Invoke(ctx, "/wego_server.v1.Applications/DiffApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationsClient) PauseApplications(ctx context.Context, in *PauseApplicationsRequest, opts ...grpc.CallOption) (*PauseApplicationsResponse, error) {
+	out := new(PauseApplicationsResponse)
+	err := c.cc.Invoke(ctx, "/wego_server.v1.Applications/PauseApplications", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationsClient) UnpauseApplications(ctx context.Context, in *UnpauseApplicationsRequest, opts ...grpc.CallOption) (*UnpauseApplicationsResponse, error) {
+	out := new(UnpauseApplicationsResponse)
+	err := c.cc.Invoke(ctx, "/wego_server.v1.Applications/UnpauseApplications", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *applicationsClient) GetReconciledObjects(ctx context.Context, in *GetReconciledObjectsReq, opts ...grpc.CallOption) (*GetReconciledObjectsRes, error) {
 	out := new(GetReconciledObjectsRes)
 	err := c.cc.Invoke(ctx, "/wego_server.v1.Applications/GetReconciledObjects", in, out, opts...)
@@ -139,15 +265,46 @@ type ApplicationsServer interface {
 	// Authenticate generates jwt token using git provider name and git provider token arguments
 	Authenticate(context.Context, *AuthenticateRequest) (*AuthenticateResponse, error)
 	//
+	// RefreshToken exchanges a refresh token for a new access token and a new refresh token.
+	// A refresh token can only be used once.
+	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
+	//
+	// Logout revokes the access token of the request and the given refresh token.
+	Logout(context.Context, *LogoutRequest) (*LogoutResponse, error)
+	//
 	// ListApplications returns the list of WeGo applications that the authenticated user has access to.
 	ListApplications(context.Context, *ListApplicationsRequest) (*ListApplicationsResponse, error)
 	//
 	// GetApplication returns a given application
 	GetApplication(context.Context, *GetApplicationRequest) (*GetApplicationResponse, error)
 	//
+	// WatchApplications streams the changes made to applications and to their Flux source and automation objects.
+	// The HTTP API streams newline-delimited JSON, or server-sent events when the client accepts text/event-stream.
+	WatchApplications(*WatchApplicationsRequest, Applications_WatchApplicationsServer) error
+	//
 	// ListCommits returns the list of WeGo commits that the authenticated user has access to.
 	ListCommits(context.Context, *ListCommitsRequest) (*ListCommitsResponse, error)
 	//
+	// ListEvents returns the Kubernetes events of an application, of its source and automation objects
+	// and of the objects reconciled by the automation, merged in a single timeline sorted by time.
+	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
+	//
+	// SyncApplication asks Flux to reconcile the source of an application, then its Kustomization or HelmRelease.
+	// When wait is set, the call returns once both handled the request and are Ready, with the new revisions.
+	SyncApplication(context.Context, *SyncApplicationRequest) (*SyncApplicationResponse, error)
+	//
+	// DiffApplication renders the manifests of the source of an application the way Flux does
+	// and compares them with the objects of the cluster reconciled by the application.
+	DiffApplication(context.Context, *DiffApplicationRequest) (*DiffApplicationResponse, error)
+	//
+	// PauseApplications suspends the Kustomization or HelmRelease of every application of a namespace,
+	// or of the applications matching a label selector. The user and the reason are recorded in annotations.
+	PauseApplications(context.Context, *PauseApplicationsRequest) (*PauseApplicationsResponse, error)
+	//
+	// UnpauseApplications resumes the Kustomization or HelmRelease of every application of a namespace,
+	// or of the applications matching a label selector.
+	UnpauseApplications(context.Context, *UnpauseApplicationsRequest) (*UnpauseApplicationsResponse, error)
+	//
 	// GetReconciledObjects returns a list of objects that were created as a result of the Application.
 	// This list is derived by looking at the Kustomization that is associated with an Application.
 	// Helm Releases are not currently supported.
@@ -176,15 +333,39 @@ type UnimplementedApplicationsServer struct {
 func (UnimplementedApplicationsServer) Authenticate(context.Context, *AuthenticateRequest) (*AuthenticateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authenticate not implemented")
 }
+func (UnimplementedApplicationsServer) RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedApplicationsServer) Logout(context.Context, *LogoutRequest) (*LogoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
 func (UnimplementedApplicationsServer) ListApplications(context.Context, *ListApplicationsRequest) (*ListApplicationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListApplications not implemented")
 }
 func (UnimplementedApplicationsServer) GetApplication(context.Context, *GetApplicationRequest) (*GetApplicationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetApplication not implemented")
 }
+func (UnimplementedApplicationsServer) WatchApplications(*WatchApplicationsRequest, Applications_WatchApplicationsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchApplications not implemented")
+}
 func (UnimplementedApplicationsServer) ListCommits(context.Context, *ListCommitsRequest) (*ListCommitsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListCommits not implemented")
 }
+func (UnimplementedApplicationsServer) ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEvents not implemented")
+}
+func (UnimplementedApplicationsServer) SyncApplication(context.Context, *SyncApplicationRequest) (*SyncApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncApplication not implemented")
+}
+func (UnimplementedApplicationsServer) DiffApplication(context.Context, *DiffApplicationRequest) (*DiffApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffApplication not implemented")
+}
+func (UnimplementedApplicationsServer) PauseApplications(context.Context, *PauseApplicationsRequest) (*PauseApplicationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseApplications not implemented")
+}
+func (UnimplementedApplicationsServer) UnpauseApplications(context.Context, *UnpauseApplicationsRequest) (*UnpauseApplicationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseApplications not implemented")
+}
 func (UnimplementedApplicationsServer) GetReconciledObjects(context.Context, *GetReconciledObjectsReq) (*GetReconciledObjectsRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetReconciledObjects not implemented")
 }
@@ -228,6 +409,42 @@ func _Applications_Authenticate_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Applications_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationsServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wego_server.v1.Applications/RefreshToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationsServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Applications_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationsServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wego_server.v1.Applications/Logout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationsServer).Logout(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Applications_ListApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListApplicationsRequest)
 	if err := dec(in); err != nil {
@@ -264,6 +481,27 @@ func _Applications_GetApplication_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Applications_WatchApplications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchApplicationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApplicationsServer).WatchApplications(m, &applicationsWatchApplicationsServer{stream})
+}
+
+type Applications_WatchApplicationsServer interface {
+	Send(*WatchApplicationsResponse) error
+	grpc.ServerStream
+}
+
+type applicationsWatchApplicationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *applicationsWatchApplicationsServer) Send(m *WatchApplicationsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Applications_ListCommits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListCommitsRequest)
 	if err := dec(in); err != nil {
@@ -282,6 +520,96 @@ func _Applications_ListCommits_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Applications_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationsServer).ListEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wego_server.v1.Applications/ListEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationsServer).ListEvents(ctx, req.(*ListEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Applications_SyncApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationsServer).SyncApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wego_server.v1.Applications/SyncApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationsServer).SyncApplication(ctx, req.(*SyncApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Applications_DiffApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationsServer).DiffApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wego_server.v1.Applications/DiffApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationsServer).DiffApplication(ctx, req.(*DiffApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Applications_PauseApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseApplicationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationsServer).PauseApplications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wego_server.v1.Applications/PauseApplications",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationsServer).PauseApplications(ctx, req.(*PauseApplicationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Applications_UnpauseApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpauseApplicationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationsServer).UnpauseApplications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wego_server.v1.Applications/UnpauseApplications",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationsServer).UnpauseApplications(ctx, req.(*UnpauseApplicationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Applications_GetReconciledObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetReconciledObjectsReq)
 	if err := dec(in); err != nil {
@@ -365,6 +693,14 @@ var Applications_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Authenticate",
 			Handler:    _Applications_Authenticate_Handler,
 		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _Applications_RefreshToken_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _Applications_Logout_Handler,
+		},
 		{
 			MethodName: "ListApplications",
 			Handler:    _Applications_ListApplications_Handler,
@@ -377,6 +713,26 @@ var Applications_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListCommits",
 			Handler:    _Applications_ListCommits_Handler,
 		},
+		{
+			MethodName: "ListEvents",
+			Handler:    _Applications_ListEvents_Handler,
+		},
+		{
+			MethodName: "SyncApplication",
+			Handler:    _Applications_SyncApplication_Handler,
+		},
+		{
+			MethodName: "DiffApplication",
+			Handler:    _Applications_DiffApplication_Handler,
+		},
+		{
+			MethodName: "PauseApplications",
+			Handler:    _Applications_PauseApplications_Handler,
+		},
+		{
+			MethodName: "UnpauseApplications",
+			Handler:    _Applications_UnpauseApplications_Handler,
+		},
 		{
 			MethodName: "GetReconciledObjects",
 			Handler:    _Applications_GetReconciledObjects_Handler,
@@ -394,6 +750,12 @@ var Applications_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Applications_GetGithubAuthStatus_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchApplications",
+			Handler:       _Applications_WatchApplications_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "api/applications/applications.proto",
 }
@@ -0,0 +1,13 @@
+package apputils
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestApputils(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Apputils Suite")
+}
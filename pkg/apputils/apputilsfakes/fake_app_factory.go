@@ -8,6 +8,7 @@ import (
 	"github.com/weaveworks/weave-gitops/pkg/apputils"
 	"github.com/weaveworks/weave-gitops/pkg/kube"
 	"github.com/weaveworks/weave-gitops/pkg/services/app"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 type FakeAppFactory struct {
@@ -26,6 +27,23 @@ type FakeAppFactory struct {
 		result1 app.AppService
 		result2 error
 	}
+	GetClusterAppServiceStub        func(context.Context, kube.Kube, client.Client, string, string) (app.AppService, error)
+	getClusterAppServiceMutex       sync.RWMutex
+	getClusterAppServiceArgsForCall []struct {
+		arg1 context.Context
+		arg2 kube.Kube
+		arg3 client.Client
+		arg4 string
+		arg5 string
+	}
+	getClusterAppServiceReturns struct {
+		result1 app.AppService
+		result2 error
+	}
+	getClusterAppServiceReturnsOnCall map[int]struct {
+		result1 app.AppService
+		result2 error
+	}
 	GetKubeServiceStub        func() (kube.Kube, error)
 	getKubeServiceMutex       sync.RWMutex
 	getKubeServiceArgsForCall []struct {
@@ -108,6 +126,74 @@ func (fake *FakeAppFactory) GetAppServiceReturnsOnCall(i int, result1 app.AppSer
 	}{result1, result2}
 }
 
+func (fake *FakeAppFactory) GetClusterAppService(arg1 context.Context, arg2 kube.Kube, arg3 client.Client, arg4 string, arg5 string) (app.AppService, error) {
+	fake.getClusterAppServiceMutex.Lock()
+	ret, specificReturn := fake.getClusterAppServiceReturnsOnCall[len(fake.getClusterAppServiceArgsForCall)]
+	fake.getClusterAppServiceArgsForCall = append(fake.getClusterAppServiceArgsForCall, struct {
+		arg1 context.Context
+		arg2 kube.Kube
+		arg3 client.Client
+		arg4 string
+		arg5 string
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.GetClusterAppServiceStub
+	fakeReturns := fake.getClusterAppServiceReturns
+	fake.recordInvocation("GetClusterAppService", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.getClusterAppServiceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAppFactory) GetClusterAppServiceCallCount() int {
+	fake.getClusterAppServiceMutex.RLock()
+	defer fake.getClusterAppServiceMutex.RUnlock()
+	return len(fake.getClusterAppServiceArgsForCall)
+}
+
+func (fake *FakeAppFactory) GetClusterAppServiceCalls(stub func(context.Context, kube.Kube, client.Client, string, string) (app.AppService, error)) {
+	fake.getClusterAppServiceMutex.Lock()
+	defer fake.getClusterAppServiceMutex.Unlock()
+	fake.GetClusterAppServiceStub = stub
+}
+
+func (fake *FakeAppFactory) GetClusterAppServiceArgsForCall(i int) (context.Context, kube.Kube, client.Client, string, string) {
+	fake.getClusterAppServiceMutex.RLock()
+	defer fake.getClusterAppServiceMutex.RUnlock()
+	argsForCall := fake.getClusterAppServiceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeAppFactory) GetClusterAppServiceReturns(result1 app.AppService, result2 error) {
+	fake.getClusterAppServiceMutex.Lock()
+	defer fake.getClusterAppServiceMutex.Unlock()
+	fake.GetClusterAppServiceStub = nil
+	fake.getClusterAppServiceReturns = struct {
+		result1 app.AppService
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAppFactory) GetClusterAppServiceReturnsOnCall(i int, result1 app.AppService, result2 error) {
+	fake.getClusterAppServiceMutex.Lock()
+	defer fake.getClusterAppServiceMutex.Unlock()
+	fake.GetClusterAppServiceStub = nil
+	if fake.getClusterAppServiceReturnsOnCall == nil {
+		fake.getClusterAppServiceReturnsOnCall = make(map[int]struct {
+			result1 app.AppService
+			result2 error
+		})
+	}
+	fake.getClusterAppServiceReturnsOnCall[i] = struct {
+		result1 app.AppService
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeAppFactory) GetKubeService() (kube.Kube, error) {
 	fake.getKubeServiceMutex.Lock()
 	ret, specificReturn := fake.getKubeServiceReturnsOnCall[len(fake.getKubeServiceArgsForCall)]
@@ -169,6 +255,8 @@ func (fake *FakeAppFactory) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.getAppServiceMutex.RLock()
 	defer fake.getAppServiceMutex.RUnlock()
+	fake.getClusterAppServiceMutex.RLock()
+	defer fake.getClusterAppServiceMutex.RUnlock()
 	fake.getKubeServiceMutex.RLock()
 	defer fake.getKubeServiceMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
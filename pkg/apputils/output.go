@@ -0,0 +1,136 @@
+package apputils
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/pkg/services/app"
+	"github.com/weaveworks/weave-gitops/pkg/utils"
+	"sigs.k8s.io/yaml"
+)
+
+// OutputFormat is the format the app commands print their results in
+type OutputFormat string
+
+const (
+	OutputTable OutputFormat = "table"
+	OutputWide  OutputFormat = "wide"
+	OutputJSON  OutputFormat = "json"
+	OutputYAML  OutputFormat = "yaml"
+)
+
+// AddOutputFlag adds the -o, --output flag to a command
+func AddOutputFlag(cmd *cobra.Command, format *string) {
+	cmd.Flags().StringVarP(format, "output", "o", string(OutputTable), "Output format: table, wide, json or yaml")
+}
+
+// ParseOutputFormat validates the value of an --output flag
+func ParseOutputFormat(format string) (OutputFormat, error) {
+	switch f := OutputFormat(format); f {
+	case OutputTable, OutputWide, OutputJSON, OutputYAML:
+		return f, nil
+	}
+
+	return "", fmt.Errorf("unsupported output format %q, expected table, wide, json or yaml", format)
+}
+
+// PrintObject prints an object as JSON or YAML
+func PrintObject(w io.Writer, format OutputFormat, obj interface{}) error {
+	var (
+		out []byte
+		err error
+	)
+
+	switch format {
+	case OutputJSON:
+		out, err = json.MarshalIndent(obj, "", "  ")
+		out = append(out, '\n')
+	case OutputYAML:
+		out, err = yaml.Marshal(obj)
+	default:
+		return fmt.Errorf("cannot print an object as %s", format)
+	}
+
+	if err != nil {
+		return fmt.Errorf("could not encode output: %w", err)
+	}
+
+	_, err = w.Write(out)
+
+	return err
+}
+
+// PrintAppInfo prints the summary of an application in the given format
+func PrintAppInfo(w io.Writer, format OutputFormat, info app.AppInfo) error {
+	if format == OutputJSON || format == OutputYAML {
+		return PrintObject(w, format, info)
+	}
+
+	printAppTable(w, format, []app.AppInfo{info})
+
+	return nil
+}
+
+// PrintAppInfoList prints the summaries of several applications in the given format
+func PrintAppInfoList(w io.Writer, format OutputFormat, infos []app.AppInfo) error {
+	if format == OutputJSON || format == OutputYAML {
+		return PrintObject(w, format, infos)
+	}
+
+	printAppTable(w, format, infos)
+
+	return nil
+}
+
+func printAppTable(w io.Writer, format OutputFormat, infos []app.AppInfo) {
+	header := []string{"Name", "Type", "Ready", "Suspended", "Revision"}
+	if format == OutputWide {
+		header = append(header, "URL", "Branch", "Path", "Message")
+	}
+
+	rows := [][]string{}
+
+	for _, info := range infos {
+		row := []string{info.Name, info.DeploymentType, info.Ready, strconv.FormatBool(info.Suspended), info.LastAppliedRevision}
+		if format == OutputWide {
+			row = append(row, info.URL, info.Branch, info.Path, info.ReadyMessage)
+		}
+
+		rows = append(rows, row)
+	}
+
+	utils.PrintTable(w, header, rows)
+}
+
+// PrintPauseResults prints the outcome of pausing or unpausing several applications as a table, the status of
+// the applications changed being done. An error is returned when some applications could not be changed.
+func PrintPauseResults(w io.Writer, results []app.PauseResult, done string) error {
+	header := []string{"Namespace", "Name", "Status"}
+	rows := [][]string{}
+	failed := 0
+
+	for _, result := range results {
+		status := done
+
+		switch {
+		case result.Err != nil:
+			status = fmt.Sprintf("failed: %s", result.Err)
+			failed++
+		case !result.Changed:
+			status = "already " + done
+		}
+
+		rows = append(rows, []string{result.Namespace, result.Name, status})
+	}
+
+	utils.PrintTable(w, header, rows)
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d apps could not be %s", failed, len(results), done)
+	}
+
+	return nil
+}
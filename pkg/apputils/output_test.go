@@ -0,0 +1,89 @@
+package apputils
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/pkg/services/app"
+	"sigs.k8s.io/yaml"
+)
+
+var _ = Describe("Output", func() {
+	var (
+		buf   *bytes.Buffer
+		infos []app.AppInfo
+	)
+
+	BeforeEach(func() {
+		buf = &bytes.Buffer{}
+		infos = []app.AppInfo{{
+			Name:                "podinfo",
+			Namespace:           "wego-system",
+			URL:                 "ssh://git@github.com/example/podinfo",
+			Branch:              "main",
+			DeploymentType:      "kustomize",
+			Ready:               "True",
+			ReadyMessage:        "Applied revision: main/abc123",
+			LastAppliedRevision: "main/abc123",
+		}}
+	})
+
+	It("rejects unknown formats", func() {
+		_, err := ParseOutputFormat("xml")
+		Expect(err).To(HaveOccurred())
+
+		format, err := ParseOutputFormat("wide")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(format).To(Equal(OutputWide))
+	})
+
+	It("prints a table", func() {
+		Expect(PrintAppInfoList(buf, OutputTable, infos)).To(Succeed())
+
+		Expect(buf.String()).To(MatchRegexp(`NAME\s+TYPE\s+READY\s+SUSPENDED\s+REVISION\s*\n`))
+		Expect(buf.String()).To(MatchRegexp(`podinfo\s+kustomize\s+True\s+false\s+main/abc123`))
+		Expect(buf.String()).NotTo(ContainSubstring("github.com"))
+	})
+
+	It("prints a wide table", func() {
+		Expect(PrintAppInfoList(buf, OutputWide, infos)).To(Succeed())
+
+		Expect(buf.String()).To(ContainSubstring("ssh://git@github.com/example/podinfo"))
+		Expect(buf.String()).To(ContainSubstring("Applied revision: main/abc123"))
+	})
+
+	It("prints a list as JSON", func() {
+		Expect(PrintAppInfoList(buf, OutputJSON, infos)).To(Succeed())
+
+		decoded := []app.AppInfo{}
+		Expect(json.Unmarshal(buf.Bytes(), &decoded)).To(Succeed())
+		Expect(decoded).To(Equal(infos))
+	})
+
+	It("prints an application as YAML", func() {
+		Expect(PrintAppInfo(buf, OutputYAML, infos[0])).To(Succeed())
+
+		Expect(buf.String()).To(ContainSubstring("lastAppliedRevision: main/abc123\n"))
+
+		decoded := app.AppInfo{}
+		Expect(yaml.Unmarshal(buf.Bytes(), &decoded)).To(Succeed())
+		Expect(decoded).To(Equal(infos[0]))
+	})
+
+	It("prints the results of pausing apps", func() {
+		results := []app.PauseResult{
+			{Name: "billing", Namespace: "wego-system", Changed: true},
+			{Name: "payments", Namespace: "wego-system"},
+			{Name: "podinfo", Namespace: "wego-system", Err: errors.New("could not find Kustomization")},
+		}
+
+		Expect(PrintPauseResults(buf, results, "paused")).To(MatchError("1 of 3 apps could not be paused"))
+
+		Expect(buf.String()).To(MatchRegexp(`billing\s+paused\s*\n`))
+		Expect(buf.String()).To(MatchRegexp(`payments\s+already paused\s*\n`))
+		Expect(buf.String()).To(MatchRegexp(`podinfo\s+failed: could not find Kustomization`))
+	})
+})
@@ -15,6 +15,7 @@ import (
 	"github.com/weaveworks/weave-gitops/pkg/services/app"
 	"github.com/weaveworks/weave-gitops/pkg/services/auth"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
@@ -22,6 +23,8 @@ import (
 type AppFactory interface {
 	GetKubeService() (kube.Kube, error)
 	GetAppService(ctx context.Context, name, namespace string) (app.AppService, error)
+	// GetClusterAppService returns the service of an application of the cluster of the clients
+	GetClusterAppService(ctx context.Context, kubeClient kube.Kube, rawClient client.Client, name, namespace string) (app.AppService, error)
 }
 
 type DefaultAppFactory struct {
@@ -31,6 +34,10 @@ func (f *DefaultAppFactory) GetAppService(ctx context.Context, name, namespace s
 	return GetAppService(ctx, name, namespace)
 }
 
+func (f *DefaultAppFactory) GetClusterAppService(ctx context.Context, kubeClient kube.Kube, rawClient client.Client, name, namespace string) (app.AppService, error) {
+	return GetClusterAppService(ctx, kubeClient, rawClient, name, namespace)
+}
+
 func (f *DefaultAppFactory) GetKubeService() (kube.Kube, error) {
 	kubeClient, _, err := kube.NewKubeHTTPClient()
 	if err != nil {
@@ -72,12 +79,22 @@ func IsClusterReady() error {
 }
 
 func GetAppService(ctx context.Context, appName string, namespace string) (app.AppService, error) {
-	osysClient, fluxClient, kubeClient, logger, err := GetBaseClients()
+	kubeClient, rawClient, err := kube.NewKubeHTTPClient()
 	if err != nil {
-		return nil, fmt.Errorf("error initializing clients: %w", err)
+		return nil, fmt.Errorf("error creating k8s http client: %w", err)
 	}
 
-	appClient, configClient, gitProvider, err := getGitClientsForApp(ctx, appName, namespace, false)
+	return GetClusterAppService(ctx, kubeClient, rawClient, appName, namespace)
+}
+
+// GetClusterAppService returns the service of an application of the cluster of the clients,
+// its git clients using the credentials stored in that cluster
+func GetClusterAppService(ctx context.Context, kubeClient kube.Kube, rawClient client.Client, appName string, namespace string) (app.AppService, error) {
+	osysClient := osys.New()
+	fluxClient := flux.New(osysClient, &runner.CLIRunner{})
+	logger := logger.NewCLILogger(osysClient.Stdout())
+
+	appClient, configClient, gitProvider, err := getGitClientsForApp(ctx, kubeClient, rawClient, appName, namespace, false)
 	if err != nil {
 		return nil, fmt.Errorf("error getting git clients: %w", err)
 	}
@@ -85,13 +102,17 @@ func GetAppService(ctx context.Context, appName string, namespace string) (app.A
 	return app.New(ctx, logger, appClient, configClient, gitProvider, fluxClient, kubeClient, osysClient), nil
 }
 
-func GetAppServiceForAdd(ctx context.Context, url, configUrl, namespace string, isHelmRepository bool, dryRun bool) (app.AppService, error) {
-	osysClient, fluxClient, kubeClient, logger, err := GetBaseClients()
+func GetAppServiceForAdd(ctx context.Context, url, configUrl, namespace string, isHelmRepository bool, dryRun bool, creds auth.GitCredentials) (app.AppService, error) {
+	kubeClient, rawClient, err := kube.NewKubeHTTPClient()
 	if err != nil {
-		return nil, fmt.Errorf("error initializing clients: %w", err)
+		return nil, fmt.Errorf("error creating k8s http client: %w", err)
 	}
 
-	appClient, configClient, gitProvider, err := getGitClients(ctx, url, configUrl, namespace, isHelmRepository, dryRun)
+	osysClient := osys.New()
+	fluxClient := flux.New(osysClient, &runner.CLIRunner{})
+	logger := logger.NewCLILogger(osysClient.Stdout())
+
+	appClient, configClient, gitProvider, err := getGitClients(ctx, kubeClient, rawClient, url, configUrl, namespace, isHelmRepository, dryRun, creds)
 	if err != nil {
 		return nil, fmt.Errorf("error getting git clients: %w", err)
 	}
@@ -99,23 +120,20 @@ func GetAppServiceForAdd(ctx context.Context, url, configUrl, namespace string,
 	return app.New(ctx, logger, appClient, configClient, gitProvider, fluxClient, kubeClient, osysClient), nil
 }
 
-func getGitClientsForApp(ctx context.Context, appName string, namespace string, dryRun bool) (git.Git, git.Git, gitproviders.GitProvider, error) {
-	kube, _, err := kube.NewKubeHTTPClient()
-	if err != nil {
-		return nil, nil, nil, fmt.Errorf("error creating k8s http client: %w", err)
-	}
-
-	app, err := kube.GetApplication(ctx, types.NamespacedName{Namespace: namespace, Name: appName})
+func getGitClientsForApp(ctx context.Context, kubeClient kube.Kube, rawClient client.Client, appName string, namespace string, dryRun bool) (git.Git, git.Git, gitproviders.GitProvider, error) {
+	app, err := kubeClient.GetApplication(ctx, types.NamespacedName{Namespace: namespace, Name: appName})
 	if err != nil {
 		return nil, nil, nil, fmt.Errorf("could not retrieve application %q: %w", appName, err)
 	}
 
 	isHelmRepository := app.Spec.SourceType == wego.SourceTypeHelm
 
-	return getGitClients(ctx, app.Spec.URL, app.Spec.ConfigURL, namespace, isHelmRepository, dryRun)
+	creds := auth.GitCredentials{Auth: app.Spec.GitAuth, SecretName: app.Spec.GitCredentialsSecret}
+
+	return getGitClients(ctx, kubeClient, rawClient, app.Spec.URL, app.Spec.ConfigURL, namespace, isHelmRepository, dryRun, creds)
 }
 
-func getGitClients(ctx context.Context, url, configUrl, namespace string, isHelmRepository bool, dryRun bool) (git.Git, git.Git, gitproviders.GitProvider, error) {
+func getGitClients(ctx context.Context, kubeClient kube.Kube, rawClient client.Client, url, configUrl, namespace string, isHelmRepository bool, dryRun bool, creds auth.GitCredentials) (git.Git, git.Git, gitproviders.GitProvider, error) {
 	isExternalConfig := app.IsExternalConfigUrl(configUrl)
 
 	var providerUrl string
@@ -134,17 +152,12 @@ func getGitClients(ctx context.Context, url, configUrl, namespace string, isHelm
 		return nil, nil, nil, fmt.Errorf("error normalizing url: %w", err)
 	}
 
-	kube, _, err := kube.NewKubeHTTPClient()
-	if err != nil {
-		return nil, nil, nil, fmt.Errorf("error creating k8s http client: %w", err)
-	}
-
-	targetName, err := kube.GetClusterName(ctx)
+	targetName, err := kubeClient.GetClusterName(ctx)
 	if err != nil {
 		return nil, nil, nil, fmt.Errorf("error getting target name: %w", err)
 	}
 
-	authsvc, err := getAuthService(ctx, normalizedUrl, dryRun)
+	authsvc, err := getAuthService(ctx, rawClient, normalizedUrl, dryRun)
 	if err != nil {
 		return nil, nil, nil, fmt.Errorf("error creating auth service: %w", err)
 	}
@@ -153,7 +166,7 @@ func getGitClients(ctx context.Context, url, configUrl, namespace string, isHelm
 
 	if !isHelmRepository {
 		// We need to do this even if we have an external config to set up the deploy key for the app repo
-		appRepoClient, appRepoErr := authsvc.CreateGitClient(ctx, normalizedUrl, targetName, namespace)
+		appRepoClient, appRepoErr := authsvc.CreateGitClientWithCredentials(ctx, normalizedUrl, targetName, namespace, creds)
 		if appRepoErr != nil {
 			return nil, nil, nil, appRepoErr
 		}
@@ -167,7 +180,7 @@ func getGitClients(ctx context.Context, url, configUrl, namespace string, isHelm
 			return nil, nil, nil, fmt.Errorf("error normalizing url: %w", err)
 		}
 
-		configRepoClient, configRepoErr := authsvc.CreateGitClient(ctx, normalizedConfigUrl, targetName, namespace)
+		configRepoClient, configRepoErr := authsvc.CreateGitClientWithCredentials(ctx, normalizedConfigUrl, targetName, namespace, creds)
 		if configRepoErr != nil {
 			return nil, nil, nil, configRepoErr
 		}
@@ -180,7 +193,7 @@ func getGitClients(ctx context.Context, url, configUrl, namespace string, isHelm
 	return appClient, configClient, authsvc.GetGitProvider(), nil
 }
 
-func getAuthService(ctx context.Context, normalizedUrl gitproviders.NormalizedRepoURL, dryRun bool) (auth.AuthService, error) {
+func getAuthService(ctx context.Context, rawClient client.Client, normalizedUrl gitproviders.NormalizedRepoURL, dryRun bool) (auth.AuthService, error) {
 	var (
 		gitProvider gitproviders.GitProvider
 		err         error
@@ -201,10 +214,5 @@ func getAuthService(ctx context.Context, normalizedUrl gitproviders.NormalizedRe
 	fluxClient := flux.New(osysClient, cliRunner)
 	logger := logger.NewCLILogger(osysClient.Stdout())
 
-	_, rawClient, err := kube.NewKubeHTTPClient()
-	if err != nil {
-		return nil, fmt.Errorf("error creating k8s http client: %w", err)
-	}
-
 	return auth.NewAuthService(fluxClient, rawClient, gitProvider, logger)
 }
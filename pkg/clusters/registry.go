@@ -0,0 +1,193 @@
+package clusters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	corev1 "k8s.io/api/core/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ClusterSecretLabel marks the Secrets holding the kubeconfig of a cluster. Its value is the name of the cluster.
+	ClusterSecretLabel = "wego.weave.works/cluster"
+	// ClusterSecretKey is the key of the kubeconfig in a cluster Secret, the same one Flux uses for remote clusters.
+	ClusterSecretKey = "value"
+	// DefaultClusterName names the cluster the server runs in when it has no kubeconfig context
+	DefaultClusterName = "default"
+)
+
+var ErrClusterNotFound = errors.New("cluster not found")
+
+// A Cluster holds the clients used to talk to one Kubernetes cluster
+type Cluster struct {
+	Name   string
+	Kube   kube.Kube
+	Client client.Client
+}
+
+// NewCluster creates the clients of the cluster described by a rest config
+func NewCluster(name string, config *rest.Config) (*Cluster, error) {
+	kubeService, rawClient, err := kube.NewKubeHTTPClientWithConfig(config, name)
+	if err != nil {
+		return nil, fmt.Errorf("could not create clients for cluster %q: %w", name, err)
+	}
+
+	return &Cluster{Name: name, Kube: kubeService, Client: rawClient}, nil
+}
+
+// A Registry holds the clusters the server can talk to.
+// Requests without a cluster name go to the default cluster, the one the server runs against.
+type Registry struct {
+	lock           sync.RWMutex
+	defaultCluster string
+	clusters       map[string]*Cluster
+}
+
+// NewRegistry creates a registry holding the default cluster
+func NewRegistry(defaultCluster *Cluster) *Registry {
+	return &Registry{
+		defaultCluster: defaultCluster.Name,
+		clusters:       map[string]*Cluster{defaultCluster.Name: defaultCluster},
+	}
+}
+
+// Add registers a cluster. Cluster names must be unique.
+func (r *Registry) Add(cluster *Cluster) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if _, ok := r.clusters[cluster.Name]; ok {
+		return fmt.Errorf("cluster %q is already registered", cluster.Name)
+	}
+
+	r.clusters[cluster.Name] = cluster
+
+	return nil
+}
+
+// Get returns the named cluster, or the default cluster when the name is empty
+func (r *Registry) Get(name string) (*Cluster, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	if name == "" {
+		name = r.defaultCluster
+	}
+
+	cluster, ok := r.clusters[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrClusterNotFound, name)
+	}
+
+	return cluster, nil
+}
+
+// List returns every registered cluster sorted by name
+func (r *Registry) List() []*Cluster {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	list := []*Cluster{}
+	for _, c := range r.clusters {
+		list = append(list, c)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+
+	return list
+}
+
+// LoadKubeconfig registers a cluster for each context of a kubeconfig, named after the context.
+// Contexts matching an already registered cluster are skipped. Contexts which cannot be loaded are skipped too,
+// their errors being returned together once the other contexts are registered.
+func (r *Registry) LoadKubeconfig(config clientcmdapi.Config) error {
+	names := []string{}
+	for name := range config.Contexts {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	errs := []error{}
+
+	for _, name := range names {
+		if _, err := r.Get(name); err == nil {
+			continue
+		}
+
+		restCfg, err := clientcmd.NewNonInteractiveClientConfig(config, name, &clientcmd.ConfigOverrides{}, nil).ClientConfig()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("could not create rest config for context %q: %w", name, err))
+			continue
+		}
+
+		if err := r.addCluster(name, restCfg); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// LoadSecrets registers the clusters whose kubeconfig is stored in the labelled Secrets of a namespace of the default cluster.
+// The current context of each kubeconfig is used. Secrets which cannot be loaded are skipped,
+// their errors being returned together once the other clusters are registered.
+func (r *Registry) LoadSecrets(ctx context.Context, namespace string) error {
+	defaultCluster, err := r.Get("")
+	if err != nil {
+		return err
+	}
+
+	secrets := &corev1.SecretList{}
+	if err := defaultCluster.Client.List(ctx, secrets, client.InNamespace(namespace), client.HasLabels{ClusterSecretLabel}); err != nil {
+		return fmt.Errorf("could not list cluster secrets: %w", err)
+	}
+
+	errs := []error{}
+
+	for _, secret := range secrets.Items {
+		name := secret.Labels[ClusterSecretLabel]
+		if name == "" {
+			errs = append(errs, fmt.Errorf("secret %s/%s has an empty %s label", secret.Namespace, secret.Name, ClusterSecretLabel))
+			continue
+		}
+
+		data, ok := secret.Data[ClusterSecretKey]
+		if !ok {
+			errs = append(errs, fmt.Errorf("secret %s/%s has no %q key", secret.Namespace, secret.Name, ClusterSecretKey))
+			continue
+		}
+
+		restCfg, err := clientcmd.RESTConfigFromKubeConfig(data)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("could not read kubeconfig of secret %s/%s: %w", secret.Namespace, secret.Name, err))
+			continue
+		}
+
+		if err := r.addCluster(name, restCfg); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+func (r *Registry) addCluster(name string, config *rest.Config) error {
+	cluster, err := NewCluster(name, config)
+	if err != nil {
+		return err
+	}
+
+	return r.Add(cluster)
+}
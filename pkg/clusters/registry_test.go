@@ -0,0 +1,155 @@
+package clusters
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/kube/kubefakes"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const stagingKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: staging
+  cluster:
+    server: https://staging.example.com
+contexts:
+- name: staging
+  context:
+    cluster: staging
+    user: staging
+current-context: staging
+users:
+- name: staging
+  user:
+    token: a-token
+`
+
+var _ = Describe("Registry", func() {
+	var (
+		registry *Registry
+		local    *Cluster
+	)
+
+	BeforeEach(func() {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "staging-kubeconfig",
+				Namespace: "wego-system",
+				Labels:    map[string]string{ClusterSecretLabel: "staging"},
+			},
+			Data: map[string][]byte{ClusterSecretKey: []byte(stagingKubeconfig)},
+		}
+		unrelated := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "deploy-key", Namespace: "wego-system"},
+		}
+
+		local = &Cluster{
+			Name:   "kind-local",
+			Kube:   &kubefakes.FakeKube{},
+			Client: fake.NewClientBuilder().WithScheme(kube.CreateScheme()).WithObjects(secret, unrelated).Build(),
+		}
+		registry = NewRegistry(local)
+	})
+
+	It("returns the default cluster when no name is given", func() {
+		Expect(registry.Get("")).To(Equal(local))
+		Expect(registry.Get("kind-local")).To(Equal(local))
+	})
+
+	It("fails to get unknown clusters", func() {
+		_, err := registry.Get("production")
+		Expect(err).To(MatchError(ErrClusterNotFound))
+	})
+
+	It("refuses duplicate cluster names", func() {
+		Expect(registry.Add(&Cluster{Name: "kind-local"})).To(MatchError(`cluster "kind-local" is already registered`))
+	})
+
+	It("loads the clusters of the labelled secrets", func() {
+		Expect(registry.LoadSecrets(context.Background(), "wego-system")).To(Succeed())
+
+		list := registry.List()
+		Expect(list).To(HaveLen(2))
+		Expect(list[0]).To(Equal(local))
+		Expect(list[1].Name).To(Equal("staging"))
+
+		name, err := list[1].Kube.GetClusterName(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(name).To(Equal("staging"))
+	})
+
+	It("loads a cluster per kubeconfig context", func() {
+		config := clientcmdapi.Config{
+			Clusters: map[string]*clientcmdapi.Cluster{
+				"local": {Server: "https://127.0.0.1:6443"},
+				"prod":  {Server: "https://prod.example.com"},
+			},
+			AuthInfos: map[string]*clientcmdapi.AuthInfo{
+				"admin": {Token: "a-token"},
+			},
+			Contexts: map[string]*clientcmdapi.Context{
+				"kind-local": {Cluster: "local", AuthInfo: "admin"},
+				"production": {Cluster: "prod", AuthInfo: "admin"},
+			},
+		}
+
+		Expect(registry.LoadKubeconfig(config)).To(Succeed())
+
+		list := registry.List()
+		Expect(list).To(HaveLen(2))
+		Expect(list[0]).To(Equal(local))
+		Expect(list[1].Name).To(Equal("production"))
+	})
+
+	It("skips the cluster secrets which cannot be loaded", func() {
+		staging := &corev1.Secret{}
+		Expect(local.Client.Get(context.Background(), client.ObjectKey{Name: "staging-kubeconfig", Namespace: "wego-system"}, staging)).To(Succeed())
+
+		broken := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "broken",
+				Namespace: "wego-system",
+				Labels:    map[string]string{ClusterSecretLabel: "broken"},
+			},
+		}
+		local.Client = fake.NewClientBuilder().WithScheme(kube.CreateScheme()).WithObjects(broken, staging).Build()
+
+		err := registry.LoadSecrets(context.Background(), "wego-system")
+		Expect(err).To(MatchError(`secret wego-system/broken has no "value" key`))
+
+		list := registry.List()
+		Expect(list).To(HaveLen(2))
+		Expect(list[1].Name).To(Equal("staging"))
+	})
+
+	It("skips the kubeconfig contexts which cannot be loaded", func() {
+		config := clientcmdapi.Config{
+			Clusters: map[string]*clientcmdapi.Cluster{
+				"prod": {Server: "https://prod.example.com"},
+			},
+			AuthInfos: map[string]*clientcmdapi.AuthInfo{
+				"admin": {Token: "a-token"},
+			},
+			Contexts: map[string]*clientcmdapi.Context{
+				"dangling":   {Cluster: "deleted", AuthInfo: "admin"},
+				"production": {Cluster: "prod", AuthInfo: "admin"},
+			},
+		}
+
+		err := registry.LoadKubeconfig(config)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(`context "dangling"`))
+
+		list := registry.List()
+		Expect(list).To(HaveLen(2))
+		Expect(list[1].Name).To(Equal("production"))
+	})
+})
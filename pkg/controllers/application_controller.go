@@ -0,0 +1,250 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1beta1"
+	"github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
+	"github.com/go-logr/logr"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/services/auth"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	// Reasons used on the Application conditions
+	ObjectNotFoundReason = "ObjectNotFound"
+	ProgressingReason    = "Progressing"
+	SucceededReason      = "ReconciliationSucceeded"
+	FailedReason         = "ReconciliationFailed"
+
+	// minTokenRefreshInterval bounds how often the installation token of an app is checked
+	minTokenRefreshInterval = time.Minute
+)
+
+// ApplicationReconciler keeps the status of an Application up to date with the
+// state of the flux source and automation objects created for it.
+// The installation tokens of the apps using the github-app git auth are refreshed before they expire
+// when GitHubAppTokens is set.
+type ApplicationReconciler struct {
+	client.Client
+	Log             logr.Logger
+	GitHubAppTokens *auth.GitHubAppTokenIssuer
+}
+
+func (r *ApplicationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithValues("application", req.NamespacedName)
+
+	app := &wego.Application{}
+	if err := r.Get(ctx, req.NamespacedName, app); err != nil {
+		// Flux objects that do not belong to an application are enqueued too, ignore them.
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	src, automation, err := fluxObjects(app)
+	if err != nil {
+		log.Error(err, "unable to determine flux objects")
+		return ctrl.Result{}, nil
+	}
+
+	patch := client.MergeFrom(app.DeepCopy())
+
+	sourceCondition, err := r.readyCondition(ctx, req.NamespacedName, src)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	automationCondition, err := r.readyCondition(ctx, req.NamespacedName, automation)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	setCondition(app, wego.SourceReadyCondition, sourceCondition)
+	setCondition(app, wego.AutomationReadyCondition, automationCondition)
+	setCondition(app, meta.ReadyCondition, rollupReadyCondition(sourceCondition, automationCondition))
+
+	app.Status.LastAppliedRevision = lastAppliedRevision(automation)
+	app.Status.ObservedGeneration = app.Generation
+
+	if err := r.Status().Patch(ctx, app, patch); err != nil {
+		return ctrl.Result{}, fmt.Errorf("could not patch application status: %w", err)
+	}
+
+	log.V(1).Info("application status updated")
+
+	if app.Spec.GitAuth == wego.GitAuthGitHubApp && r.GitHubAppTokens != nil {
+		return r.refreshGitHubAppToken(ctx, app)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// refreshGitHubAppToken keeps the installation token read by the sources of the app valid,
+// requeueing the app to refresh it again before it expires
+func (r *ApplicationReconciler) refreshGitHubAppToken(ctx context.Context, app *wego.Application) (ctrl.Result, error) {
+	appSecretName := types.NamespacedName{Name: app.Spec.GitCredentialsSecret, Namespace: app.Namespace}
+
+	_, expiresAt, err := r.GitHubAppTokens.Token(ctx, appSecretName)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("could not refresh github app installation token: %w", err)
+	}
+
+	requeueAfter := time.Until(expiresAt) - auth.GitHubAppTokenRefreshMargin
+	if requeueAfter < minTokenRefreshInterval {
+		requeueAfter = minTokenRefreshInterval
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+func (r *ApplicationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	enqueueApplication := handler.EnqueueRequestsFromMapFunc(func(obj client.Object) []reconcile.Request {
+		// Flux objects created for an application share its name and namespace
+		return []reconcile.Request{{NamespacedName: client.ObjectKeyFromObject(obj)}}
+	})
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&wego.Application{}).
+		Watches(&source.Kind{Type: &sourcev1.GitRepository{}}, enqueueApplication).
+		Watches(&source.Kind{Type: &sourcev1.HelmRepository{}}, enqueueApplication).
+		Watches(&source.Kind{Type: &kustomizev1.Kustomization{}}, enqueueApplication).
+		Watches(&source.Kind{Type: &helmv2.HelmRelease{}}, enqueueApplication).
+		Complete(r)
+}
+
+// fluxObjects returns empty flux objects matching the source and deployment types of an application.
+// The first return argument is the source, the second is the automation (Kustomization or HelmRelease)
+func fluxObjects(app *wego.Application) (client.Object, client.Object, error) {
+	var src, automation client.Object
+
+	switch app.Spec.SourceType {
+	// Apps created before the SourceType field existed default to git, same as the CLI
+	case wego.SourceTypeGit, "":
+		src = &sourcev1.GitRepository{}
+	case wego.SourceTypeHelm:
+		src = &sourcev1.HelmRepository{}
+	default:
+		return nil, nil, fmt.Errorf("invalid source type %q", app.Spec.SourceType)
+	}
+
+	switch app.Spec.DeploymentType {
+	case wego.DeploymentTypeKustomize, "":
+		automation = &kustomizev1.Kustomization{}
+	case wego.DeploymentTypeHelm:
+		automation = &helmv2.HelmRelease{}
+	default:
+		return nil, nil, fmt.Errorf("invalid deployment type %q", app.Spec.DeploymentType)
+	}
+
+	return src, automation, nil
+}
+
+// readyCondition fetches a flux object and returns a copy of its Ready condition.
+func (r *ApplicationReconciler) readyCondition(ctx context.Context, name types.NamespacedName, obj client.Object) (metav1.Condition, error) {
+	kind := kindName(obj)
+
+	if err := r.Get(ctx, name, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return metav1.Condition{
+				Status:  metav1.ConditionFalse,
+				Reason:  ObjectNotFoundReason,
+				Message: fmt.Sprintf("%s %s not found", kind, name),
+			}, nil
+		}
+
+		return metav1.Condition{}, fmt.Errorf("could not get %s %s: %w", kind, name, err)
+	}
+
+	var conditions []metav1.Condition
+
+	switch o := obj.(type) {
+	case *sourcev1.GitRepository:
+		conditions = o.Status.Conditions
+	case *sourcev1.HelmRepository:
+		conditions = o.Status.Conditions
+	case *kustomizev1.Kustomization:
+		conditions = o.Status.Conditions
+	case *helmv2.HelmRelease:
+		conditions = o.Status.Conditions
+	}
+
+	if c := apimeta.FindStatusCondition(conditions, meta.ReadyCondition); c != nil {
+		return metav1.Condition{Status: c.Status, Reason: c.Reason, Message: c.Message}, nil
+	}
+
+	return metav1.Condition{
+		Status:  metav1.ConditionUnknown,
+		Reason:  ProgressingReason,
+		Message: fmt.Sprintf("%s %s has not been reconciled yet", kind, name),
+	}, nil
+}
+
+// rollupReadyCondition is True when every condition is True, False as soon as one of them is False
+// and Unknown otherwise.
+func rollupReadyCondition(conditions ...metav1.Condition) metav1.Condition {
+	ready := metav1.Condition{
+		Status:  metav1.ConditionTrue,
+		Reason:  SucceededReason,
+		Message: "Application is ready",
+	}
+
+	for _, c := range conditions {
+		switch c.Status {
+		case metav1.ConditionFalse:
+			return metav1.Condition{Status: metav1.ConditionFalse, Reason: FailedReason, Message: c.Message}
+		case metav1.ConditionUnknown:
+			ready = metav1.Condition{Status: metav1.ConditionUnknown, Reason: ProgressingReason, Message: c.Message}
+		}
+	}
+
+	return ready
+}
+
+func setCondition(app *wego.Application, conditionType string, condition metav1.Condition) {
+	condition.Type = conditionType
+	condition.ObservedGeneration = app.Generation
+
+	if condition.Reason == "" {
+		condition.Reason = ProgressingReason
+	}
+
+	apimeta.SetStatusCondition(&app.Status.Conditions, condition)
+}
+
+func lastAppliedRevision(automation client.Object) string {
+	switch at := automation.(type) {
+	case *kustomizev1.Kustomization:
+		return at.Status.LastAppliedRevision
+	case *helmv2.HelmRelease:
+		return at.Status.LastAppliedRevision
+	}
+
+	return ""
+}
+
+func kindName(obj client.Object) string {
+	switch obj.(type) {
+	case *sourcev1.GitRepository:
+		return sourcev1.GitRepositoryKind
+	case *sourcev1.HelmRepository:
+		return sourcev1.HelmRepositoryKind
+	case *kustomizev1.Kustomization:
+		return kustomizev1.KustomizationKind
+	case *helmv2.HelmRelease:
+		return helmv2.HelmReleaseKind
+	}
+
+	return obj.GetObjectKind().GroupVersionKind().Kind
+}
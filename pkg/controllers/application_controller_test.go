@@ -0,0 +1,167 @@
+package controllers
+
+import (
+	"context"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1beta1"
+	"github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/gitcredentials"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/services/auth"
+	"github.com/weaveworks/weave-gitops/pkg/testutils"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("ApplicationReconciler", func() {
+	var (
+		ctx        context.Context
+		k8sClient  client.Client
+		reconciler *ApplicationReconciler
+		name       types.NamespacedName
+		app        *wego.Application
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		name = types.NamespacedName{Name: "my-app", Namespace: wego.DefaultNamespace}
+
+		app = &wego.Application{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       name.Name,
+				Namespace:  name.Namespace,
+				Generation: 2,
+			},
+			Spec: wego.ApplicationSpec{
+				SourceType:     wego.SourceTypeGit,
+				DeploymentType: wego.DeploymentTypeKustomize,
+			},
+		}
+
+		k8sClient = fake.NewClientBuilder().WithScheme(kube.CreateScheme()).WithObjects(app).Build()
+		reconciler = &ApplicationReconciler{Client: k8sClient, Log: testutils.MakeFakeLogr()}
+	})
+
+	reconcileApp := func() *wego.Application {
+		_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: name})
+		Expect(err).NotTo(HaveOccurred())
+
+		result := &wego.Application{}
+		Expect(k8sClient.Get(ctx, name, result)).To(Succeed())
+
+		return result
+	}
+
+	readyCondition := func(status metav1.ConditionStatus, message string) []metav1.Condition {
+		return []metav1.Condition{{
+			Type:    meta.ReadyCondition,
+			Status:  status,
+			Reason:  "Test",
+			Message: message,
+		}}
+	}
+
+	It("ignores objects without an application", func() {
+		_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "other", Namespace: name.Namespace}})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("reports missing flux objects", func() {
+		result := reconcileApp()
+
+		Expect(result.Status.ObservedGeneration).To(Equal(int64(2)))
+
+		source := apimeta.FindStatusCondition(result.Status.Conditions, wego.SourceReadyCondition)
+		Expect(source).NotTo(BeNil())
+		Expect(source.Status).To(Equal(metav1.ConditionFalse))
+		Expect(source.Reason).To(Equal(ObjectNotFoundReason))
+
+		ready := apimeta.FindStatusCondition(result.Status.Conditions, meta.ReadyCondition)
+		Expect(ready).NotTo(BeNil())
+		Expect(ready.Status).To(Equal(metav1.ConditionFalse))
+		Expect(ready.Message).To(Equal("GitRepository wego-system/my-app not found"))
+	})
+
+	It("rolls up the state of the source and kustomization", func() {
+		git := &sourcev1.GitRepository{ObjectMeta: metav1.ObjectMeta{Name: name.Name, Namespace: name.Namespace}}
+		git.Status.Conditions = readyCondition(metav1.ConditionTrue, "Fetched revision: main/abc")
+		Expect(k8sClient.Create(ctx, git)).To(Succeed())
+
+		kust := &kustomizev1.Kustomization{ObjectMeta: metav1.ObjectMeta{Name: name.Name, Namespace: name.Namespace}}
+		kust.Status.Conditions = readyCondition(metav1.ConditionTrue, "Applied revision: main/abc")
+		kust.Status.LastAppliedRevision = "main/abc"
+		Expect(k8sClient.Create(ctx, kust)).To(Succeed())
+
+		result := reconcileApp()
+
+		Expect(result.Status.LastAppliedRevision).To(Equal("main/abc"))
+		Expect(apimeta.IsStatusConditionTrue(result.Status.Conditions, wego.SourceReadyCondition)).To(BeTrue())
+		Expect(apimeta.IsStatusConditionTrue(result.Status.Conditions, wego.AutomationReadyCondition)).To(BeTrue())
+		Expect(apimeta.IsStatusConditionTrue(result.Status.Conditions, meta.ReadyCondition)).To(BeTrue())
+	})
+
+	It("reports a failing helm release", func() {
+		app.Spec.SourceType = wego.SourceTypeHelm
+		app.Spec.DeploymentType = wego.DeploymentTypeHelm
+		Expect(k8sClient.Update(ctx, app)).To(Succeed())
+
+		helmRepo := &sourcev1.HelmRepository{ObjectMeta: metav1.ObjectMeta{Name: name.Name, Namespace: name.Namespace}}
+		helmRepo.Status.Conditions = readyCondition(metav1.ConditionTrue, "Fetched index")
+		Expect(k8sClient.Create(ctx, helmRepo)).To(Succeed())
+
+		release := &helmv2.HelmRelease{ObjectMeta: metav1.ObjectMeta{Name: name.Name, Namespace: name.Namespace}}
+		release.Status.Conditions = readyCondition(metav1.ConditionFalse, "install failed")
+		Expect(k8sClient.Create(ctx, release)).To(Succeed())
+
+		result := reconcileApp()
+
+		ready := apimeta.FindStatusCondition(result.Status.Conditions, meta.ReadyCondition)
+		Expect(ready.Status).To(Equal(metav1.ConditionFalse))
+		Expect(ready.Reason).To(Equal(FailedReason))
+		Expect(ready.Message).To(Equal("install failed"))
+	})
+
+	It("reports objects that have not been reconciled yet as unknown", func() {
+		Expect(k8sClient.Create(ctx, &sourcev1.GitRepository{ObjectMeta: metav1.ObjectMeta{Name: name.Name, Namespace: name.Namespace}})).To(Succeed())
+		Expect(k8sClient.Create(ctx, &kustomizev1.Kustomization{ObjectMeta: metav1.ObjectMeta{Name: name.Name, Namespace: name.Namespace}})).To(Succeed())
+
+		result := reconcileApp()
+
+		ready := apimeta.FindStatusCondition(result.Status.Conditions, meta.ReadyCondition)
+		Expect(ready.Status).To(Equal(metav1.ConditionUnknown))
+		Expect(ready.Reason).To(Equal(ProgressingReason))
+	})
+
+	It("requeues apps using a github app until their installation token expires", func() {
+		app.Spec.GitAuth = wego.GitAuthGitHubApp
+		app.Spec.GitCredentialsSecret = "github-app"
+		Expect(k8sClient.Update(ctx, app)).To(Succeed())
+
+		expiresAt := time.Now().Add(time.Hour)
+		Expect(k8sClient.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "github-app-token",
+				Namespace:   name.Namespace,
+				Annotations: map[string]string{gitcredentials.GitHubAppTokenExpiresAtAnnotation: expiresAt.Format(time.RFC3339)},
+			},
+			Data: map[string][]byte{gitcredentials.PasswordKey: []byte("installation-token")},
+		})).To(Succeed())
+
+		reconciler.GitHubAppTokens = &auth.GitHubAppTokenIssuer{KubeClient: k8sClient}
+
+		result, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: name})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeNumerically("~", time.Until(expiresAt)-auth.GitHubAppTokenRefreshMargin, time.Minute))
+	})
+})
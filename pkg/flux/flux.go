@@ -1,14 +1,25 @@
 package flux
 
 import (
+	"bytes"
 	"fmt"
+	neturl "net/url"
+	"path/filepath"
 	"strings"
+	"time"
 
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1beta1"
+	"github.com/fluxcd/pkg/apis/meta"
+	"github.com/fluxcd/pkg/runtime/dependency"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
 	"github.com/pkg/errors"
-	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
 	"github.com/weaveworks/weave-gitops/pkg/osys"
 	"github.com/weaveworks/weave-gitops/pkg/runner"
 	"github.com/weaveworks/weave-gitops/pkg/version"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
@@ -22,13 +33,12 @@ type Flux interface {
 	Uninstall(namespace string, export bool) error
 	CreateSourceGit(name string, url string, branch string, secretRef string, namespace string) ([]byte, error)
 	CreateSourceHelm(name string, url string, namespace string) ([]byte, error)
-	CreateKustomization(name string, source string, path string, namespace string) ([]byte, error)
-	CreateHelmReleaseGitRepository(name, source, path, namespace, targetNamespace string) ([]byte, error)
-	CreateHelmReleaseHelmRepository(name, chart, namespace, targetNamespace string) ([]byte, error)
+	CreateKustomization(name string, source string, path string, namespace string, opts KustomizationOptions) ([]byte, error)
+	CreateHelmReleaseGitRepository(name, source, path, namespace, targetNamespace string, opts HelmReleaseOptions) ([]byte, error)
+	CreateHelmReleaseHelmRepository(name, chart, namespace, targetNamespace string, opts HelmReleaseOptions) ([]byte, error)
 	CreateSecretGit(name string, url string, namespace string) ([]byte, error)
 	GetVersion() (string, error)
 	GetAllResourcesStatus(name string, namespace string) ([]byte, error)
-	SuspendOrResumeApp(pause wego.SuspendActionType, name, namespace, deploymentType string) ([]byte, error)
 	GetLatestStatusAllNamespaces() ([]string, error)
 }
 
@@ -91,23 +101,46 @@ func (f *FluxClient) Uninstall(namespace string, dryRun bool) error {
 }
 
 func (f *FluxClient) CreateSourceGit(name string, url string, branch string, secretRef string, namespace string) ([]byte, error) {
-	args := []string{
-		"create", "source", "git", name,
-		"--branch", branch,
-		"--namespace", namespace,
-		"--interval", "30s",
-		"--export",
+	if secretRef == "" {
+		url = makePublicUrl(url)
+	}
+
+	if err := validateGitURL(url); err != nil {
+		return nil, fmt.Errorf("failed to create source git: %w", err)
+	}
+
+	if branch == "" {
+		return nil, fmt.Errorf("failed to create source git: a git branch is required")
+	}
+
+	gvk := sourcev1.GroupVersion.WithKind(sourcev1.GitRepositoryKind)
+	source := sourcev1.GitRepository{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       gvk.Kind,
+			APIVersion: gvk.GroupVersion().String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: sourcev1.GitRepositorySpec{
+			URL:      url,
+			Interval: metav1.Duration{Duration: 30 * time.Second},
+			Reference: &sourcev1.GitRepositoryRef{
+				Branch: branch,
+			},
+		},
 	}
 
 	if secretRef != "" {
-		args = append(args, "--secret-ref", secretRef, "--url", url)
-	} else {
-		args = append(args, "--url", makePublicUrl(url))
+		source.Spec.SecretRef = &meta.LocalObjectReference{
+			Name: secretRef,
+		}
 	}
 
-	out, err := f.runFluxCmd(args...)
+	out, err := exportManifest(source)
 	if err != nil {
-		return out, fmt.Errorf("failed to create source git: %w", err)
+		return nil, fmt.Errorf("failed to create source git: %w", err)
 	}
 
 	return out, nil
@@ -137,84 +170,205 @@ func makePublicUrl(url string) string {
 }
 
 func (f *FluxClient) CreateSourceHelm(name string, url string, namespace string) ([]byte, error) {
-	args := []string{
-		"create", "source", "helm", name,
-		"--url", url,
-		"--namespace", namespace,
-		"--interval", "30s",
-		"--export",
+	if _, err := neturl.Parse(url); err != nil {
+		return nil, fmt.Errorf("failed to create source helm: url parse failed: %w", err)
+	}
+
+	gvk := sourcev1.GroupVersion.WithKind(sourcev1.HelmRepositoryKind)
+	source := sourcev1.HelmRepository{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       gvk.Kind,
+			APIVersion: gvk.GroupVersion().String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: sourcev1.HelmRepositorySpec{
+			URL:      url,
+			Interval: metav1.Duration{Duration: 30 * time.Second},
+		},
+	}
+
+	out, err := exportManifest(source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create source helm: %w", err)
 	}
 
-	out, err := f.runFluxCmd(args...)
+	return out, nil
+}
+
+// KustomizationOptions holds the optional settings of the Kustomizations
+type KustomizationOptions struct {
+	TargetNamespace string
+	// Prune defaults to true
+	Prune *bool
+	// Interval defaults to one minute
+	Interval     time.Duration
+	HealthChecks []meta.NamespacedObjectKindReference
+	DependsOn    []dependency.CrossNamespaceDependencyReference
+	PostBuild    *kustomizev1.PostBuild
+}
+
+func (f *FluxClient) CreateKustomization(name string, source string, path string, namespace string, opts KustomizationOptions) ([]byte, error) {
+	sourceName, sourceNamespace := parseSourceName(source)
+
+	interval := time.Minute
+	if opts.Interval != 0 {
+		interval = opts.Interval
+	}
+
+	prune := true
+	if opts.Prune != nil {
+		prune = *opts.Prune
+	}
+
+	gvk := kustomizev1.GroupVersion.WithKind(kustomizev1.KustomizationKind)
+	kustomization := kustomizev1.Kustomization{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       gvk.Kind,
+			APIVersion: gvk.GroupVersion().String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: kustomizev1.KustomizationSpec{
+			Interval: metav1.Duration{Duration: interval},
+			Path:     safeRelativePath(path),
+			Prune:    prune,
+			SourceRef: kustomizev1.CrossNamespaceSourceReference{
+				Kind:      sourcev1.GitRepositoryKind,
+				Name:      sourceName,
+				Namespace: sourceNamespace,
+			},
+			Validation:      "client",
+			TargetNamespace: opts.TargetNamespace,
+			HealthChecks:    opts.HealthChecks,
+			DependsOn:       opts.DependsOn,
+			PostBuild:       opts.PostBuild,
+		},
+	}
+
+	out, err := exportManifest(kustomization)
 	if err != nil {
-		return out, fmt.Errorf("failed to create source helm: %w", err)
+		return nil, fmt.Errorf("failed to create kustomization: %w", err)
 	}
 
 	return out, nil
 }
 
-func (f *FluxClient) CreateKustomization(name string, source string, path string, namespace string) ([]byte, error) {
-	args := []string{
-		"create", "kustomization", name,
-		"--path", path,
-		"--source", source,
-		"--namespace", namespace,
-		"--prune", "true",
-		"--validation", "client",
-		"--interval", "1m",
-		"--export",
-	}
+// HelmReleaseOptions holds the optional settings of the HelmReleases
+type HelmReleaseOptions struct {
+	// Values override the default values of the chart
+	Values *apiextensionsv1.JSON
+	// ValuesFrom are merged in order before Values
+	ValuesFrom []helmv2.ValuesReference
+	// Version is the semver constraint of the versions of the chart, the latest version when empty
+	Version string
+	// DependsOn are the HelmReleases which must be ready before the release is installed or upgraded
+	DependsOn []dependency.CrossNamespaceDependencyReference
+}
 
-	out, err := f.runFluxCmd(args...)
+func (f *FluxClient) CreateHelmReleaseGitRepository(name, source, chartPath, namespace, targetNamespace string, opts HelmReleaseOptions) ([]byte, error) {
+	out, err := exportManifest(makeHelmRelease(name, sourcev1.GitRepositoryKind, source, chartPath, namespace, targetNamespace, opts))
 	if err != nil {
-		return out, fmt.Errorf("failed to create kustomization: %w", err)
+		return nil, fmt.Errorf("failed to create helm release git repo: %w", err)
 	}
 
 	return out, nil
 }
 
-func (f *FluxClient) CreateHelmReleaseGitRepository(name, source, chartPath, namespace, targetNamespace string) ([]byte, error) {
-	args := []string{
-		"create", "helmrelease", name,
-		"--source", "GitRepository/" + source,
-		"--chart", chartPath,
-		"--namespace", namespace,
-		"--interval", "5m",
-		"--export",
+func (f *FluxClient) CreateHelmReleaseHelmRepository(name, chart, namespace, targetNamespace string, opts HelmReleaseOptions) ([]byte, error) {
+	out, err := exportManifest(makeHelmRelease(name, sourcev1.HelmRepositoryKind, name, chart, namespace, targetNamespace, opts))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create helm release helm repo: %w", err)
 	}
 
-	if targetNamespace != "" {
-		args = append(args, "--target-namespace", targetNamespace)
+	return out, nil
+}
+
+func makeHelmRelease(name, sourceKind, source, chart, namespace, targetNamespace string, opts HelmReleaseOptions) helmv2.HelmRelease {
+	sourceName, sourceNamespace := parseSourceName(source)
+
+	gvk := helmv2.GroupVersion.WithKind(helmv2.HelmReleaseKind)
+
+	return helmv2.HelmRelease{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       gvk.Kind,
+			APIVersion: gvk.GroupVersion().String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: helmv2.HelmReleaseSpec{
+			Interval:        metav1.Duration{Duration: 5 * time.Minute},
+			TargetNamespace: targetNamespace,
+			Chart: helmv2.HelmChartTemplate{
+				Spec: helmv2.HelmChartTemplateSpec{
+					Chart:   chart,
+					Version: opts.Version,
+					SourceRef: helmv2.CrossNamespaceObjectReference{
+						Kind:      sourceKind,
+						Name:      sourceName,
+						Namespace: sourceNamespace,
+					},
+				},
+			},
+			Install:    &helmv2.Install{},
+			Values:     opts.Values,
+			ValuesFrom: opts.ValuesFrom,
+			DependsOn:  opts.DependsOn,
+		},
 	}
+}
 
-	out, err := f.runFluxCmd(args...)
+// exportManifest serializes a flux object the same way 'flux create ... --export' does
+func exportManifest(object interface{}) ([]byte, error) {
+	data, err := yaml.Marshal(object)
 	if err != nil {
-		return out, fmt.Errorf("failed to create helm release git repo: %w", err)
+		return nil, fmt.Errorf("could not marshal yaml: %w", err)
 	}
 
-	return out, nil
+	data = bytes.Replace(data, []byte("  creationTimestamp: null\n"), []byte(""), 1)
+	data = bytes.Replace(data, []byte("status: {}\n"), []byte(""), 1)
+
+	out := append([]byte("---\n"), data...)
+
+	return append(out, '\n'), nil
 }
 
-func (f *FluxClient) CreateHelmReleaseHelmRepository(name, chart, namespace, targetNamespace string) ([]byte, error) {
-	args := []string{
-		"create", "helmrelease", name,
-		"--source", "HelmRepository/" + name,
-		"--chart", chart,
-		"--namespace", namespace,
-		"--interval", "5m",
-		"--export",
+func validateGitURL(url string) error {
+	u, err := neturl.Parse(url)
+	if err != nil {
+		return fmt.Errorf("git URL parse failed: %w", err)
 	}
 
-	if targetNamespace != "" {
-		args = append(args, "--target-namespace", targetNamespace)
+	if u.Scheme != "ssh" && u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("git URL scheme '%s' not supported, can be: ssh, http and https", u.Scheme)
 	}
 
-	out, err := f.runFluxCmd(args...)
-	if err != nil {
-		return out, fmt.Errorf("failed to create helm release helm repo: %w", err)
+	return nil
+}
+
+// parseSourceName splits a source given as <name>.<namespace>, the format accepted by the flux CLI
+func parseSourceName(source string) (string, string) {
+	parts := strings.Split(source, ".")
+	if len(parts) == 1 {
+		return source, ""
 	}
 
-	return out, nil
+	return strings.Join(parts[:len(parts)-1], "."), parts[len(parts)-1]
+}
+
+// safeRelativePath flattens a path relative to the root of the source, as the flux CLI does for the
+// kustomization path. The leading dot of a hidden directory is dropped in the process, i.e.
+// ".wego/apps" becomes "./wego/apps".
+func safeRelativePath(path string) string {
+	cleanPath := filepath.Join("./", filepath.Clean("/"+strings.TrimSpace(path)))
+
+	return filepath.ToSlash(fmt.Sprintf("./%s", strings.TrimPrefix(cleanPath, ".")))
 }
 
 // CreatSecretGit Creates a Git secret returns the deploy key
@@ -296,11 +450,3 @@ func (f *FluxClient) fluxPath() (string, error) {
 
 	return fmt.Sprintf("%v/flux-%v", path, version.FluxVersion), nil
 }
-
-func (f *FluxClient) SuspendOrResumeApp(suspend wego.SuspendActionType, name, namespace string, deploymentType string) ([]byte, error) {
-	args := []string{
-		string(suspend), deploymentType, name, fmt.Sprintf("--namespace=%s", namespace),
-	}
-
-	return f.runFluxCmd(args...)
-}
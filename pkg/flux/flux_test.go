@@ -4,12 +4,18 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1beta1"
+	"github.com/fluxcd/pkg/apis/meta"
+	"github.com/fluxcd/pkg/runtime/dependency"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/weaveworks/weave-gitops/pkg/flux"
 	"github.com/weaveworks/weave-gitops/pkg/osys"
 	"github.com/weaveworks/weave-gitops/pkg/runner/runnerfakes"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 )
 
 var (
@@ -77,138 +83,262 @@ var _ = Describe("Uninstall", func() {
 
 var _ = Describe("CreateSourceGit", func() {
 	It("creates a git source", func() {
-		runner.RunStub = func(s1 string, s2 ...string) ([]byte, error) {
-			return []byte("out"), nil
-		}
 		out, err := fluxClient.CreateSourceGit("my-name", "https://github.com/foo/my-name", "main", "my-secret", "wego-system")
 		Expect(err).ShouldNot(HaveOccurred())
-		Expect(out).To(Equal([]byte("out")))
-
-		Expect(runner.RunCallCount()).To(Equal(1))
-
-		cmd, args := runner.RunArgsForCall(0)
-		Expect(cmd).To(Equal(fluxPath()))
-
-		Expect(strings.Join(args, " ")).To(Equal("create source git my-name --branch main --namespace wego-system --interval 30s --export --secret-ref my-secret --url https://github.com/foo/my-name"))
+		Expect(string(out)).To(Equal(`---
+apiVersion: source.toolkit.fluxcd.io/v1beta1
+kind: GitRepository
+metadata:
+  name: my-name
+  namespace: wego-system
+spec:
+  interval: 30s
+  ref:
+    branch: main
+  secretRef:
+    name: my-secret
+  url: https://github.com/foo/my-name
+
+`))
+
+		Expect(runner.RunCallCount()).To(Equal(0))
 	})
+
 	It("creates a git source for a public repo", func() {
-		runner.RunStub = func(s1 string, s2 ...string) ([]byte, error) {
-			return []byte("out"), nil
-		}
 		out, err := fluxClient.CreateSourceGit("my-name", "ssh://git@github.com/foo/my-name", "main", "", "wego-system")
 		Expect(err).ShouldNot(HaveOccurred())
-		Expect(out).To(Equal([]byte("out")))
-
-		Expect(runner.RunCallCount()).To(Equal(1))
-
-		cmd, args := runner.RunArgsForCall(0)
-		Expect(cmd).To(Equal(fluxPath()))
+		Expect(string(out)).To(Equal(`---
+apiVersion: source.toolkit.fluxcd.io/v1beta1
+kind: GitRepository
+metadata:
+  name: my-name
+  namespace: wego-system
+spec:
+  interval: 30s
+  ref:
+    branch: main
+  url: https://github.com/foo/my-name.git
+
+`))
+	})
 
-		Expect(strings.Join(args, " ")).To(Equal("create source git my-name --branch main --namespace wego-system --interval 30s --export --url https://github.com/foo/my-name.git"))
+	It("fails on an unsupported url scheme", func() {
+		_, err := fluxClient.CreateSourceGit("my-name", "git@gitlab.com:foo/my-name.git", "main", "my-secret", "wego-system")
+		Expect(err).Should(HaveOccurred())
 	})
 })
 
 var _ = Describe("CreateSourceHelm", func() {
 	It("creates a source helm", func() {
-		runner.RunStub = func(s1 string, s2 ...string) ([]byte, error) {
-			return []byte("out"), nil
-		}
 		out, err := fluxClient.CreateSourceHelm("my-name", "https://github.com/foo/my-name", "wego-system")
 		Expect(err).ShouldNot(HaveOccurred())
-		Expect(out).To(Equal([]byte("out")))
-
-		Expect(runner.RunCallCount()).To(Equal(1))
-
-		cmd, args := runner.RunArgsForCall(0)
-		Expect(cmd).To(Equal(fluxPath()))
-
-		Expect(strings.Join(args, " ")).To(Equal("create source helm my-name --url https://github.com/foo/my-name --namespace wego-system --interval 30s --export"))
+		Expect(string(out)).To(Equal(`---
+apiVersion: source.toolkit.fluxcd.io/v1beta1
+kind: HelmRepository
+metadata:
+  name: my-name
+  namespace: wego-system
+spec:
+  interval: 30s
+  url: https://github.com/foo/my-name
+
+`))
 	})
 })
 
 var _ = Describe("CreateKustomization", func() {
 	It("creates a kustomization", func() {
-		runner.RunStub = func(s1 string, s2 ...string) ([]byte, error) {
-			return []byte("out"), nil
-		}
-		out, err := fluxClient.CreateKustomization("my-name", "my-source", "./path", "wego-system")
+		out, err := fluxClient.CreateKustomization("my-name", "my-source", "./path", "wego-system", flux.KustomizationOptions{})
 		Expect(err).ShouldNot(HaveOccurred())
-		Expect(out).To(Equal([]byte("out")))
+		Expect(string(out)).To(Equal(`---
+apiVersion: kustomize.toolkit.fluxcd.io/v1beta1
+kind: Kustomization
+metadata:
+  name: my-name
+  namespace: wego-system
+spec:
+  interval: 1m0s
+  path: ./path
+  prune: true
+  sourceRef:
+    kind: GitRepository
+    name: my-source
+  validation: client
+
+`))
+	})
 
-		Expect(runner.RunCallCount()).To(Equal(1))
+	It("creates a kustomization with options", func() {
+		prune := false
+
+		out, err := fluxClient.CreateKustomization("my-name", "my-source", "./path", "wego-system", flux.KustomizationOptions{
+			TargetNamespace: "podinfo",
+			Prune:           &prune,
+			Interval:        10 * time.Minute,
+			HealthChecks:    []meta.NamespacedObjectKindReference{{APIVersion: "apps/v1", Kind: "Deployment", Name: "podinfo", Namespace: "podinfo"}},
+			DependsOn:       []dependency.CrossNamespaceDependencyReference{{Name: "infrastructure"}},
+			PostBuild: &kustomizev1.PostBuild{
+				Substitute:     map[string]string{"cluster_env": "dev"},
+				SubstituteFrom: []kustomizev1.SubstituteReference{{Kind: "ConfigMap", Name: "cluster-vars"}},
+			},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(out)).To(Equal(`---
+apiVersion: kustomize.toolkit.fluxcd.io/v1beta1
+kind: Kustomization
+metadata:
+  name: my-name
+  namespace: wego-system
+spec:
+  dependsOn:
+  - name: infrastructure
+  healthChecks:
+  - apiVersion: apps/v1
+    kind: Deployment
+    name: podinfo
+    namespace: podinfo
+  interval: 10m0s
+  path: ./path
+  postBuild:
+    substitute:
+      cluster_env: dev
+    substituteFrom:
+    - kind: ConfigMap
+      name: cluster-vars
+  prune: false
+  sourceRef:
+    kind: GitRepository
+    name: my-source
+  targetNamespace: podinfo
+  validation: client
+
+`))
+	})
 
-		cmd, args := runner.RunArgsForCall(0)
-		Expect(cmd).To(Equal(fluxPath()))
+	It("normalizes the path like the flux CLI", func() {
+		out, err := fluxClient.CreateKustomization("my-name", "my-source", ".wego/apps/my-name", "wego-system", flux.KustomizationOptions{})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(out)).To(ContainSubstring("path: ./wego/apps/my-name\n"))
 
-		Expect(strings.Join(args, " ")).To(Equal("create kustomization my-name --path ./path --source my-source --namespace wego-system --prune true --validation client --interval 1m --export"))
+		out, err = fluxClient.CreateKustomization("my-name", "my-source", "../../path/", "wego-system", flux.KustomizationOptions{})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(out)).To(ContainSubstring("path: ./path\n"))
+	})
+
+	It("reads the namespace of the source after the last dot", func() {
+		out, err := fluxClient.CreateKustomization("my-name", "my.source.flux-system", "./", "wego-system", flux.KustomizationOptions{})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(out)).To(ContainSubstring(`  sourceRef:
+    kind: GitRepository
+    name: my.source
+    namespace: flux-system
+`))
 	})
 })
 
 var _ = Describe("CreateHelmReleaseGitRepository", func() {
 	It("creates a helm release with a git repository", func() {
-		runner.RunStub = func(s1 string, s2 ...string) ([]byte, error) {
-			return []byte("out"), nil
-		}
-		out, err := fluxClient.CreateHelmReleaseGitRepository("my-name", "my-source", "./chart-path", "wego-system", "")
+		out, err := fluxClient.CreateHelmReleaseGitRepository("my-name", "my-source", "./chart-path", "wego-system", "", flux.HelmReleaseOptions{})
 		Expect(err).ShouldNot(HaveOccurred())
-		Expect(out).To(Equal([]byte("out")))
-
-		Expect(runner.RunCallCount()).To(Equal(1))
-
-		cmd, args := runner.RunArgsForCall(0)
-		Expect(cmd).To(Equal(fluxPath()))
-
-		Expect(strings.Join(args, " ")).To(Equal("create helmrelease my-name --source GitRepository/my-source --chart ./chart-path --namespace wego-system --interval 5m --export"))
+		Expect(string(out)).To(Equal(`---
+apiVersion: helm.toolkit.fluxcd.io/v2beta1
+kind: HelmRelease
+metadata:
+  name: my-name
+  namespace: wego-system
+spec:
+  chart:
+    spec:
+      chart: ./chart-path
+      sourceRef:
+        kind: GitRepository
+        name: my-source
+  install: {}
+  interval: 5m0s
+
+`))
 	})
 
 	It("creates a helm release with a git repository and a target namespace", func() {
-		runner.RunStub = func(s1 string, s2 ...string) ([]byte, error) {
-			return []byte("out"), nil
-		}
-		out, err := fluxClient.CreateHelmReleaseGitRepository("my-name", "my-source", "./chart-path", "wego-system", "sock-shop")
+		out, err := fluxClient.CreateHelmReleaseGitRepository("my-name", "my-source", "./chart-path", "wego-system", "sock-shop", flux.HelmReleaseOptions{})
 		Expect(err).ShouldNot(HaveOccurred())
-		Expect(out).To(Equal([]byte("out")))
-
-		Expect(runner.RunCallCount()).To(Equal(1))
-
-		cmd, args := runner.RunArgsForCall(0)
-		Expect(cmd).To(Equal(fluxPath()))
-
-		Expect(strings.Join(args, " ")).To(Equal("create helmrelease my-name --source GitRepository/my-source --chart ./chart-path --namespace wego-system --interval 5m --export --target-namespace sock-shop"))
+		Expect(string(out)).To(Equal(`---
+apiVersion: helm.toolkit.fluxcd.io/v2beta1
+kind: HelmRelease
+metadata:
+  name: my-name
+  namespace: wego-system
+spec:
+  chart:
+    spec:
+      chart: ./chart-path
+      sourceRef:
+        kind: GitRepository
+        name: my-source
+  install: {}
+  interval: 5m0s
+  targetNamespace: sock-shop
+
+`))
 	})
 })
 
 var _ = Describe("CreateHelmReleaseHelmRepository", func() {
 	It("creates a helm release with a helm repository", func() {
-		runner.RunStub = func(s1 string, s2 ...string) ([]byte, error) {
-			return []byte("out"), nil
-		}
-		out, err := fluxClient.CreateHelmReleaseHelmRepository("my-name", "my-chart", "wego-system", "")
+		out, err := fluxClient.CreateHelmReleaseHelmRepository("my-name", "my-chart", "wego-system", "", flux.HelmReleaseOptions{})
 		Expect(err).ShouldNot(HaveOccurred())
-		Expect(out).To(Equal([]byte("out")))
-
-		Expect(runner.RunCallCount()).To(Equal(1))
-
-		cmd, args := runner.RunArgsForCall(0)
-		Expect(cmd).To(Equal(fluxPath()))
-
-		Expect(strings.Join(args, " ")).To(Equal("create helmrelease my-name --source HelmRepository/my-name --chart my-chart --namespace wego-system --interval 5m --export"))
+		Expect(string(out)).To(Equal(`---
+apiVersion: helm.toolkit.fluxcd.io/v2beta1
+kind: HelmRelease
+metadata:
+  name: my-name
+  namespace: wego-system
+spec:
+  chart:
+    spec:
+      chart: my-chart
+      sourceRef:
+        kind: HelmRepository
+        name: my-name
+  install: {}
+  interval: 5m0s
+
+`))
 	})
 
 	It("creates a helm release with a helm repository and a target namespace", func() {
-		runner.RunStub = func(s1 string, s2 ...string) ([]byte, error) {
-			return []byte("out"), nil
-		}
-		out, err := fluxClient.CreateHelmReleaseHelmRepository("my-name", "my-chart", "wego-system", "sock-shop")
+		out, err := fluxClient.CreateHelmReleaseHelmRepository("my-name", "my-chart", "wego-system", "sock-shop", flux.HelmReleaseOptions{})
 		Expect(err).ShouldNot(HaveOccurred())
-		Expect(out).To(Equal([]byte("out")))
+		Expect(string(out)).To(ContainSubstring("  targetNamespace: sock-shop\n"))
+	})
 
-		Expect(runner.RunCallCount()).To(Equal(1))
+	It("creates a helm release with values", func() {
+		out, err := fluxClient.CreateHelmReleaseHelmRepository("my-name", "my-chart", "wego-system", "", flux.HelmReleaseOptions{
+			Values:     &apiextensionsv1.JSON{Raw: []byte(`{"replicaCount":2}`)},
+			ValuesFrom: []helmv2.ValuesReference{{Kind: "ConfigMap", Name: "my-values"}},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(out)).To(ContainSubstring(`  values:
+    replicaCount: 2
+  valuesFrom:
+  - kind: ConfigMap
+    name: my-values
+`))
+	})
 
-		cmd, args := runner.RunArgsForCall(0)
-		Expect(cmd).To(Equal(fluxPath()))
+	It("creates a helm release depending on other releases", func() {
+		out, err := fluxClient.CreateHelmReleaseHelmRepository("my-name", "my-chart", "wego-system", "", flux.HelmReleaseOptions{
+			DependsOn: []dependency.CrossNamespaceDependencyReference{{Name: "redis"}},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(out)).To(ContainSubstring("  dependsOn:\n  - name: redis\n"))
+	})
 
-		Expect(strings.Join(args, " ")).To(Equal("create helmrelease my-name --source HelmRepository/my-name --chart my-chart --namespace wego-system --interval 5m --export --target-namespace sock-shop"))
+	It("creates a helm release with a chart version constraint", func() {
+		out, err := fluxClient.CreateHelmReleaseHelmRepository("my-name", "my-chart", "wego-system", "", flux.HelmReleaseOptions{Version: "~1.2.0"})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(out)).To(ContainSubstring("      chart: my-chart\n"))
+		Expect(string(out)).To(ContainSubstring("      version: ~1.2.0\n"))
 	})
 })
 
@@ -4,12 +4,11 @@ package fluxfakes
 import (
 	"sync"
 
-	"github.com/weaveworks/weave-gitops/api/v1alpha1"
 	"github.com/weaveworks/weave-gitops/pkg/flux"
 )
 
 type FakeFlux struct {
-	CreateHelmReleaseGitRepositoryStub        func(string, string, string, string, string) ([]byte, error)
+	CreateHelmReleaseGitRepositoryStub        func(string, string, string, string, string, flux.HelmReleaseOptions) ([]byte, error)
 	createHelmReleaseGitRepositoryMutex       sync.RWMutex
 	createHelmReleaseGitRepositoryArgsForCall []struct {
 		arg1 string
@@ -17,6 +16,7 @@ type FakeFlux struct {
 		arg3 string
 		arg4 string
 		arg5 string
+		arg6 flux.HelmReleaseOptions
 	}
 	createHelmReleaseGitRepositoryReturns struct {
 		result1 []byte
@@ -26,13 +26,14 @@ type FakeFlux struct {
 		result1 []byte
 		result2 error
 	}
-	CreateHelmReleaseHelmRepositoryStub        func(string, string, string, string) ([]byte, error)
+	CreateHelmReleaseHelmRepositoryStub        func(string, string, string, string, flux.HelmReleaseOptions) ([]byte, error)
 	createHelmReleaseHelmRepositoryMutex       sync.RWMutex
 	createHelmReleaseHelmRepositoryArgsForCall []struct {
 		arg1 string
 		arg2 string
 		arg3 string
 		arg4 string
+		arg5 flux.HelmReleaseOptions
 	}
 	createHelmReleaseHelmRepositoryReturns struct {
 		result1 []byte
@@ -42,13 +43,14 @@ type FakeFlux struct {
 		result1 []byte
 		result2 error
 	}
-	CreateKustomizationStub        func(string, string, string, string) ([]byte, error)
+	CreateKustomizationStub        func(string, string, string, string, flux.KustomizationOptions) ([]byte, error)
 	createKustomizationMutex       sync.RWMutex
 	createKustomizationArgsForCall []struct {
 		arg1 string
 		arg2 string
 		arg3 string
 		arg4 string
+		arg5 flux.KustomizationOptions
 	}
 	createKustomizationReturns struct {
 		result1 []byte
@@ -185,22 +187,6 @@ type FakeFlux struct {
 	setupBinMutex       sync.RWMutex
 	setupBinArgsForCall []struct {
 	}
-	SuspendOrResumeAppStub        func(v1alpha1.SuspendActionType, string, string, string) ([]byte, error)
-	suspendOrResumeAppMutex       sync.RWMutex
-	suspendOrResumeAppArgsForCall []struct {
-		arg1 v1alpha1.SuspendActionType
-		arg2 string
-		arg3 string
-		arg4 string
-	}
-	suspendOrResumeAppReturns struct {
-		result1 []byte
-		result2 error
-	}
-	suspendOrResumeAppReturnsOnCall map[int]struct {
-		result1 []byte
-		result2 error
-	}
 	UninstallStub        func(string, bool) error
 	uninstallMutex       sync.RWMutex
 	uninstallArgsForCall []struct {
@@ -217,7 +203,7 @@ type FakeFlux struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeFlux) CreateHelmReleaseGitRepository(arg1 string, arg2 string, arg3 string, arg4 string, arg5 string) ([]byte, error) {
+func (fake *FakeFlux) CreateHelmReleaseGitRepository(arg1 string, arg2 string, arg3 string, arg4 string, arg5 string, arg6 flux.HelmReleaseOptions) ([]byte, error) {
 	fake.createHelmReleaseGitRepositoryMutex.Lock()
 	ret, specificReturn := fake.createHelmReleaseGitRepositoryReturnsOnCall[len(fake.createHelmReleaseGitRepositoryArgsForCall)]
 	fake.createHelmReleaseGitRepositoryArgsForCall = append(fake.createHelmReleaseGitRepositoryArgsForCall, struct {
@@ -226,13 +212,14 @@ func (fake *FakeFlux) CreateHelmReleaseGitRepository(arg1 string, arg2 string, a
 		arg3 string
 		arg4 string
 		arg5 string
-	}{arg1, arg2, arg3, arg4, arg5})
+		arg6 flux.HelmReleaseOptions
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
 	stub := fake.CreateHelmReleaseGitRepositoryStub
 	fakeReturns := fake.createHelmReleaseGitRepositoryReturns
-	fake.recordInvocation("CreateHelmReleaseGitRepository", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.recordInvocation("CreateHelmReleaseGitRepository", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
 	fake.createHelmReleaseGitRepositoryMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4, arg5)
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -246,17 +233,17 @@ func (fake *FakeFlux) CreateHelmReleaseGitRepositoryCallCount() int {
 	return len(fake.createHelmReleaseGitRepositoryArgsForCall)
 }
 
-func (fake *FakeFlux) CreateHelmReleaseGitRepositoryCalls(stub func(string, string, string, string, string) ([]byte, error)) {
+func (fake *FakeFlux) CreateHelmReleaseGitRepositoryCalls(stub func(string, string, string, string, string, flux.HelmReleaseOptions) ([]byte, error)) {
 	fake.createHelmReleaseGitRepositoryMutex.Lock()
 	defer fake.createHelmReleaseGitRepositoryMutex.Unlock()
 	fake.CreateHelmReleaseGitRepositoryStub = stub
 }
 
-func (fake *FakeFlux) CreateHelmReleaseGitRepositoryArgsForCall(i int) (string, string, string, string, string) {
+func (fake *FakeFlux) CreateHelmReleaseGitRepositoryArgsForCall(i int) (string, string, string, string, string, flux.HelmReleaseOptions) {
 	fake.createHelmReleaseGitRepositoryMutex.RLock()
 	defer fake.createHelmReleaseGitRepositoryMutex.RUnlock()
 	argsForCall := fake.createHelmReleaseGitRepositoryArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
 }
 
 func (fake *FakeFlux) CreateHelmReleaseGitRepositoryReturns(result1 []byte, result2 error) {
@@ -285,7 +272,7 @@ func (fake *FakeFlux) CreateHelmReleaseGitRepositoryReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
-func (fake *FakeFlux) CreateHelmReleaseHelmRepository(arg1 string, arg2 string, arg3 string, arg4 string) ([]byte, error) {
+func (fake *FakeFlux) CreateHelmReleaseHelmRepository(arg1 string, arg2 string, arg3 string, arg4 string, arg5 flux.HelmReleaseOptions) ([]byte, error) {
 	fake.createHelmReleaseHelmRepositoryMutex.Lock()
 	ret, specificReturn := fake.createHelmReleaseHelmRepositoryReturnsOnCall[len(fake.createHelmReleaseHelmRepositoryArgsForCall)]
 	fake.createHelmReleaseHelmRepositoryArgsForCall = append(fake.createHelmReleaseHelmRepositoryArgsForCall, struct {
@@ -293,13 +280,14 @@ func (fake *FakeFlux) CreateHelmReleaseHelmRepository(arg1 string, arg2 string,
 		arg2 string
 		arg3 string
 		arg4 string
-	}{arg1, arg2, arg3, arg4})
+		arg5 flux.HelmReleaseOptions
+	}{arg1, arg2, arg3, arg4, arg5})
 	stub := fake.CreateHelmReleaseHelmRepositoryStub
 	fakeReturns := fake.createHelmReleaseHelmRepositoryReturns
-	fake.recordInvocation("CreateHelmReleaseHelmRepository", []interface{}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("CreateHelmReleaseHelmRepository", []interface{}{arg1, arg2, arg3, arg4, arg5})
 	fake.createHelmReleaseHelmRepositoryMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4)
+		return stub(arg1, arg2, arg3, arg4, arg5)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -313,17 +301,17 @@ func (fake *FakeFlux) CreateHelmReleaseHelmRepositoryCallCount() int {
 	return len(fake.createHelmReleaseHelmRepositoryArgsForCall)
 }
 
-func (fake *FakeFlux) CreateHelmReleaseHelmRepositoryCalls(stub func(string, string, string, string) ([]byte, error)) {
+func (fake *FakeFlux) CreateHelmReleaseHelmRepositoryCalls(stub func(string, string, string, string, flux.HelmReleaseOptions) ([]byte, error)) {
 	fake.createHelmReleaseHelmRepositoryMutex.Lock()
 	defer fake.createHelmReleaseHelmRepositoryMutex.Unlock()
 	fake.CreateHelmReleaseHelmRepositoryStub = stub
 }
 
-func (fake *FakeFlux) CreateHelmReleaseHelmRepositoryArgsForCall(i int) (string, string, string, string) {
+func (fake *FakeFlux) CreateHelmReleaseHelmRepositoryArgsForCall(i int) (string, string, string, string, flux.HelmReleaseOptions) {
 	fake.createHelmReleaseHelmRepositoryMutex.RLock()
 	defer fake.createHelmReleaseHelmRepositoryMutex.RUnlock()
 	argsForCall := fake.createHelmReleaseHelmRepositoryArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
 }
 
 func (fake *FakeFlux) CreateHelmReleaseHelmRepositoryReturns(result1 []byte, result2 error) {
@@ -352,7 +340,7 @@ func (fake *FakeFlux) CreateHelmReleaseHelmRepositoryReturnsOnCall(i int, result
 	}{result1, result2}
 }
 
-func (fake *FakeFlux) CreateKustomization(arg1 string, arg2 string, arg3 string, arg4 string) ([]byte, error) {
+func (fake *FakeFlux) CreateKustomization(arg1 string, arg2 string, arg3 string, arg4 string, arg5 flux.KustomizationOptions) ([]byte, error) {
 	fake.createKustomizationMutex.Lock()
 	ret, specificReturn := fake.createKustomizationReturnsOnCall[len(fake.createKustomizationArgsForCall)]
 	fake.createKustomizationArgsForCall = append(fake.createKustomizationArgsForCall, struct {
@@ -360,13 +348,14 @@ func (fake *FakeFlux) CreateKustomization(arg1 string, arg2 string, arg3 string,
 		arg2 string
 		arg3 string
 		arg4 string
-	}{arg1, arg2, arg3, arg4})
+		arg5 flux.KustomizationOptions
+	}{arg1, arg2, arg3, arg4, arg5})
 	stub := fake.CreateKustomizationStub
 	fakeReturns := fake.createKustomizationReturns
-	fake.recordInvocation("CreateKustomization", []interface{}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("CreateKustomization", []interface{}{arg1, arg2, arg3, arg4, arg5})
 	fake.createKustomizationMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4)
+		return stub(arg1, arg2, arg3, arg4, arg5)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -380,17 +369,17 @@ func (fake *FakeFlux) CreateKustomizationCallCount() int {
 	return len(fake.createKustomizationArgsForCall)
 }
 
-func (fake *FakeFlux) CreateKustomizationCalls(stub func(string, string, string, string) ([]byte, error)) {
+func (fake *FakeFlux) CreateKustomizationCalls(stub func(string, string, string, string, flux.KustomizationOptions) ([]byte, error)) {
 	fake.createKustomizationMutex.Lock()
 	defer fake.createKustomizationMutex.Unlock()
 	fake.CreateKustomizationStub = stub
 }
 
-func (fake *FakeFlux) CreateKustomizationArgsForCall(i int) (string, string, string, string) {
+func (fake *FakeFlux) CreateKustomizationArgsForCall(i int) (string, string, string, string, flux.KustomizationOptions) {
 	fake.createKustomizationMutex.RLock()
 	defer fake.createKustomizationMutex.RUnlock()
 	argsForCall := fake.createKustomizationArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
 }
 
 func (fake *FakeFlux) CreateKustomizationReturns(result1 []byte, result2 error) {
@@ -997,73 +986,6 @@ func (fake *FakeFlux) SetupBinCalls(stub func()) {
 	fake.SetupBinStub = stub
 }
 
-func (fake *FakeFlux) SuspendOrResumeApp(arg1 v1alpha1.SuspendActionType, arg2 string, arg3 string, arg4 string) ([]byte, error) {
-	fake.suspendOrResumeAppMutex.Lock()
-	ret, specificReturn := fake.suspendOrResumeAppReturnsOnCall[len(fake.suspendOrResumeAppArgsForCall)]
-	fake.suspendOrResumeAppArgsForCall = append(fake.suspendOrResumeAppArgsForCall, struct {
-		arg1 v1alpha1.SuspendActionType
-		arg2 string
-		arg3 string
-		arg4 string
-	}{arg1, arg2, arg3, arg4})
-	stub := fake.SuspendOrResumeAppStub
-	fakeReturns := fake.suspendOrResumeAppReturns
-	fake.recordInvocation("SuspendOrResumeApp", []interface{}{arg1, arg2, arg3, arg4})
-	fake.suspendOrResumeAppMutex.Unlock()
-	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4)
-	}
-	if specificReturn {
-		return ret.result1, ret.result2
-	}
-	return fakeReturns.result1, fakeReturns.result2
-}
-
-func (fake *FakeFlux) SuspendOrResumeAppCallCount() int {
-	fake.suspendOrResumeAppMutex.RLock()
-	defer fake.suspendOrResumeAppMutex.RUnlock()
-	return len(fake.suspendOrResumeAppArgsForCall)
-}
-
-func (fake *FakeFlux) SuspendOrResumeAppCalls(stub func(v1alpha1.SuspendActionType, string, string, string) ([]byte, error)) {
-	fake.suspendOrResumeAppMutex.Lock()
-	defer fake.suspendOrResumeAppMutex.Unlock()
-	fake.SuspendOrResumeAppStub = stub
-}
-
-func (fake *FakeFlux) SuspendOrResumeAppArgsForCall(i int) (v1alpha1.SuspendActionType, string, string, string) {
-	fake.suspendOrResumeAppMutex.RLock()
-	defer fake.suspendOrResumeAppMutex.RUnlock()
-	argsForCall := fake.suspendOrResumeAppArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
-}
-
-func (fake *FakeFlux) SuspendOrResumeAppReturns(result1 []byte, result2 error) {
-	fake.suspendOrResumeAppMutex.Lock()
-	defer fake.suspendOrResumeAppMutex.Unlock()
-	fake.SuspendOrResumeAppStub = nil
-	fake.suspendOrResumeAppReturns = struct {
-		result1 []byte
-		result2 error
-	}{result1, result2}
-}
-
-func (fake *FakeFlux) SuspendOrResumeAppReturnsOnCall(i int, result1 []byte, result2 error) {
-	fake.suspendOrResumeAppMutex.Lock()
-	defer fake.suspendOrResumeAppMutex.Unlock()
-	fake.SuspendOrResumeAppStub = nil
-	if fake.suspendOrResumeAppReturnsOnCall == nil {
-		fake.suspendOrResumeAppReturnsOnCall = make(map[int]struct {
-			result1 []byte
-			result2 error
-		})
-	}
-	fake.suspendOrResumeAppReturnsOnCall[i] = struct {
-		result1 []byte
-		result2 error
-	}{result1, result2}
-}
-
 func (fake *FakeFlux) Uninstall(arg1 string, arg2 bool) error {
 	fake.uninstallMutex.Lock()
 	ret, specificReturn := fake.uninstallReturnsOnCall[len(fake.uninstallArgsForCall)]
@@ -1155,8 +1077,6 @@ func (fake *FakeFlux) Invocations() map[string][][]interface{} {
 	defer fake.installMutex.RUnlock()
 	fake.setupBinMutex.RLock()
 	defer fake.setupBinMutex.RUnlock()
-	fake.suspendOrResumeAppMutex.RLock()
-	defer fake.suspendOrResumeAppMutex.RUnlock()
 	fake.uninstallMutex.RLock()
 	defer fake.uninstallMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
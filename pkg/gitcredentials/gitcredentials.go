@@ -0,0 +1,37 @@
+// Package gitcredentials names the Secrets holding the credentials the git repositories of apps are accessed with,
+// and their entries. It is shared by the app service, which points the sources of apps at the Secrets,
+// and the auth service, which reads and writes them.
+package gitcredentials
+
+import (
+	"crypto/md5"
+	"fmt"
+)
+
+// The entries of the git credentials Secrets. The https Secrets are read by Flux as they are,
+// the GitHub App Secrets are exchanged for installation tokens stored in https Secrets.
+const (
+	UsernameKey                       = "username"
+	PasswordKey                       = "password"
+	GitHubAppIDKey                    = "githubAppID"
+	GitHubAppInstallationIDKey        = "githubAppInstallationID"
+	GitHubAppPrivateKeyKey            = "githubAppPrivateKey"
+	GitHubAppTokenExpiresAtAnnotation = "wego.weave.works/token-expires-at"
+
+	// GitHubAppTokenUsername is the username git sends with installation tokens
+	GitHubAppTokenUsername = "x-access-token"
+)
+
+// maxSecretNameLength is the length of the longest names hashed the way the other resources of an app are
+const maxSecretNameLength = 63
+
+// GitHubAppTokenSecretName returns the name of the Secret holding the installation token
+// of the GitHub App whose credentials are in the given Secret
+func GitHubAppTokenSecretName(appSecretName string) string {
+	name := appSecretName + "-token"
+	if len(name) <= maxSecretNameLength {
+		return name
+	}
+
+	return fmt.Sprintf("wego-%x", md5.Sum([]byte(name)))
+}
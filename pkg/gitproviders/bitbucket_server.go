@@ -0,0 +1,492 @@
+package gitproviders
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+const (
+	bitbucketServerAPIPath  = "/rest/api/1.0"
+	bitbucketServerKeysPath = "/rest/keys/1.0"
+	// bitbucketServerSSHPort is the port Bitbucket Server serves ssh clones on unless configured otherwise
+	bitbucketServerSSHPort = "7999"
+)
+
+// bitbucketServerProvider implements GitProvider against the Bitbucket Server REST API 1.0.
+// Bitbucket Server groups repositories in projects, which play the role of organizations.
+// The repositories of a user live in the personal project "~<user>".
+type bitbucketServerProvider struct {
+	domain  string
+	baseURL string
+	api     restClient
+}
+
+type bitbucketServerProject struct {
+	Key string `json:"key"`
+}
+
+type bitbucketServerRepository struct {
+	Slug        string                 `json:"slug"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	Public      bool                   `json:"public"`
+	Project     bitbucketServerProject `json:"project"`
+}
+
+type bitbucketServerRef struct {
+	ID           string `json:"id"`
+	DisplayID    string `json:"displayId"`
+	LatestCommit string `json:"latestCommit"`
+}
+
+type bitbucketServerSSHKey struct {
+	Key struct {
+		ID    int64  `json:"id,omitempty"`
+		Text  string `json:"text"`
+		Label string `json:"label"`
+	} `json:"key"`
+	Permission string `json:"permission"`
+}
+
+type bitbucketServerCommit struct {
+	ID      string `json:"id"`
+	Message string `json:"message"`
+	Author  struct {
+		Name         string `json:"name"`
+		EmailAddress string `json:"emailAddress"`
+	} `json:"author"`
+	AuthorTimestamp int64 `json:"authorTimestamp"`
+}
+
+type bitbucketServerPullRequest struct {
+	ID    int64  `json:"id"`
+	Title string `json:"title"`
+	Links struct {
+		Self []struct {
+			Href string `json:"href"`
+		} `json:"self"`
+	} `json:"links"`
+}
+
+func newBitbucketServerProvider(client *http.Client, baseURL, domain, token string) bitbucketServerProvider {
+	return bitbucketServerProvider{
+		domain:  domain,
+		baseURL: baseURL,
+		api: newRestClient(client, baseURL, func(req *http.Request) {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}),
+	}
+}
+
+// bitbucketServerProjectKey returns the key of the project holding the repositories of the owner
+func bitbucketServerProjectKey(owner string, accountType ProviderAccountType) string {
+	if accountType == AccountTypeUser {
+		return "~" + strings.TrimPrefix(owner, "~")
+	}
+
+	return owner
+}
+
+func bitbucketServerRepoPath(projectKey, repoName string) string {
+	return fmt.Sprintf("/projects/%s/repos/%s", url.PathEscape(projectKey), url.PathEscape(repoName))
+}
+
+func (p bitbucketServerProvider) repoPath(owner, repoName string) (string, error) {
+	accountType, err := p.GetAccountType(owner)
+	if err != nil {
+		return "", err
+	}
+
+	return bitbucketServerRepoPath(bitbucketServerProjectKey(owner, accountType), repoName), nil
+}
+
+func (p bitbucketServerProvider) CreateRepository(name string, owner string, private bool) error {
+	accountType, err := p.GetAccountType(owner)
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("%s/projects/%s/repos", bitbucketServerAPIPath, url.PathEscape(bitbucketServerProjectKey(owner, accountType)))
+	body := map[string]interface{}{
+		"name":   name,
+		"public": !private,
+	}
+
+	if err := p.api.do(http.MethodPost, path, body, nil); err != nil {
+		return fmt.Errorf("error creating repo %w", err)
+	}
+
+	return nil
+}
+
+func (p bitbucketServerProvider) RepositoryExists(name string, owner string) (bool, error) {
+	if _, err := p.getRepository(owner, name); err != nil {
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (p bitbucketServerProvider) DeployKeyExists(owner, repoName string) (bool, error) {
+	repoPath, err := p.repoPath(owner, repoName)
+	if err != nil {
+		return false, err
+	}
+
+	keys := struct {
+		Values []bitbucketServerSSHKey `json:"values"`
+	}{}
+
+	if err := p.api.do(http.MethodGet, bitbucketServerKeysPath+repoPath+"/ssh?limit=100", nil, &keys); err != nil {
+		return false, fmt.Errorf("error getting deploy key %s for repo %s. %s", deployKeyName, repoName, err)
+	}
+
+	for _, key := range keys.Values {
+		if key.Key.Label == deployKeyName {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (p bitbucketServerProvider) UploadDeployKey(owner, repoName string, deployKey []byte) error {
+	repoPath, err := p.repoPath(owner, repoName)
+	if err != nil {
+		return err
+	}
+
+	key := bitbucketServerSSHKey{Permission: "REPO_WRITE"}
+	key.Key.Text = strings.TrimSpace(string(deployKey))
+	key.Key.Label = deployKeyName
+
+	if err := p.api.do(http.MethodPost, bitbucketServerKeysPath+repoPath+"/ssh", key, nil); err != nil {
+		return fmt.Errorf("error uploading deploy key %s", err)
+	}
+
+	return nil
+}
+
+func (p bitbucketServerProvider) DeleteDeployKey(owner, repoName string, deployKey []byte) error {
+	repoPath, err := p.repoPath(owner, repoName)
+	if err != nil {
+		return err
+	}
+
+	keys := struct {
+		Values []bitbucketServerSSHKey `json:"values"`
+	}{}
+
+	if err := p.api.do(http.MethodGet, bitbucketServerKeysPath+repoPath+"/ssh?limit=100", nil, &keys); err != nil {
+		return fmt.Errorf("error getting deploy key %s for repo %s. %s", deployKeyName, repoName, err)
+	}
+
+	for _, key := range keys.Values {
+		if key.Key.Label != deployKeyName || !sameDeployKey([]byte(key.Key.Text), deployKey) {
+			continue
+		}
+
+		path := bitbucketServerKeysPath + repoPath + "/ssh/" + strconv.FormatInt(key.Key.ID, 10)
+		if err := p.api.do(http.MethodDelete, path, nil, nil); err != nil {
+			return fmt.Errorf("error deleting deploy key %s", err)
+		}
+	}
+
+	return nil
+}
+
+func (p bitbucketServerProvider) GetAccountType(owner string) (ProviderAccountType, error) {
+	if strings.HasPrefix(owner, "~") {
+		return AccountTypeUser, nil
+	}
+
+	if err := p.api.do(http.MethodGet, bitbucketServerAPIPath+"/projects/"+url.PathEscape(owner), nil, nil); err != nil {
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			return AccountTypeUser, nil
+		}
+
+		return "", fmt.Errorf("could not get account type %s", err)
+	}
+
+	return AccountTypeOrg, nil
+}
+
+func (p bitbucketServerProvider) GetRepoInfo(accountType ProviderAccountType, owner string, repoName string) (*gitprovider.RepositoryInfo, error) {
+	repoPath := bitbucketServerRepoPath(bitbucketServerProjectKey(owner, accountType), repoName)
+
+	repo := bitbucketServerRepository{}
+	if err := p.api.do(http.MethodGet, bitbucketServerAPIPath+repoPath, nil, &repo); err != nil {
+		return nil, fmt.Errorf("error getting repository %w", err)
+	}
+
+	visibility := gitprovider.RepositoryVisibilityPrivate
+	if repo.Public {
+		visibility = gitprovider.RepositoryVisibilityPublic
+	}
+
+	info := NewRepositoryInfo(repo.Description, visibility)
+
+	defaultBranch := bitbucketServerRef{}
+	if err := p.api.do(http.MethodGet, bitbucketServerAPIPath+repoPath+"/branches/default", nil, &defaultBranch); err != nil {
+		// An empty repository has no default branch yet
+		if !errors.Is(err, gitprovider.ErrNotFound) {
+			return nil, fmt.Errorf("error getting default branch %w", err)
+		}
+	}
+
+	if defaultBranch.DisplayID != "" {
+		info.DefaultBranch = gitprovider.StringVar(defaultBranch.DisplayID)
+	}
+
+	return &info, nil
+}
+
+func (p bitbucketServerProvider) GetRepoInfoFromUrl(repoUrl string) (*gitprovider.RepositoryInfo, error) {
+	normalizedUrl, err := NewNormalizedRepoURL(repoUrl)
+	if err != nil {
+		return nil, fmt.Errorf("error normalizing url: %w", err)
+	}
+
+	accountType, err := p.GetAccountType(normalizedUrl.Owner())
+	if err != nil {
+		return nil, err
+	}
+
+	return p.GetRepoInfo(accountType, normalizedUrl.Owner(), normalizedUrl.RepositoryName())
+}
+
+func (p bitbucketServerProvider) GetDefaultBranch(url string) (string, error) {
+	repoInfo, err := p.GetRepoInfoFromUrl(url)
+	if err != nil {
+		return "", err
+	}
+
+	if repoInfo.DefaultBranch != nil {
+		return *repoInfo.DefaultBranch, nil
+	}
+
+	return "main", nil
+}
+
+func (p bitbucketServerProvider) GetRepoVisibility(url string) (*gitprovider.RepositoryVisibility, error) {
+	repoInfo, err := p.GetRepoInfoFromUrl(url)
+	if err != nil {
+		return nil, err
+	}
+
+	return getVisibilityFromRepoInfo(url, repoInfo)
+}
+
+func (p bitbucketServerProvider) CreatePullRequestToUserRepo(userRepRef gitprovider.UserRepositoryRef, targetBranch string, newBranch string, files []gitprovider.CommitFile, commitMessage string, prTitle string, prDescription string) (gitprovider.PullRequest, error) {
+	projectKey := bitbucketServerProjectKey(userRepRef.UserLogin, AccountTypeUser)
+
+	return p.createPullRequest(projectKey, userRepRef.RepositoryName, targetBranch, newBranch, files, commitMessage, prTitle, prDescription)
+}
+
+func (p bitbucketServerProvider) CreatePullRequestToOrgRepo(orgRepRef gitprovider.OrgRepositoryRef, targetBranch string, newBranch string, files []gitprovider.CommitFile, commitMessage string, prTitle string, prDescription string) (gitprovider.PullRequest, error) {
+	projectKey := bitbucketServerProjectKey(orgRepRef.Organization, AccountTypeOrg)
+
+	return p.createPullRequest(projectKey, orgRepRef.RepositoryName, targetBranch, newBranch, files, commitMessage, prTitle, prDescription)
+}
+
+func (p bitbucketServerProvider) createPullRequest(projectKey, repoName, targetBranch, newBranch string, files []gitprovider.CommitFile, commitMessage, prTitle, prDescription string) (gitprovider.PullRequest, error) {
+	repoPath := bitbucketServerAPIPath + bitbucketServerRepoPath(projectKey, repoName)
+	repoRef := projectKey + "/" + repoName
+
+	if targetBranch == "" {
+		defaultBranch := bitbucketServerRef{}
+		if err := p.api.do(http.MethodGet, repoPath+"/branches/default", nil, &defaultBranch); err != nil {
+			return nil, fmt.Errorf("error getting info for repo [%s] err [%s]", repoRef, err)
+		}
+
+		targetBranch = defaultBranch.DisplayID
+	}
+
+	branch := bitbucketServerRef{}
+	body := map[string]string{
+		"name":       newBranch,
+		"startPoint": "refs/heads/" + targetBranch,
+	}
+
+	if err := p.api.do(http.MethodPost, repoPath+"/branches", body, &branch); err != nil {
+		return nil, fmt.Errorf("error creating branch [%s] for repo [%s] err [%s]", newBranch, repoRef, err)
+	}
+
+	head := branch.LatestCommit
+
+	for _, file := range files {
+		commit, err := p.commitFile(repoPath, newBranch, head, commitMessage, file)
+		if err != nil {
+			return nil, fmt.Errorf("error creating commit for branch [%s] for repo [%s] err [%s]", newBranch, repoRef, err)
+		}
+
+		head = commit
+	}
+
+	repository := map[string]interface{}{
+		"slug":    repoName,
+		"project": bitbucketServerProject{Key: projectKey},
+	}
+
+	pr := bitbucketServerPullRequest{}
+	prBody := map[string]interface{}{
+		"title":       prTitle,
+		"description": prDescription,
+		"fromRef":     map[string]interface{}{"id": "refs/heads/" + newBranch, "repository": repository},
+		"toRef":       map[string]interface{}{"id": "refs/heads/" + targetBranch, "repository": repository},
+	}
+
+	if err := p.api.do(http.MethodPost, repoPath+"/pull-requests", prBody, &pr); err != nil {
+		return nil, fmt.Errorf("error creating pull request [%s] for branch [%s] for repo [%s] err [%s]", prTitle, newBranch, repoRef, err)
+	}
+
+	webURL := ""
+	if len(pr.Links.Self) > 0 {
+		webURL = pr.Links.Self[0].Href
+	}
+
+	return restPullRequest{info: gitprovider.PullRequestInfo{WebURL: webURL}, object: pr}, nil
+}
+
+// commitFile commits a single file on top of the given commit and returns the new commit id.
+// The REST API can only commit one file at a time and cannot delete files.
+func (p bitbucketServerProvider) commitFile(repoPath, branch, sourceCommit, message string, file gitprovider.CommitFile) (string, error) {
+	if file.Path == nil {
+		return "", fmt.Errorf("file path cannot be empty")
+	}
+
+	if file.Content == nil {
+		return "", fmt.Errorf("deleting %s is not supported by Bitbucket Server", *file.Path)
+	}
+
+	path := repoPath + "/browse/" + escapePath(*file.Path)
+
+	exists := true
+	if err := p.api.do(http.MethodGet, path+"?type=true&at="+url.QueryEscape("refs/heads/"+branch), nil, nil); err != nil {
+		if !errors.Is(err, gitprovider.ErrNotFound) {
+			return "", err
+		}
+
+		exists = false
+	}
+
+	var buf bytes.Buffer
+
+	form := multipart.NewWriter(&buf)
+	fields := map[string]string{
+		"content": *file.Content,
+		"message": message,
+		"branch":  branch,
+	}
+
+	// The source commit is only accepted when an existing file is edited
+	if exists {
+		fields["sourceCommitId"] = sourceCommit
+	}
+
+	for name, value := range fields {
+		if err := form.WriteField(name, value); err != nil {
+			return "", fmt.Errorf("could not write form field %s: %w", name, err)
+		}
+	}
+
+	if err := form.Close(); err != nil {
+		return "", fmt.Errorf("could not write form: %w", err)
+	}
+
+	req, err := p.api.newRequest(http.MethodPut, path, &buf)
+	if err != nil {
+		return "", err
+	}
+
+	req.Header.Set("Content-Type", form.FormDataContentType())
+
+	commit := bitbucketServerCommit{}
+	if err := p.api.send(req, &commit); err != nil {
+		return "", err
+	}
+
+	return commit.ID, nil
+}
+
+func (p bitbucketServerProvider) GetCommitsFromUserRepo(userRepRef gitprovider.UserRepositoryRef, targetBranch string, pageSize int, pageToken int) ([]gitprovider.Commit, error) {
+	projectKey := bitbucketServerProjectKey(userRepRef.UserLogin, AccountTypeUser)
+
+	return p.getCommits(projectKey, userRepRef.RepositoryName, targetBranch, pageSize, pageToken)
+}
+
+func (p bitbucketServerProvider) GetCommitsFromOrgRepo(orgRepRef gitprovider.OrgRepositoryRef, targetBranch string, pageSize int, pageToken int) ([]gitprovider.Commit, error) {
+	projectKey := bitbucketServerProjectKey(orgRepRef.Organization, AccountTypeOrg)
+
+	return p.getCommits(projectKey, orgRepRef.RepositoryName, targetBranch, pageSize, pageToken)
+}
+
+func (p bitbucketServerProvider) getCommits(projectKey, repoName, targetBranch string, pageSize int, pageToken int) ([]gitprovider.Commit, error) {
+	// Page tokens are page numbers where 0 and 1 both mean the first page, like they do for GitHub
+	page := pageToken
+	if page < 1 {
+		page = 1
+	}
+
+	query := url.Values{
+		"until": {targetBranch},
+		"limit": {strconv.Itoa(pageSize)},
+		"start": {strconv.Itoa((page - 1) * pageSize)},
+	}
+
+	repoPath := bitbucketServerRepoPath(projectKey, repoName)
+	commits := struct {
+		Values []bitbucketServerCommit `json:"values"`
+	}{}
+
+	if err := p.api.do(http.MethodGet, bitbucketServerAPIPath+repoPath+"/commits?"+query.Encode(), nil, &commits); err != nil {
+		return nil, fmt.Errorf("error getting commits for repo [%s/%s] err [%s]", projectKey, repoName, err)
+	}
+
+	result := make([]gitprovider.Commit, 0, len(commits.Values))
+
+	for _, c := range commits.Values {
+		result = append(result, restCommit{
+			info: gitprovider.CommitInfo{
+				Sha:       c.ID,
+				Author:    c.Author.Name,
+				Message:   c.Message,
+				CreatedAt: millisToTime(c.AuthorTimestamp),
+				URL:       fmt.Sprintf("%s%s/commits/%s", p.baseURL, repoPath, c.ID),
+			},
+			object: c,
+		})
+	}
+
+	return result, nil
+}
+
+func (p bitbucketServerProvider) GetProviderDomain() string {
+	return p.domain
+}
+
+func (p bitbucketServerProvider) getRepository(owner, repoName string) (*bitbucketServerRepository, error) {
+	repoPath, err := p.repoPath(owner, repoName)
+	if err != nil {
+		return nil, err
+	}
+
+	repo := &bitbucketServerRepository{}
+	if err := p.api.do(http.MethodGet, bitbucketServerAPIPath+repoPath, nil, repo); err != nil {
+		return nil, fmt.Errorf("error getting repository %w", err)
+	}
+
+	return repo, nil
+}
@@ -0,0 +1,244 @@
+package gitproviders
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const bitbucketServerCommitsResponse = `{
+	"values": [{
+		"id": "def0123abdef0123abdef0123abdef0123abdef0",
+		"message": "Update podinfo",
+		"author": {"name": "jdoe", "emailAddress": "jane@example.com"},
+		"authorTimestamp": 1632147313000
+	}],
+	"size": 1,
+	"isLastPage": true
+}`
+
+// bitbucketServerStandIn records the calls made to a stand-in Bitbucket Server
+type bitbucketServerStandIn struct {
+	server   *httptest.Server
+	keys     []bitbucketServerSSHKey
+	files    map[string]string
+	branches map[string]string
+	pulls    []map[string]interface{}
+	auth     []string
+}
+
+func newBitbucketServerStandIn() *bitbucketServerStandIn {
+	s := &bitbucketServerStandIn{
+		files:    map[string]string{"apps/existing.yaml": "old"},
+		branches: map[string]string{},
+	}
+
+	repoPath := "/rest/api/1.0/projects/WEAVE/repos/podinfo"
+	head := "abc"
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/rest/api/1.0/projects/WEAVE", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, bitbucketServerProject{Key: "WEAVE"})
+	})
+	mux.HandleFunc(repoPath, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, bitbucketServerRepository{Slug: "podinfo", Name: "podinfo", Public: true})
+	})
+	mux.HandleFunc(repoPath+"/branches/default", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, bitbucketServerRef{ID: "refs/heads/develop", DisplayID: "develop"})
+	})
+	mux.HandleFunc(repoPath+"/branches", func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]string{}
+		Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+		s.branches[body["name"]] = body["startPoint"]
+		writeJSON(w, bitbucketServerRef{ID: "refs/heads/" + body["name"], DisplayID: body["name"], LatestCommit: head})
+	})
+	mux.HandleFunc("/rest/keys/1.0/projects/WEAVE/repos/podinfo/ssh", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			key := bitbucketServerSSHKey{}
+			Expect(json.NewDecoder(r.Body).Decode(&key)).To(Succeed())
+			s.keys = append(s.keys, key)
+			writeJSON(w, key)
+
+			return
+		}
+
+		writeJSON(w, map[string]interface{}{"values": s.keys})
+	})
+	mux.HandleFunc("/rest/keys/1.0/projects/WEAVE/repos/podinfo/ssh/", func(w http.ResponseWriter, r *http.Request) {
+		Expect(r.Method).To(Equal(http.MethodDelete))
+
+		id := strings.TrimPrefix(r.URL.Path, "/rest/keys/1.0/projects/WEAVE/repos/podinfo/ssh/")
+		for i, key := range s.keys {
+			if strconv.FormatInt(key.Key.ID, 10) == id {
+				s.keys = append(s.keys[:i], s.keys[i+1:]...)
+				w.WriteHeader(http.StatusNoContent)
+
+				return
+			}
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+	})
+	mux.HandleFunc(repoPath+"/browse/", func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, repoPath+"/browse/")
+
+		if r.Method == http.MethodGet {
+			if _, ok := s.files[path]; !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			writeJSON(w, map[string]string{"type": "FILE"})
+
+			return
+		}
+
+		Expect(r.ParseMultipartForm(1024)).To(Succeed())
+		Expect(r.FormValue("branch")).To(Equal("wego-123"))
+
+		if _, ok := s.files[path]; ok {
+			Expect(r.FormValue("sourceCommitId")).To(Equal(head))
+		} else {
+			Expect(r.MultipartForm.Value).NotTo(HaveKey("sourceCommitId"))
+		}
+
+		s.files[path] = r.FormValue("content")
+		head = head + "1"
+		writeJSON(w, bitbucketServerCommit{ID: head})
+	})
+	mux.HandleFunc(repoPath+"/pull-requests", func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]interface{}{}
+		Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+		s.pulls = append(s.pulls, body)
+
+		pr := bitbucketServerPullRequest{ID: 1}
+		pr.Links.Self = append(pr.Links.Self, struct {
+			Href string `json:"href"`
+		}{Href: "https://bitbucket.example.com/projects/WEAVE/repos/podinfo/pull-requests/1"})
+		writeJSON(w, pr)
+	})
+	mux.HandleFunc("/rest/api/1.0/projects/~JDOE/repos/podinfo/commits", func(w http.ResponseWriter, r *http.Request) {
+		Expect(r.URL.Query().Get("until")).To(Equal("main"))
+		Expect(r.URL.Query().Get("limit")).To(Equal("10"))
+		Expect(r.URL.Query().Get("start")).To(Equal("10"))
+
+		_, _ = w.Write([]byte(bitbucketServerCommitsResponse))
+	})
+
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.auth = append(s.auth, r.Header.Get("Authorization"))
+		mux.ServeHTTP(w, r)
+	}))
+
+	return s
+}
+
+var _ = Describe("bitbucketServerProvider", func() {
+	var (
+		standIn  *bitbucketServerStandIn
+		provider GitProvider
+	)
+
+	BeforeEach(func() {
+		standIn = newBitbucketServerStandIn()
+		provider = newBitbucketServerProvider(standIn.server.Client(), standIn.server.URL, "bitbucket.example.com", "a-token")
+	})
+
+	AfterEach(func() {
+		standIn.server.Close()
+	})
+
+	It("authenticates with the token", func() {
+		_, err := provider.GetAccountType("WEAVE")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(standIn.auth).To(ConsistOf("Bearer a-token"))
+	})
+
+	It("treats projects as organizations", func() {
+		Expect(provider.GetAccountType("WEAVE")).To(Equal(AccountTypeOrg))
+		Expect(provider.GetAccountType("jdoe")).To(Equal(AccountTypeUser))
+		Expect(provider.GetAccountType("~jdoe")).To(Equal(AccountTypeUser))
+	})
+
+	It("gets the repository info", func() {
+		info, err := provider.GetRepoInfo(AccountTypeOrg, "WEAVE", "podinfo")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*info.DefaultBranch).To(Equal("develop"))
+		Expect(*info.Visibility).To(Equal(gitprovider.RepositoryVisibilityPublic))
+
+		Expect(provider.RepositoryExists("podinfo", "WEAVE")).To(BeTrue())
+		Expect(provider.RepositoryExists("missing", "WEAVE")).To(BeFalse())
+	})
+
+	It("uploads the deploy key as an access key", func() {
+		Expect(provider.DeployKeyExists("WEAVE", "podinfo")).To(BeFalse())
+
+		Expect(provider.UploadDeployKey("WEAVE", "podinfo", []byte("ssh-ed25519 AAAA\n"))).To(Succeed())
+		Expect(standIn.keys).To(HaveLen(1))
+		Expect(standIn.keys[0].Key.Label).To(Equal(deployKeyName))
+		Expect(standIn.keys[0].Key.Text).To(Equal("ssh-ed25519 AAAA"))
+		Expect(standIn.keys[0].Permission).To(Equal("REPO_WRITE"))
+
+		Expect(provider.DeployKeyExists("WEAVE", "podinfo")).To(BeTrue())
+	})
+
+	It("deletes the access key with the public key", func() {
+		Expect(provider.UploadDeployKey("WEAVE", "podinfo", []byte("ssh-ed25519 AAAA old"))).To(Succeed())
+		Expect(provider.UploadDeployKey("WEAVE", "podinfo", []byte("ssh-ed25519 BBBB new"))).To(Succeed())
+		standIn.keys[0].Key.ID = 1
+		standIn.keys[1].Key.ID = 2
+
+		Expect(provider.DeleteDeployKey("WEAVE", "podinfo", []byte("ssh-ed25519 AAAA"))).To(Succeed())
+		Expect(standIn.keys).To(HaveLen(1))
+		Expect(standIn.keys[0].Key.Text).To(Equal("ssh-ed25519 BBBB new"))
+	})
+
+	It("creates a pull request against the default branch", func() {
+		files := []gitprovider.CommitFile{
+			{Path: gitprovider.StringVar("apps/new.yaml"), Content: gitprovider.StringVar("new")},
+			{Path: gitprovider.StringVar("apps/existing.yaml"), Content: gitprovider.StringVar("updated")},
+		}
+		ref := NewOrgRepositoryRef("bitbucket.example.com", "WEAVE", "podinfo")
+
+		pr, err := provider.CreatePullRequestToOrgRepo(ref, "", "wego-123", files, "Add app", "gitops app add", "Added yamls")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pr.Get().WebURL).To(Equal("https://bitbucket.example.com/projects/WEAVE/repos/podinfo/pull-requests/1"))
+
+		Expect(standIn.branches).To(Equal(map[string]string{"wego-123": "refs/heads/develop"}))
+		Expect(standIn.files).To(Equal(map[string]string{"apps/new.yaml": "new", "apps/existing.yaml": "updated"}))
+		Expect(standIn.pulls).To(HaveLen(1))
+		Expect(standIn.pulls[0]["title"]).To(Equal("gitops app add"))
+		Expect(standIn.pulls[0]["fromRef"]).To(HaveKeyWithValue("id", "refs/heads/wego-123"))
+		Expect(standIn.pulls[0]["toRef"]).To(HaveKeyWithValue("id", "refs/heads/develop"))
+	})
+
+	It("refuses to delete files", func() {
+		files := []gitprovider.CommitFile{{Path: gitprovider.StringVar("apps/existing.yaml")}}
+		ref := NewOrgRepositoryRef("bitbucket.example.com", "WEAVE", "podinfo")
+
+		_, err := provider.CreatePullRequestToOrgRepo(ref, "main", "wego-123", files, "Remove app", "gitops app remove", "")
+		Expect(err).To(MatchError(ContainSubstring("deleting apps/existing.yaml is not supported")))
+	})
+
+	It("lists the commits of a personal repository", func() {
+		ref := NewUserRepositoryRef("bitbucket.example.com", "JDOE", "podinfo")
+
+		commits, err := provider.GetCommitsFromUserRepo(ref, "main", 10, 2)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(commits).To(HaveLen(1))
+
+		info := commits[0].Get()
+		Expect(info.Sha).To(Equal("def0123abdef0123abdef0123abdef0123abdef0"))
+		Expect(info.Author).To(Equal("jdoe"))
+		Expect(info.Message).To(Equal("Update podinfo"))
+		Expect(info.URL).To(Equal(standIn.server.URL + "/projects/~JDOE/repos/podinfo/commits/def0123abdef0123abdef0123abdef0123abdef0"))
+		Expect(info.CreatedAt.Unix()).To(Equal(int64(1632147313)))
+	})
+})
@@ -58,6 +58,10 @@ func (p *dryrunProvider) UploadDeployKey(owner, repoName string, deployKey []byt
 	return nil
 }
 
+func (p *dryrunProvider) DeleteDeployKey(owner, repoName string, deployKey []byte) error {
+	return nil
+}
+
 func (p *dryrunProvider) CreatePullRequestToUserRepo(userRepRef gitprovider.UserRepositoryRef, targetBranch string, newBranch string, files []gitprovider.CommitFile, commitMessage string, prTitle string, prDescription string) (gitprovider.PullRequest, error) {
 	return nil, nil
 }
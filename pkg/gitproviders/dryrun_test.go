@@ -73,6 +73,12 @@ var _ = Describe("DryRun", func() {
 		})
 	})
 
+	Describe("DeleteDeployKey", func() {
+		It("returns nil", func() {
+			Expect(dryRunProvider.DeleteDeployKey("", "", []byte{})).To(Succeed())
+		})
+	})
+
 	Describe("CreatePullRequestToUserRepo", func() {
 		It("returns nil", func() {
 			res, err := dryRunProvider.CreatePullRequestToUserRepo(gitprovider.UserRepositoryRef{}, "", "", nil, "", "", "")
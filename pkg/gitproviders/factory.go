@@ -2,6 +2,8 @@ package gitproviders
 
 import (
 	"fmt"
+	"os"
+	"strings"
 
 	"github.com/fluxcd/go-git-providers/github"
 	"github.com/fluxcd/go-git-providers/gitlab"
@@ -12,9 +14,18 @@ import (
 type GitProviderName string
 
 const (
-	GitProviderGitHub GitProviderName = "github"
-	GitProviderGitLab GitProviderName = "gitlab"
-	tokenTypeOauth    string          = "oauth2"
+	GitProviderGitHub          GitProviderName = "github"
+	GitProviderGitLab          GitProviderName = "gitlab"
+	GitProviderBitbucketServer GitProviderName = "bitbucket-server"
+	GitProviderGitea           GitProviderName = "gitea"
+	tokenTypeOauth             string          = "oauth2"
+)
+
+// Self-hosted providers can't be recognized from a repository URL alone.
+// These environment variables hold a comma separated list of the hostnames serving each of them.
+const (
+	BitbucketServerHostnameEnvVar = "BITBUCKET_SERVER_HOSTNAME"
+	GiteaHostnameEnvVar           = "GITEA_HOSTNAME"
 )
 
 // Config defines the configuration for connecting to a GitProvider.
@@ -31,6 +42,44 @@ type Config struct {
 	Token string
 }
 
+// selfHostedProviderForHostname looks up the self-hosted provider configured for hostname
+func selfHostedProviderForHostname(hostname string) (GitProviderName, bool) {
+	for providerName, envVar := range map[GitProviderName]string{
+		GitProviderBitbucketServer: BitbucketServerHostnameEnvVar,
+		GitProviderGitea:           GiteaHostnameEnvVar,
+	} {
+		for _, h := range strings.Split(os.Getenv(envVar), ",") {
+			if strings.EqualFold(strings.TrimSpace(h), hostname) {
+				return providerName, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// buildSelfHostedGitProvider returns the GitProvider of the providers go-git-providers has no client for
+func buildSelfHostedGitProvider(config Config) (GitProvider, error) {
+	if config.Token == "" {
+		return nil, fmt.Errorf("no git provider token present")
+	}
+
+	if config.Hostname == "" {
+		return nil, fmt.Errorf("the hostname of a %s provider is required", config.Provider)
+	}
+
+	baseURL := "https://" + config.Hostname
+
+	switch config.Provider {
+	case GitProviderBitbucketServer:
+		return newBitbucketServerProvider(nil, baseURL, config.Hostname, config.Token), nil
+	case GitProviderGitea:
+		return newGiteaProvider(nil, baseURL, config.Hostname, config.Token), nil
+	default:
+		return nil, fmt.Errorf("unsupported Git provider '%s'", config.Provider)
+	}
+}
+
 func buildGitProvider(config Config) (gitprovider.Client, string, error) {
 	if config.Token == "" {
 		return nil, "", fmt.Errorf("no git provider token present")
@@ -0,0 +1,378 @@
+package gitproviders
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+const giteaAPIPath = "/api/v1"
+
+// giteaProvider implements GitProvider against the Gitea API v1
+type giteaProvider struct {
+	domain string
+	api    restClient
+}
+
+type giteaRepository struct {
+	Name          string `json:"name"`
+	Description   string `json:"description"`
+	Private       bool   `json:"private"`
+	DefaultBranch string `json:"default_branch"`
+	HTMLURL       string `json:"html_url"`
+}
+
+type giteaDeployKey struct {
+	ID       int64  `json:"id,omitempty"`
+	Title    string `json:"title"`
+	Key      string `json:"key"`
+	ReadOnly bool   `json:"read_only"`
+}
+
+type giteaCommit struct {
+	SHA     string `json:"sha"`
+	HTMLURL string `json:"html_url"`
+	Commit  struct {
+		Message string `json:"message"`
+		Tree    struct {
+			SHA string `json:"sha"`
+		} `json:"tree"`
+		Author struct {
+			Name  string    `json:"name"`
+			Email string    `json:"email"`
+			Date  time.Time `json:"date"`
+		} `json:"author"`
+	} `json:"commit"`
+}
+
+type giteaPullRequest struct {
+	Number  int64  `json:"number"`
+	Title   string `json:"title"`
+	HTMLURL string `json:"html_url"`
+}
+
+type giteaContent struct {
+	SHA string `json:"sha"`
+}
+
+func newGiteaProvider(client *http.Client, baseURL, domain, token string) giteaProvider {
+	return giteaProvider{
+		domain: domain,
+		api: newRestClient(client, baseURL+giteaAPIPath, func(req *http.Request) {
+			req.Header.Set("Authorization", "token "+token)
+		}),
+	}
+}
+
+func giteaRepoPath(owner, repoName string) string {
+	return fmt.Sprintf("/repos/%s/%s", url.PathEscape(owner), url.PathEscape(repoName))
+}
+
+func (p giteaProvider) CreateRepository(name string, owner string, private bool) error {
+	ownerType, err := p.GetAccountType(owner)
+	if err != nil {
+		return err
+	}
+
+	path := "/user/repos"
+	if ownerType == AccountTypeOrg {
+		path = fmt.Sprintf("/orgs/%s/repos", url.PathEscape(owner))
+	}
+
+	body := map[string]interface{}{
+		"name":        name,
+		"description": "Weave Gitops repo",
+		"private":     private,
+		"auto_init":   true,
+		"license":     "Apache-2.0",
+	}
+
+	if err := p.api.do(http.MethodPost, path, body, nil); err != nil {
+		return fmt.Errorf("error creating repo %w", err)
+	}
+
+	return nil
+}
+
+func (p giteaProvider) RepositoryExists(name string, owner string) (bool, error) {
+	if err := p.api.do(http.MethodGet, giteaRepoPath(owner, name), nil, nil); err != nil {
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (p giteaProvider) DeployKeyExists(owner, repoName string) (bool, error) {
+	var keys []giteaDeployKey
+	if err := p.api.do(http.MethodGet, giteaRepoPath(owner, repoName)+"/keys", nil, &keys); err != nil {
+		return false, fmt.Errorf("error getting deploy key %s for repo %s. %s", deployKeyName, repoName, err)
+	}
+
+	for _, key := range keys {
+		if key.Title == deployKeyName {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (p giteaProvider) UploadDeployKey(owner, repoName string, deployKey []byte) error {
+	key := giteaDeployKey{
+		Title:    deployKeyName,
+		Key:      string(deployKey),
+		ReadOnly: false,
+	}
+
+	if err := p.api.do(http.MethodPost, giteaRepoPath(owner, repoName)+"/keys", key, nil); err != nil {
+		return fmt.Errorf("error uploading deploy key %s", err)
+	}
+
+	return nil
+}
+
+func (p giteaProvider) DeleteDeployKey(owner, repoName string, deployKey []byte) error {
+	var keys []giteaDeployKey
+	if err := p.api.do(http.MethodGet, giteaRepoPath(owner, repoName)+"/keys", nil, &keys); err != nil {
+		return fmt.Errorf("error getting deploy key %s for repo %s. %s", deployKeyName, repoName, err)
+	}
+
+	for _, key := range keys {
+		if key.Title != deployKeyName || !sameDeployKey([]byte(key.Key), deployKey) {
+			continue
+		}
+
+		path := giteaRepoPath(owner, repoName) + "/keys/" + strconv.FormatInt(key.ID, 10)
+		if err := p.api.do(http.MethodDelete, path, nil, nil); err != nil {
+			return fmt.Errorf("error deleting deploy key %s", err)
+		}
+	}
+
+	return nil
+}
+
+func (p giteaProvider) GetAccountType(owner string) (ProviderAccountType, error) {
+	if err := p.api.do(http.MethodGet, "/orgs/"+url.PathEscape(owner), nil, nil); err != nil {
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			return AccountTypeUser, nil
+		}
+
+		return "", fmt.Errorf("could not get account type %s", err)
+	}
+
+	return AccountTypeOrg, nil
+}
+
+func (p giteaProvider) GetRepoInfo(accountType ProviderAccountType, owner string, repoName string) (*gitprovider.RepositoryInfo, error) {
+	repo, err := p.getRepository(owner, repoName)
+	if err != nil {
+		return nil, err
+	}
+
+	visibility := gitprovider.RepositoryVisibilityPublic
+	if repo.Private {
+		visibility = gitprovider.RepositoryVisibilityPrivate
+	}
+
+	info := NewRepositoryInfo(repo.Description, visibility)
+	info.DefaultBranch = gitprovider.StringVar(repo.DefaultBranch)
+
+	return &info, nil
+}
+
+func (p giteaProvider) GetRepoInfoFromUrl(repoUrl string) (*gitprovider.RepositoryInfo, error) {
+	normalizedUrl, err := NewNormalizedRepoURL(repoUrl)
+	if err != nil {
+		return nil, fmt.Errorf("error normalizing url: %w", err)
+	}
+
+	return p.GetRepoInfo(AccountTypeUser, normalizedUrl.Owner(), normalizedUrl.RepositoryName())
+}
+
+func (p giteaProvider) GetDefaultBranch(url string) (string, error) {
+	repoInfo, err := p.GetRepoInfoFromUrl(url)
+	if err != nil {
+		return "", err
+	}
+
+	if repoInfo.DefaultBranch != nil && *repoInfo.DefaultBranch != "" {
+		return *repoInfo.DefaultBranch, nil
+	}
+
+	return "main", nil
+}
+
+func (p giteaProvider) GetRepoVisibility(url string) (*gitprovider.RepositoryVisibility, error) {
+	repoInfo, err := p.GetRepoInfoFromUrl(url)
+	if err != nil {
+		return nil, err
+	}
+
+	return getVisibilityFromRepoInfo(url, repoInfo)
+}
+
+func (p giteaProvider) CreatePullRequestToUserRepo(userRepRef gitprovider.UserRepositoryRef, targetBranch string, newBranch string, files []gitprovider.CommitFile, commitMessage string, prTitle string, prDescription string) (gitprovider.PullRequest, error) {
+	return p.createPullRequest(userRepRef.UserLogin, userRepRef.RepositoryName, targetBranch, newBranch, files, commitMessage, prTitle, prDescription)
+}
+
+func (p giteaProvider) CreatePullRequestToOrgRepo(orgRepRef gitprovider.OrgRepositoryRef, targetBranch string, newBranch string, files []gitprovider.CommitFile, commitMessage string, prTitle string, prDescription string) (gitprovider.PullRequest, error) {
+	return p.createPullRequest(orgRepRef.Organization, orgRepRef.RepositoryName, targetBranch, newBranch, files, commitMessage, prTitle, prDescription)
+}
+
+func (p giteaProvider) createPullRequest(owner, repoName, targetBranch, newBranch string, files []gitprovider.CommitFile, commitMessage, prTitle, prDescription string) (gitprovider.PullRequest, error) {
+	repoPath := giteaRepoPath(owner, repoName)
+
+	if targetBranch == "" {
+		repo, err := p.getRepository(owner, repoName)
+		if err != nil {
+			return nil, fmt.Errorf("error getting info for repo [%s/%s] err [%s]", owner, repoName, err)
+		}
+
+		targetBranch = repo.DefaultBranch
+	}
+
+	branch := map[string]string{
+		"new_branch_name": newBranch,
+		"old_branch_name": targetBranch,
+	}
+
+	if err := p.api.do(http.MethodPost, repoPath+"/branches", branch, nil); err != nil {
+		return nil, fmt.Errorf("error creating branch [%s] for repo [%s/%s] err [%s]", newBranch, owner, repoName, err)
+	}
+
+	for _, file := range files {
+		if err := p.commitFile(repoPath, newBranch, commitMessage, file); err != nil {
+			return nil, fmt.Errorf("error creating commit for branch [%s] for repo [%s/%s] err [%s]", newBranch, owner, repoName, err)
+		}
+	}
+
+	pr := giteaPullRequest{}
+	body := map[string]string{
+		"title": prTitle,
+		"body":  prDescription,
+		"head":  newBranch,
+		"base":  targetBranch,
+	}
+
+	if err := p.api.do(http.MethodPost, repoPath+"/pulls", body, &pr); err != nil {
+		return nil, fmt.Errorf("error creating pull request [%s] for branch [%s] for repo [%s/%s] err [%s]", prTitle, newBranch, owner, repoName, err)
+	}
+
+	return restPullRequest{info: gitprovider.PullRequestInfo{WebURL: pr.HTMLURL}, object: pr}, nil
+}
+
+// commitFile creates, updates or deletes (when the content is nil) a single file on a branch.
+// The Gitea contents API commits one file at a time.
+func (p giteaProvider) commitFile(repoPath, branch, message string, file gitprovider.CommitFile) error {
+	if file.Path == nil {
+		return fmt.Errorf("file path cannot be empty")
+	}
+
+	path := repoPath + "/contents/" + escapePath(*file.Path)
+
+	existing := giteaContent{}
+	exists := true
+
+	if err := p.api.do(http.MethodGet, path+"?ref="+url.QueryEscape(branch), nil, &existing); err != nil {
+		if !errors.Is(err, gitprovider.ErrNotFound) {
+			return err
+		}
+
+		exists = false
+	}
+
+	body := map[string]string{
+		"branch":  branch,
+		"message": message,
+	}
+
+	if exists {
+		body["sha"] = existing.SHA
+	}
+
+	switch {
+	case file.Content == nil && !exists:
+		return nil
+	case file.Content == nil:
+		return p.api.do(http.MethodDelete, path, body, nil)
+	case exists:
+		body["content"] = base64.StdEncoding.EncodeToString([]byte(*file.Content))
+		return p.api.do(http.MethodPut, path, body, nil)
+	default:
+		body["content"] = base64.StdEncoding.EncodeToString([]byte(*file.Content))
+		return p.api.do(http.MethodPost, path, body, nil)
+	}
+}
+
+func (p giteaProvider) GetCommitsFromUserRepo(userRepRef gitprovider.UserRepositoryRef, targetBranch string, pageSize int, pageToken int) ([]gitprovider.Commit, error) {
+	return p.getCommits(userRepRef.UserLogin, userRepRef.RepositoryName, targetBranch, pageSize, pageToken)
+}
+
+func (p giteaProvider) GetCommitsFromOrgRepo(orgRepRef gitprovider.OrgRepositoryRef, targetBranch string, pageSize int, pageToken int) ([]gitprovider.Commit, error) {
+	return p.getCommits(orgRepRef.Organization, orgRepRef.RepositoryName, targetBranch, pageSize, pageToken)
+}
+
+func (p giteaProvider) getCommits(owner, repoName, targetBranch string, pageSize int, pageToken int) ([]gitprovider.Commit, error) {
+	// Gitea pages start at 1, a page token of 0 also means the first page like it does for GitHub
+	page := pageToken
+	if page < 1 {
+		page = 1
+	}
+
+	query := url.Values{
+		"sha":   {targetBranch},
+		"limit": {strconv.Itoa(pageSize)},
+		"page":  {strconv.Itoa(page)},
+	}
+
+	var commits []giteaCommit
+	if err := p.api.do(http.MethodGet, giteaRepoPath(owner, repoName)+"/commits?"+query.Encode(), nil, &commits); err != nil {
+		if hasStatusCode(err, http.StatusConflict) {
+			// Gitea answers 409 when the repository is empty
+			return []gitprovider.Commit{}, nil
+		}
+
+		return nil, fmt.Errorf("error getting commits for repo [%s/%s] err [%s]", owner, repoName, err)
+	}
+
+	result := make([]gitprovider.Commit, 0, len(commits))
+
+	for _, c := range commits {
+		result = append(result, restCommit{
+			info: gitprovider.CommitInfo{
+				Sha:       c.SHA,
+				TreeSha:   c.Commit.Tree.SHA,
+				Author:    c.Commit.Author.Name,
+				Message:   c.Commit.Message,
+				CreatedAt: c.Commit.Author.Date,
+				URL:       c.HTMLURL,
+			},
+			object: c,
+		})
+	}
+
+	return result, nil
+}
+
+func (p giteaProvider) GetProviderDomain() string {
+	return p.domain
+}
+
+func (p giteaProvider) getRepository(owner, repoName string) (*giteaRepository, error) {
+	repo := &giteaRepository{}
+	if err := p.api.do(http.MethodGet, giteaRepoPath(owner, repoName), nil, repo); err != nil {
+		return nil, fmt.Errorf("error getting repository %w", err)
+	}
+
+	return repo, nil
+}
@@ -0,0 +1,240 @@
+package gitproviders
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const giteaCommitsResponse = `[{
+	"sha": "7ee3a3ea71d1e2a9c2a1e3f6a0d4c5b8e1f2a3b4",
+	"html_url": "https://gitea.example.com/weaveworks/podinfo/commit/7ee3a3ea71d1e2a9c2a1e3f6a0d4c5b8e1f2a3b4",
+	"commit": {
+		"message": "Update podinfo",
+		"tree": {"sha": "2b8e1f2a3b47ee3a3ea71d1e2a9c2a1e3f6a0d4c"},
+		"author": {"name": "Jane Doe", "email": "jane@example.com", "date": "2021-09-20T14:15:13Z"}
+	}
+}]`
+
+// giteaStandIn records the calls made to a stand-in Gitea server
+type giteaStandIn struct {
+	server   *httptest.Server
+	keys     []giteaDeployKey
+	files    map[string]string
+	branches map[string]string
+	pulls    []map[string]string
+	auth     []string
+}
+
+func newGiteaStandIn() *giteaStandIn {
+	s := &giteaStandIn{
+		files:    map[string]string{"apps/existing.yaml": "old"},
+		branches: map[string]string{},
+	}
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/api/v1/orgs/weaveworks", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]string{"username": "weaveworks"})
+	})
+	mux.HandleFunc("/api/v1/repos/weaveworks/podinfo", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, giteaRepository{Name: "podinfo", Private: true, DefaultBranch: "develop"})
+	})
+	mux.HandleFunc("/api/v1/repos/weaveworks/podinfo/keys", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			key := giteaDeployKey{}
+			Expect(json.NewDecoder(r.Body).Decode(&key)).To(Succeed())
+			s.keys = append(s.keys, key)
+			w.WriteHeader(http.StatusCreated)
+
+			return
+		}
+
+		writeJSON(w, s.keys)
+	})
+	mux.HandleFunc("/api/v1/repos/weaveworks/podinfo/keys/", func(w http.ResponseWriter, r *http.Request) {
+		Expect(r.Method).To(Equal(http.MethodDelete))
+
+		id := r.URL.Path[len("/api/v1/repos/weaveworks/podinfo/keys/"):]
+		for i, key := range s.keys {
+			if strconv.FormatInt(key.ID, 10) == id {
+				s.keys = append(s.keys[:i], s.keys[i+1:]...)
+				w.WriteHeader(http.StatusNoContent)
+
+				return
+			}
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+	})
+	mux.HandleFunc("/api/v1/repos/weaveworks/podinfo/branches", func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]string{}
+		Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+		s.branches[body["new_branch_name"]] = body["old_branch_name"]
+		w.WriteHeader(http.StatusCreated)
+	})
+	mux.HandleFunc("/api/v1/repos/weaveworks/podinfo/contents/", func(w http.ResponseWriter, r *http.Request) {
+		path := r.URL.Path[len("/api/v1/repos/weaveworks/podinfo/contents/"):]
+
+		if r.Method == http.MethodGet {
+			if _, ok := s.files[path]; !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			writeJSON(w, giteaContent{SHA: "sha-" + path})
+
+			return
+		}
+
+		body := map[string]string{}
+		Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+
+		if r.Method == http.MethodPut {
+			Expect(body["sha"]).To(Equal("sha-" + path))
+		}
+
+		content, err := base64.StdEncoding.DecodeString(body["content"])
+		Expect(err).NotTo(HaveOccurred())
+		s.files[path] = string(content)
+		writeJSON(w, map[string]string{})
+	})
+	mux.HandleFunc("/api/v1/repos/weaveworks/podinfo/pulls", func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]string{}
+		Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+		s.pulls = append(s.pulls, body)
+		writeJSON(w, giteaPullRequest{Number: 1, HTMLURL: "https://gitea.example.com/weaveworks/podinfo/pulls/1"})
+	})
+	mux.HandleFunc("/api/v1/repos/weaveworks/podinfo/commits", func(w http.ResponseWriter, r *http.Request) {
+		Expect(r.URL.Query().Get("sha")).To(Equal("main"))
+		Expect(r.URL.Query().Get("limit")).To(Equal("10"))
+		Expect(r.URL.Query().Get("page")).To(Equal("1"))
+
+		_, _ = w.Write([]byte(giteaCommitsResponse))
+	})
+	mux.HandleFunc("/api/v1/repos/weaveworks/empty/commits", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+	})
+
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.auth = append(s.auth, r.Header.Get("Authorization"))
+		mux.ServeHTTP(w, r)
+	}))
+
+	return s
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	Expect(json.NewEncoder(w).Encode(v)).To(Succeed())
+}
+
+var _ = Describe("giteaProvider", func() {
+	var (
+		standIn  *giteaStandIn
+		provider GitProvider
+	)
+
+	BeforeEach(func() {
+		standIn = newGiteaStandIn()
+		provider = newGiteaProvider(standIn.server.Client(), standIn.server.URL, "gitea.example.com", "a-token")
+	})
+
+	AfterEach(func() {
+		standIn.server.Close()
+	})
+
+	It("authenticates with the token", func() {
+		_, err := provider.GetAccountType("weaveworks")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(standIn.auth).To(ConsistOf("token a-token"))
+	})
+
+	It("detects the account type of the owner", func() {
+		Expect(provider.GetAccountType("weaveworks")).To(Equal(AccountTypeOrg))
+		Expect(provider.GetAccountType("someuser")).To(Equal(AccountTypeUser))
+	})
+
+	It("gets the repository info", func() {
+		info, err := provider.GetRepoInfo(AccountTypeOrg, "weaveworks", "podinfo")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*info.DefaultBranch).To(Equal("develop"))
+		Expect(*info.Visibility).To(Equal(gitprovider.RepositoryVisibilityPrivate))
+
+		Expect(provider.RepositoryExists("podinfo", "weaveworks")).To(BeTrue())
+		Expect(provider.RepositoryExists("missing", "weaveworks")).To(BeFalse())
+	})
+
+	It("uploads the deploy key", func() {
+		Expect(provider.DeployKeyExists("weaveworks", "podinfo")).To(BeFalse())
+
+		Expect(provider.UploadDeployKey("weaveworks", "podinfo", []byte("ssh-ed25519 AAAA"))).To(Succeed())
+		Expect(standIn.keys).To(ConsistOf(giteaDeployKey{Title: deployKeyName, Key: "ssh-ed25519 AAAA"}))
+
+		Expect(provider.DeployKeyExists("weaveworks", "podinfo")).To(BeTrue())
+	})
+
+	It("deletes the deploy key with the public key", func() {
+		standIn.keys = []giteaDeployKey{
+			{ID: 1, Title: deployKeyName, Key: "ssh-ed25519 AAAA old"},
+			{ID: 2, Title: deployKeyName, Key: "ssh-ed25519 BBBB new"},
+			{ID: 3, Title: "another-key", Key: "ssh-ed25519 AAAA"},
+		}
+
+		Expect(provider.DeleteDeployKey("weaveworks", "podinfo", []byte("ssh-ed25519 AAAA\n"))).To(Succeed())
+		Expect(standIn.keys).To(ConsistOf(
+			giteaDeployKey{ID: 2, Title: deployKeyName, Key: "ssh-ed25519 BBBB new"},
+			giteaDeployKey{ID: 3, Title: "another-key", Key: "ssh-ed25519 AAAA"},
+		))
+	})
+
+	It("creates a pull request against the default branch", func() {
+		files := []gitprovider.CommitFile{
+			{Path: gitprovider.StringVar("apps/new.yaml"), Content: gitprovider.StringVar("new")},
+			{Path: gitprovider.StringVar("apps/existing.yaml"), Content: gitprovider.StringVar("updated")},
+		}
+		ref := NewOrgRepositoryRef("gitea.example.com", "weaveworks", "podinfo")
+
+		pr, err := provider.CreatePullRequestToOrgRepo(ref, "", "wego-123", files, "Add app", "gitops app add", "Added yamls")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pr.Get().WebURL).To(Equal("https://gitea.example.com/weaveworks/podinfo/pulls/1"))
+
+		Expect(standIn.branches).To(Equal(map[string]string{"wego-123": "develop"}))
+		Expect(standIn.files).To(Equal(map[string]string{"apps/new.yaml": "new", "apps/existing.yaml": "updated"}))
+		Expect(standIn.pulls).To(ConsistOf(map[string]string{
+			"title": "gitops app add",
+			"body":  "Added yamls",
+			"head":  "wego-123",
+			"base":  "develop",
+		}))
+	})
+
+	It("lists the commits of a branch", func() {
+		ref := NewUserRepositoryRef("gitea.example.com", "weaveworks", "podinfo")
+
+		commits, err := provider.GetCommitsFromUserRepo(ref, "main", 10, 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(commits).To(HaveLen(1))
+
+		info := commits[0].Get()
+		Expect(info.Sha).To(Equal("7ee3a3ea71d1e2a9c2a1e3f6a0d4c5b8e1f2a3b4"))
+		Expect(info.Author).To(Equal("Jane Doe"))
+		Expect(info.Message).To(Equal("Update podinfo"))
+		Expect(info.URL).To(Equal("https://gitea.example.com/weaveworks/podinfo/commit/7ee3a3ea71d1e2a9c2a1e3f6a0d4c5b8e1f2a3b4"))
+		Expect(info.CreatedAt.Year()).To(Equal(2021))
+	})
+
+	It("returns no commits for an empty repository", func() {
+		ref := NewOrgRepositoryRef("gitea.example.com", "weaveworks", "empty")
+
+		commits, err := provider.GetCommitsFromOrgRepo(ref, "main", 10, 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(commits).To(BeEmpty())
+	})
+})
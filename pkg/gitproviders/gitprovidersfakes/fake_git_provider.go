@@ -60,6 +60,19 @@ type FakeGitProvider struct {
 	createRepositoryReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DeleteDeployKeyStub        func(string, string, []byte) error
+	deleteDeployKeyMutex       sync.RWMutex
+	deleteDeployKeyArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 []byte
+	}
+	deleteDeployKeyReturns struct {
+		result1 error
+	}
+	deleteDeployKeyReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DeployKeyExistsStub        func(string, string) (bool, error)
 	deployKeyExistsMutex       sync.RWMutex
 	deployKeyExistsArgsForCall []struct {
@@ -427,6 +440,74 @@ func (fake *FakeGitProvider) CreateRepositoryReturnsOnCall(i int, result1 error)
 	}{result1}
 }
 
+func (fake *FakeGitProvider) DeleteDeployKey(arg1 string, arg2 string, arg3 []byte) error {
+	var arg3Copy []byte
+	if arg3 != nil {
+		arg3Copy = make([]byte, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.deleteDeployKeyMutex.Lock()
+	ret, specificReturn := fake.deleteDeployKeyReturnsOnCall[len(fake.deleteDeployKeyArgsForCall)]
+	fake.deleteDeployKeyArgsForCall = append(fake.deleteDeployKeyArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 []byte
+	}{arg1, arg2, arg3Copy})
+	stub := fake.DeleteDeployKeyStub
+	fakeReturns := fake.deleteDeployKeyReturns
+	fake.recordInvocation("DeleteDeployKey", []interface{}{arg1, arg2, arg3Copy})
+	fake.deleteDeployKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeGitProvider) DeleteDeployKeyCallCount() int {
+	fake.deleteDeployKeyMutex.RLock()
+	defer fake.deleteDeployKeyMutex.RUnlock()
+	return len(fake.deleteDeployKeyArgsForCall)
+}
+
+func (fake *FakeGitProvider) DeleteDeployKeyCalls(stub func(string, string, []byte) error) {
+	fake.deleteDeployKeyMutex.Lock()
+	defer fake.deleteDeployKeyMutex.Unlock()
+	fake.DeleteDeployKeyStub = stub
+}
+
+func (fake *FakeGitProvider) DeleteDeployKeyArgsForCall(i int) (string, string, []byte) {
+	fake.deleteDeployKeyMutex.RLock()
+	defer fake.deleteDeployKeyMutex.RUnlock()
+	argsForCall := fake.deleteDeployKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeGitProvider) DeleteDeployKeyReturns(result1 error) {
+	fake.deleteDeployKeyMutex.Lock()
+	defer fake.deleteDeployKeyMutex.Unlock()
+	fake.DeleteDeployKeyStub = nil
+	fake.deleteDeployKeyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeGitProvider) DeleteDeployKeyReturnsOnCall(i int, result1 error) {
+	fake.deleteDeployKeyMutex.Lock()
+	defer fake.deleteDeployKeyMutex.Unlock()
+	fake.DeleteDeployKeyStub = nil
+	if fake.deleteDeployKeyReturnsOnCall == nil {
+		fake.deleteDeployKeyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteDeployKeyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeGitProvider) DeployKeyExists(arg1 string, arg2 string) (bool, error) {
 	fake.deployKeyExistsMutex.Lock()
 	ret, specificReturn := fake.deployKeyExistsReturnsOnCall[len(fake.deployKeyExistsArgsForCall)]
@@ -1143,6 +1224,8 @@ func (fake *FakeGitProvider) Invocations() map[string][][]interface{} {
 	defer fake.createPullRequestToUserRepoMutex.RUnlock()
 	fake.createRepositoryMutex.RLock()
 	defer fake.createRepositoryMutex.RUnlock()
+	fake.deleteDeployKeyMutex.RLock()
+	defer fake.deleteDeployKeyMutex.RUnlock()
 	fake.deployKeyExistsMutex.RLock()
 	defer fake.deployKeyExistsMutex.RUnlock()
 	fake.getAccountTypeMutex.RLock()
@@ -39,6 +39,8 @@ type GitProvider interface {
 	GetDefaultBranch(url string) (string, error)
 	GetRepoVisibility(url string) (*gitprovider.RepositoryVisibility, error)
 	UploadDeployKey(owner, repoName string, deployKey []byte) error
+	// DeleteDeployKey removes the deploy key with the public key from the repository, if present
+	DeleteDeployKey(owner, repoName string, deployKey []byte) error
 	CreatePullRequestToUserRepo(userRepRef gitprovider.UserRepositoryRef, targetBranch string, newBranch string, files []gitprovider.CommitFile, commitMessage string, prTitle string, prDescription string) (gitprovider.PullRequest, error)
 	CreatePullRequestToOrgRepo(orgRepRef gitprovider.OrgRepositoryRef, targetBranch string, newBranch string, files []gitprovider.CommitFile, commitMessage string, prTitle string, prDescription string) (gitprovider.PullRequest, error)
 	GetCommitsFromUserRepo(userRepRef gitprovider.UserRepositoryRef, targetBranch string, pageSize int, pageToken int) ([]gitprovider.Commit, error)
@@ -53,6 +55,15 @@ type defaultGitProvider struct {
 }
 
 func New(config Config) (GitProvider, error) {
+	if config.Provider == GitProviderBitbucketServer || config.Provider == GitProviderGitea {
+		provider, err := buildSelfHostedGitProvider(config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build git provider: %w", err)
+		}
+
+		return provider, nil
+	}
+
 	provider, domain, err := buildGitProvider(config)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build git provider: %w", err)
@@ -245,6 +256,66 @@ func (p defaultGitProvider) UploadDeployKey(owner, repoName string, deployKey []
 	return nil
 }
 
+func (p defaultGitProvider) DeleteDeployKey(owner, repoName string, deployKey []byte) error {
+	ownerType, err := p.GetAccountType(owner)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	defer ctx.Done()
+
+	var deployKeys gitprovider.DeployKeyClient
+
+	switch ownerType {
+	case AccountTypeOrg:
+		orgRepo, err := p.provider.OrgRepositories().Get(ctx, NewOrgRepositoryRef(p.domain, owner, repoName))
+		if err != nil {
+			return fmt.Errorf("error getting org repo reference for owner %s, repo %s, %s ", owner, repoName, err)
+		}
+
+		deployKeys = orgRepo.DeployKeys()
+	case AccountTypeUser:
+		userRepo, err := p.provider.UserRepositories().Get(ctx, NewUserRepositoryRef(p.domain, owner, repoName))
+		if err != nil {
+			return fmt.Errorf("error getting user repo reference for owner %s, repo %s, %s ", owner, repoName, err)
+		}
+
+		deployKeys = userRepo.DeployKeys()
+	default:
+		return fmt.Errorf("account type not supported %s", ownerType)
+	}
+
+	keys, err := deployKeys.List(ctx)
+	if err != nil {
+		return fmt.Errorf("error listing deploy keys for repo %s. %s", repoName, err)
+	}
+
+	for _, key := range keys {
+		info := key.Get()
+		if info.Name != deployKeyName || !sameDeployKey(info.Key, deployKey) {
+			continue
+		}
+
+		if err := key.Delete(ctx); err != nil {
+			return fmt.Errorf("error deleting deploy key %s for repo %s. %s", deployKeyName, repoName, err)
+		}
+	}
+
+	return nil
+}
+
+// sameDeployKey compares the type and the data of two public keys, ignoring their comments
+// which the providers do not always keep
+func sameDeployKey(a, b []byte) bool {
+	fieldsA, fieldsB := strings.Fields(string(a)), strings.Fields(string(b))
+	if len(fieldsA) < 2 || len(fieldsB) < 2 {
+		return false
+	}
+
+	return fieldsA[0] == fieldsB[0] && fieldsA[1] == fieldsB[1]
+}
+
 func (p defaultGitProvider) GetAccountType(owner string) (ProviderAccountType, error) {
 	ctx := context.Background()
 	defer ctx.Done()
@@ -570,29 +641,41 @@ func (p defaultGitProvider) waitUntilRepoCreated(ownerType ProviderAccountType,
 	return nil
 }
 
-// The raw URL is assumed to be something like ssh://git@github.com/myorg/myrepo.git.
-// The common `git clone` variant of `git@github.com:myorg/myrepo.git` is not supported.
+// The raw URL is assumed to be something like ssh://git@github.com/myorg/myrepo.git
+// or the `git clone` variant git@github.com:myorg/myrepo.git.
 func detectGitProviderFromUrl(raw string) (GitProviderName, error) {
-	if strings.HasPrefix(raw, "git@") {
-		raw = "ssh://" + raw
-		raw = strings.Replace(raw, ".com:", ".com/", 1)
-	}
-
-	u, err := url.Parse(raw)
+	hostname, err := hostnameFromUrl(raw)
 	if err != nil {
-		return "", fmt.Errorf("could not parse git repo url %q", raw)
+		return "", err
 	}
 
-	switch u.Hostname() {
+	switch hostname {
 	case github.DefaultDomain:
 		return GitProviderGitHub, nil
 	case gitlab.DefaultDomain:
 		return GitProviderGitLab, nil
 	}
 
+	if providerName, ok := selfHostedProviderForHostname(hostname); ok {
+		return providerName, nil
+	}
+
 	return "", fmt.Errorf("no git providers found for \"%s\"", raw)
 }
 
+func hostnameFromUrl(raw string) (string, error) {
+	if strings.HasPrefix(raw, "git@") {
+		raw = "ssh://" + strings.Replace(raw, ":", "/", 1)
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", fmt.Errorf("could not parse git repo url %q", raw)
+	}
+
+	return u.Hostname(), nil
+}
+
 type RepositoryURLProtocol string
 
 const RepositoryURLProtocolHTTPS RepositoryURLProtocol = "https"
@@ -610,15 +693,25 @@ type NormalizedRepoURL struct {
 // normalizeRepoURLString accepts a url like git@github.com:someuser/podinfo.git and converts it into
 // a string like ssh://git@github.com/someuser/podinfo.git. This helps standardize the different
 // user inputs that might be provided.
-func normalizeRepoURLString(url string, providerName GitProviderName) string {
+func normalizeRepoURLString(url string, providerName GitProviderName, hostname string) string {
 	trimmed := ""
 
 	if !strings.HasSuffix(url, ".git") {
 		url = url + ".git"
 	}
 
-	sshPrefix := fmt.Sprintf("git@%s.com:", providerName)
-	httpsPrefix := fmt.Sprintf("https://%s.com/", providerName)
+	sshPrefix := fmt.Sprintf("git@%s:", hostname)
+	httpsPrefix := fmt.Sprintf("https://%s/", hostname)
+	sshHost := hostname
+
+	if providerName == GitProviderBitbucketServer {
+		// Bitbucket Server serves https clones under /scm and ssh clones on a port of its own
+		httpsPrefix += "scm/"
+
+		if strings.HasPrefix(url, httpsPrefix) {
+			sshHost = hostname + ":" + bitbucketServerSSHPort
+		}
+	}
 
 	if strings.HasPrefix(url, sshPrefix) {
 		trimmed = strings.TrimPrefix(url, sshPrefix)
@@ -627,7 +720,7 @@ func normalizeRepoURLString(url string, providerName GitProviderName) string {
 	}
 
 	if trimmed != "" {
-		return fmt.Sprintf("ssh://git@%s.com/%s", providerName, trimmed)
+		return fmt.Sprintf("ssh://git@%s/%s", sshHost, trimmed)
 	}
 
 	return url
@@ -639,7 +732,12 @@ func NewNormalizedRepoURL(uri string) (NormalizedRepoURL, error) {
 		return NormalizedRepoURL{}, fmt.Errorf("could get provider name from URL %s: %w", uri, err)
 	}
 
-	normalized := normalizeRepoURLString(uri, providerName)
+	hostname, err := hostnameFromUrl(uri)
+	if err != nil {
+		return NormalizedRepoURL{}, err
+	}
+
+	normalized := normalizeRepoURLString(uri, providerName, hostname)
 
 	u, err := url.Parse(normalized)
 	if err != nil {
@@ -670,6 +768,17 @@ func (n NormalizedRepoURL) String() string {
 	return n.normalized
 }
 
+// HTTPS returns the https clone url of the repository, used when it is accessed with credentials instead of a deploy key
+func (n NormalizedRepoURL) HTTPS() string {
+	path := strings.TrimPrefix(n.url.Path, "/")
+
+	if n.provider == GitProviderBitbucketServer {
+		path = "scm/" + strings.TrimPrefix(path, "scm/")
+	}
+
+	return fmt.Sprintf("https://%s/%s", n.url.Hostname(), path)
+}
+
 func (n NormalizedRepoURL) URL() *url.URL {
 	return n.url
 }
@@ -693,6 +802,10 @@ func (n NormalizedRepoURL) Protocol() RepositoryURLProtocol {
 func getOwnerFromUrl(url url.URL, providerName GitProviderName) (string, error) {
 	url.Path = strings.TrimPrefix(url.Path, "/")
 
+	if providerName == GitProviderBitbucketServer {
+		url.Path = strings.TrimPrefix(url.Path, "scm/")
+	}
+
 	parts := strings.Split(url.Path, "/")
 	if len(parts) < 2 {
 		return "", fmt.Errorf("could not get owner from url %v", url.String())
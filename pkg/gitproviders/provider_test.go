@@ -976,6 +976,89 @@ var _ = DescribeTable("NormalizedRepoURL", func(input string, expected expectedR
 	}),
 )
 
+var _ = Describe("self-hosted providers", func() {
+	BeforeEach(func() {
+		Expect(os.Setenv(BitbucketServerHostnameEnvVar, "bitbucket.example.com")).To(Succeed())
+		Expect(os.Setenv(GiteaHostnameEnvVar, "git.example.org, gitea.example.com")).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(os.Unsetenv(BitbucketServerHostnameEnvVar)).To(Succeed())
+		Expect(os.Unsetenv(GiteaHostnameEnvVar)).To(Succeed())
+	})
+
+	It("does not detect hosts that are not configured", func() {
+		_, err := detectGitProviderFromUrl("ssh://git@git.example.net/someuser/podinfo.git")
+		Expect(err).To(MatchError(ContainSubstring("no git providers found")))
+	})
+
+	DescribeTable("NormalizedRepoURL", func(input string, expected expectedRepoURL) {
+		result, err := NewNormalizedRepoURL(input)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(result.String()).To(Equal(expected.s))
+		Expect(result.Owner()).To(Equal(expected.owner))
+		Expect(result.RepositoryName()).To(Equal(expected.name))
+		Expect(result.Provider()).To(Equal(expected.provider))
+		Expect(result.Protocol()).To(Equal(expected.protocol))
+	},
+		Entry("bitbucket server ssh", "ssh://git@bitbucket.example.com:7999/proj/podinfo.git", expectedRepoURL{
+			s:        "ssh://git@bitbucket.example.com:7999/proj/podinfo.git",
+			owner:    "proj",
+			name:     "podinfo",
+			provider: GitProviderBitbucketServer,
+			protocol: RepositoryURLProtocolSSH,
+		}),
+		Entry("bitbucket server https", "https://bitbucket.example.com/scm/proj/podinfo.git", expectedRepoURL{
+			s:        "ssh://git@bitbucket.example.com:7999/proj/podinfo.git",
+			owner:    "proj",
+			name:     "podinfo",
+			provider: GitProviderBitbucketServer,
+			protocol: RepositoryURLProtocolSSH,
+		}),
+		Entry("bitbucket server personal repository", "https://bitbucket.example.com/scm/~jdoe/podinfo", expectedRepoURL{
+			s:        "ssh://git@bitbucket.example.com:7999/~jdoe/podinfo.git",
+			owner:    "~jdoe",
+			name:     "podinfo",
+			provider: GitProviderBitbucketServer,
+			protocol: RepositoryURLProtocolSSH,
+		}),
+		Entry("gitea git clone style", "git@gitea.example.com:someuser/podinfo.git", expectedRepoURL{
+			s:        "ssh://git@gitea.example.com/someuser/podinfo.git",
+			owner:    "someuser",
+			name:     "podinfo",
+			provider: GitProviderGitea,
+			protocol: RepositoryURLProtocolSSH,
+		}),
+		Entry("gitea https", "https://git.example.org/someuser/podinfo.git", expectedRepoURL{
+			s:        "ssh://git@git.example.org/someuser/podinfo.git",
+			owner:    "someuser",
+			name:     "podinfo",
+			provider: GitProviderGitea,
+			protocol: RepositoryURLProtocolSSH,
+		}),
+	)
+
+	DescribeTable("HTTPS", func(input string, expected string) {
+		result, err := NewNormalizedRepoURL(input)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.HTTPS()).To(Equal(expected))
+	},
+		Entry("github", "git@github.com:someuser/podinfo.git", "https://github.com/someuser/podinfo.git"),
+		Entry("bitbucket server", "ssh://git@bitbucket.example.com:7999/proj/podinfo.git", "https://bitbucket.example.com/scm/proj/podinfo.git"),
+		Entry("gitea", "https://git.example.org/someuser/podinfo.git", "https://git.example.org/someuser/podinfo.git"),
+	)
+
+	It("requires the hostname of the server", func() {
+		_, err := New(Config{Provider: GitProviderGitea, Token: "token"})
+		Expect(err).To(MatchError(ContainSubstring("the hostname of a gitea provider is required")))
+
+		provider, err := New(Config{Provider: GitProviderBitbucketServer, Hostname: "bitbucket.example.com", Token: "token"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(provider.GetProviderDomain()).To(Equal("bitbucket.example.com"))
+	})
+})
+
 var _ = Describe("Test GetRepoVisiblity", func() {
 	url := "ssh://git@github.com/foo/bar"
 	It("tests that a nil info generates the appropriate error", func() {
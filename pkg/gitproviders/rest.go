@@ -0,0 +1,168 @@
+package gitproviders
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// restClient is a minimal JSON API client used by the providers go-git-providers has no client for.
+type restClient struct {
+	http    *http.Client
+	baseURL string
+	// authorize adds the provider specific credentials to every request
+	authorize func(req *http.Request)
+}
+
+func newRestClient(client *http.Client, baseURL string, authorize func(req *http.Request)) restClient {
+	if client == nil {
+		client = &http.Client{Timeout: defaultTimeout}
+	}
+
+	return restClient{
+		http:      client,
+		baseURL:   baseURL,
+		authorize: authorize,
+	}
+}
+
+// do sends a JSON encoded body (if any) to the API path and decodes the response into out (if any).
+func (c restClient) do(method, path string, body interface{}, out interface{}) error {
+	var reader io.Reader
+
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("could not encode request body: %w", err)
+		}
+
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := c.newRequest(method, path, reader)
+	if err != nil {
+		return err
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	return c.send(req, out)
+}
+
+func (c restClient) newRequest(method, path string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequest(method, c.baseURL+path, body)
+	if err != nil {
+		return nil, fmt.Errorf("could not create request for %s: %w", path, err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	c.authorize(req)
+
+	return req, nil
+}
+
+func (c restClient) send(req *http.Request, out interface{}) error {
+	res, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s %s failed: %w", req.Method, req.URL.Path, err)
+	}
+	defer res.Body.Close()
+
+	data, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("could not read response of %s %s: %w", req.Method, req.URL.Path, err)
+	}
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return apiError{method: req.Method, path: req.URL.Path, statusCode: res.StatusCode, body: bytes.TrimSpace(data)}
+	}
+
+	if out == nil || len(data) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("could not decode response of %s %s: %w", req.Method, req.URL.Path, err)
+	}
+
+	return nil
+}
+
+// apiError is returned for any non 2xx response. A 404 response unwraps to gitprovider.ErrNotFound.
+type apiError struct {
+	method     string
+	path       string
+	statusCode int
+	body       []byte
+}
+
+func (e apiError) Error() string {
+	return fmt.Sprintf("%s %s failed with status code %d: %s", e.method, e.path, e.statusCode, e.body)
+}
+
+func (e apiError) Unwrap() error {
+	if e.statusCode == http.StatusNotFound {
+		return gitprovider.ErrNotFound
+	}
+
+	return nil
+}
+
+func hasStatusCode(err error, statusCode int) bool {
+	var apiErr apiError
+
+	return errors.As(err, &apiErr) && apiErr.statusCode == statusCode
+}
+
+// restCommit adapts a commit returned by a REST API to gitprovider.Commit
+type restCommit struct {
+	info   gitprovider.CommitInfo
+	object interface{}
+}
+
+func (c restCommit) Get() gitprovider.CommitInfo {
+	return c.info
+}
+
+func (c restCommit) APIObject() interface{} {
+	return c.object
+}
+
+// restPullRequest adapts a pull request returned by a REST API to gitprovider.PullRequest
+type restPullRequest struct {
+	info   gitprovider.PullRequestInfo
+	object interface{}
+}
+
+func (pr restPullRequest) Get() gitprovider.PullRequestInfo {
+	return pr.info
+}
+
+func (pr restPullRequest) APIObject() interface{} {
+	return pr.object
+}
+
+func millisToTime(ms int64) time.Time {
+	return time.Unix(0, ms*int64(time.Millisecond)).UTC()
+}
+
+// escapePath escapes every segment of a slash separated path, e.g. a file path inside a repository
+func escapePath(p string) string {
+	segments := strings.Split(p, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,103 @@
+package helm
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// DefaultIndexTimeout is how long reading the index of a Helm repository may take,
+// the default timeout of the HelmRepository API
+const DefaultIndexTimeout = 60 * time.Second
+
+// Keys of the Secret holding the credentials of a Helm repository, as read by the Flux source controller
+const (
+	UsernameKey = "username"
+	PasswordKey = "password"
+	CertFileKey = "certFile"
+	KeyFileKey  = "keyFile"
+	CAFileKey   = "caFile"
+)
+
+// NewRepositoryClient returns an HTTP client reading a Helm repository with the credentials of a Secret:
+// basic auth with its username and password, and TLS with its certFile, keyFile and caFile.
+// The client has no credentials when the secret is nil.
+func NewRepositoryClient(secret *corev1.Secret, timeout time.Duration) (*http.Client, error) {
+	if timeout == 0 {
+		timeout = DefaultIndexTimeout
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	client := &http.Client{Transport: transport, Timeout: timeout}
+
+	if secret == nil {
+		return client, nil
+	}
+
+	tlsConfig, err := repositoryTLSConfig(secret)
+	if err != nil {
+		return nil, fmt.Errorf("invalid TLS credentials in secret %s: %w", secret.Name, err)
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	username, password := string(secret.Data[UsernameKey]), string(secret.Data[PasswordKey])
+	if username != "" || password != "" {
+		if username == "" || password == "" {
+			return nil, fmt.Errorf("secret %s must hold both the %s and %s of the helm repository", secret.Name, UsernameKey, PasswordKey)
+		}
+
+		client.Transport = &basicAuthTransport{username: username, password: password, next: transport}
+	}
+
+	return client, nil
+}
+
+// repositoryTLSConfig returns the TLS settings of the client certificate and certificate authority of the secret,
+// or nil when it holds neither
+func repositoryTLSConfig(secret *corev1.Secret) (*tls.Config, error) {
+	certFile, keyFile, caFile := secret.Data[CertFileKey], secret.Data[KeyFileKey], secret.Data[CAFileKey]
+	if len(certFile) == 0 && len(keyFile) == 0 && len(caFile) == 0 {
+		return nil, nil
+	}
+
+	config := &tls.Config{}
+
+	if len(certFile) > 0 || len(keyFile) > 0 {
+		cert, err := tls.X509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, err
+		}
+
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	if len(caFile) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caFile) {
+			return nil, fmt.Errorf("no certificate found in %s", CAFileKey)
+		}
+
+		config.RootCAs = pool
+	}
+
+	return config, nil
+}
+
+// basicAuthTransport sets the basic auth credentials of a Helm repository on its requests
+type basicAuthTransport struct {
+	username string
+	password string
+	next     http.RoundTripper
+}
+
+func (t *basicAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.SetBasicAuth(t.username, t.password)
+
+	return t.next.RoundTrip(req)
+}
@@ -0,0 +1,107 @@
+package helm
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"sigs.k8s.io/yaml"
+)
+
+// repositoryIndex holds the fields we need from the index of a Helm repository
+type repositoryIndex struct {
+	Entries map[string][]struct {
+		Version string `json:"version"`
+	} `json:"entries"`
+}
+
+// ChartVersions returns the versions of a chart published in the index of a Helm repository, newest first.
+// Versions which are not semantic versions are skipped, as they cannot be selected with a version constraint.
+func ChartVersions(ctx context.Context, httpClient *http.Client, repoURL, chart string) ([]string, error) {
+	indexURL := strings.TrimSuffix(repoURL, "/") + "/index.yaml"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, indexURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("could not get the index of helm repository %s: %w", repoURL, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not get the index of helm repository %s: %s", repoURL, res.Status)
+	}
+
+	data, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("could not read the index of helm repository %s: %w", repoURL, err)
+	}
+
+	index := repositoryIndex{}
+	if err := yaml.Unmarshal(data, &index); err != nil {
+		return nil, fmt.Errorf("could not parse the index of helm repository %s: %w", repoURL, err)
+	}
+
+	entries, ok := index.Entries[chart]
+	if !ok {
+		return nil, fmt.Errorf("chart %s not found in helm repository %s", chart, repoURL)
+	}
+
+	versions := semver.Collection{}
+
+	for _, entry := range entries {
+		version, err := semver.NewVersion(entry.Version)
+		if err != nil {
+			continue
+		}
+
+		versions = append(versions, version)
+	}
+
+	sort.Sort(sort.Reverse(versions))
+
+	result := []string{}
+	for _, version := range versions {
+		result = append(result, version.Original())
+	}
+
+	return result, nil
+}
+
+// ValidateVersionConstraint checks that a chart version constraint can be read by Flux
+func ValidateVersionConstraint(constraint string) error {
+	if _, err := semver.NewConstraint(constraint); err != nil {
+		return fmt.Errorf("invalid chart version constraint %q: %w", constraint, err)
+	}
+
+	return nil
+}
+
+// LatestMatchingVersion returns the newest of the versions, sorted newest first, satisfying a constraint.
+// It returns an empty string when no version does.
+func LatestMatchingVersion(versions []string, constraint string) (string, error) {
+	constraints, err := semver.NewConstraint(constraint)
+	if err != nil {
+		return "", fmt.Errorf("invalid chart version constraint %q: %w", constraint, err)
+	}
+
+	for _, v := range versions {
+		version, err := semver.NewVersion(v)
+		if err != nil {
+			continue
+		}
+
+		if constraints.Check(version) {
+			return v, nil
+		}
+	}
+
+	return "", nil
+}
@@ -0,0 +1,140 @@
+package helm
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Labels of the Secrets the Helm storage driver keeps a release revision in
+const (
+	StorageOwnerKey   = "owner"
+	StorageNameKey    = "name"
+	StorageStatusKey  = "status"
+	StorageVersionKey = "version"
+)
+
+var gzipMagic = []byte{0x1f, 0x8b, 0x08}
+
+// storedRelease holds the fields we need from a release in the Helm storage
+type storedRelease struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Manifest  string `json:"manifest"`
+}
+
+// DeployedStorageLabels selects the storage Secrets of the deployed revisions of a release
+func DeployedStorageLabels(releaseName string) client.MatchingLabels {
+	return client.MatchingLabels{
+		StorageOwnerKey:  "helm",
+		StorageNameKey:   releaseName,
+		StorageStatusKey: "deployed",
+	}
+}
+
+// DeployedManifest returns the objects rendered by the latest revision found in the storage Secrets of a release.
+// No objects are returned when there is no revision.
+func DeployedManifest(secrets []corev1.Secret) ([]unstructured.Unstructured, error) {
+	var latest *corev1.Secret
+
+	for i := range secrets {
+		if latest == nil || storageVersion(&secrets[i]) > storageVersion(latest) {
+			latest = &secrets[i]
+		}
+	}
+
+	if latest == nil {
+		return []unstructured.Unstructured{}, nil
+	}
+
+	release, err := decodeRelease(latest.Data["release"])
+	if err != nil {
+		return nil, fmt.Errorf("could not decode helm release %s: %w", latest.Name, err)
+	}
+
+	objects, err := decodeManifest(release.Manifest)
+	if err != nil {
+		return nil, fmt.Errorf("could not read manifest of helm release %s: %w", latest.Name, err)
+	}
+
+	for i := range objects {
+		// Helm installs the objects without a namespace in the namespace of the release.
+		// The namespace is ignored for cluster scoped objects.
+		if objects[i].GetNamespace() == "" {
+			objects[i].SetNamespace(release.Namespace)
+		}
+	}
+
+	return objects, nil
+}
+
+func storageVersion(secret *corev1.Secret) int {
+	version, _ := strconv.Atoi(secret.Labels[StorageVersionKey])
+
+	return version
+}
+
+// decodeRelease reverses the encoding of the Helm storage drivers: base64 encoded, gzipped JSON
+func decodeRelease(data []byte) (*storedRelease, error) {
+	b, err := base64.StdEncoding.DecodeString(string(data))
+	if err != nil {
+		return nil, err
+	}
+
+	if bytes.HasPrefix(b, gzipMagic) {
+		r, err := gzip.NewReader(bytes.NewReader(b))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+
+		b, err = ioutil.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	release := &storedRelease{}
+	if err := json.Unmarshal(b, release); err != nil {
+		return nil, err
+	}
+
+	return release, nil
+}
+
+func decodeManifest(manifest string) ([]unstructured.Unstructured, error) {
+	decoder := yaml.NewYAMLOrJSONDecoder(strings.NewReader(manifest), 4096)
+
+	objects := []unstructured.Unstructured{}
+
+	for {
+		obj := unstructured.Unstructured{}
+
+		if err := decoder.Decode(&obj.Object); err != nil {
+			if errors.Is(err, io.EOF) {
+				return objects, nil
+			}
+
+			return nil, err
+		}
+
+		// Templates rendering to nothing leave empty documents behind
+		if len(obj.Object) == 0 {
+			continue
+		}
+
+		objects = append(objects, obj)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 type Resource interface {
@@ -52,5 +53,7 @@ type Kube interface {
 	GetClusterStatus(ctx context.Context) ClusterStatus
 	GetApplication(ctx context.Context, name types.NamespacedName) (*wego.Application, error)
 	GetResource(ctx context.Context, name types.NamespacedName, resource Resource) error
+	ListResources(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error
+	PatchResource(ctx context.Context, resource Resource, patch client.Patch) error
 	GetSecret(ctx context.Context, name types.NamespacedName) (*corev1.Secret, error)
 }
@@ -10,6 +10,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 type FakeKube struct {
@@ -144,6 +145,32 @@ type FakeKube struct {
 		result1 *v1.Secret
 		result2 error
 	}
+	ListResourcesStub        func(context.Context, client.ObjectList, ...client.ListOption) error
+	listResourcesMutex       sync.RWMutex
+	listResourcesArgsForCall []struct {
+		arg1 context.Context
+		arg2 client.ObjectList
+		arg3 []client.ListOption
+	}
+	listResourcesReturns struct {
+		result1 error
+	}
+	listResourcesReturnsOnCall map[int]struct {
+		result1 error
+	}
+	PatchResourceStub        func(context.Context, kube.Resource, client.Patch) error
+	patchResourceMutex       sync.RWMutex
+	patchResourceArgsForCall []struct {
+		arg1 context.Context
+		arg2 kube.Resource
+		arg3 client.Patch
+	}
+	patchResourceReturns struct {
+		result1 error
+	}
+	patchResourceReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SecretPresentStub        func(context.Context, string, string) (bool, error)
 	secretPresentMutex       sync.RWMutex
 	secretPresentArgsForCall []struct {
@@ -809,6 +836,132 @@ func (fake *FakeKube) GetSecretReturnsOnCall(i int, result1 *v1.Secret, result2
 	}{result1, result2}
 }
 
+func (fake *FakeKube) ListResources(arg1 context.Context, arg2 client.ObjectList, arg3 ...client.ListOption) error {
+	fake.listResourcesMutex.Lock()
+	ret, specificReturn := fake.listResourcesReturnsOnCall[len(fake.listResourcesArgsForCall)]
+	fake.listResourcesArgsForCall = append(fake.listResourcesArgsForCall, struct {
+		arg1 context.Context
+		arg2 client.ObjectList
+		arg3 []client.ListOption
+	}{arg1, arg2, arg3})
+	stub := fake.ListResourcesStub
+	fakeReturns := fake.listResourcesReturns
+	fake.recordInvocation("ListResources", []interface{}{arg1, arg2, arg3})
+	fake.listResourcesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeKube) ListResourcesCallCount() int {
+	fake.listResourcesMutex.RLock()
+	defer fake.listResourcesMutex.RUnlock()
+	return len(fake.listResourcesArgsForCall)
+}
+
+func (fake *FakeKube) ListResourcesCalls(stub func(context.Context, client.ObjectList, ...client.ListOption) error) {
+	fake.listResourcesMutex.Lock()
+	defer fake.listResourcesMutex.Unlock()
+	fake.ListResourcesStub = stub
+}
+
+func (fake *FakeKube) ListResourcesArgsForCall(i int) (context.Context, client.ObjectList, []client.ListOption) {
+	fake.listResourcesMutex.RLock()
+	defer fake.listResourcesMutex.RUnlock()
+	argsForCall := fake.listResourcesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeKube) ListResourcesReturns(result1 error) {
+	fake.listResourcesMutex.Lock()
+	defer fake.listResourcesMutex.Unlock()
+	fake.ListResourcesStub = nil
+	fake.listResourcesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeKube) ListResourcesReturnsOnCall(i int, result1 error) {
+	fake.listResourcesMutex.Lock()
+	defer fake.listResourcesMutex.Unlock()
+	fake.ListResourcesStub = nil
+	if fake.listResourcesReturnsOnCall == nil {
+		fake.listResourcesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.listResourcesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeKube) PatchResource(arg1 context.Context, arg2 kube.Resource, arg3 client.Patch) error {
+	fake.patchResourceMutex.Lock()
+	ret, specificReturn := fake.patchResourceReturnsOnCall[len(fake.patchResourceArgsForCall)]
+	fake.patchResourceArgsForCall = append(fake.patchResourceArgsForCall, struct {
+		arg1 context.Context
+		arg2 kube.Resource
+		arg3 client.Patch
+	}{arg1, arg2, arg3})
+	stub := fake.PatchResourceStub
+	fakeReturns := fake.patchResourceReturns
+	fake.recordInvocation("PatchResource", []interface{}{arg1, arg2, arg3})
+	fake.patchResourceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeKube) PatchResourceCallCount() int {
+	fake.patchResourceMutex.RLock()
+	defer fake.patchResourceMutex.RUnlock()
+	return len(fake.patchResourceArgsForCall)
+}
+
+func (fake *FakeKube) PatchResourceCalls(stub func(context.Context, kube.Resource, client.Patch) error) {
+	fake.patchResourceMutex.Lock()
+	defer fake.patchResourceMutex.Unlock()
+	fake.PatchResourceStub = stub
+}
+
+func (fake *FakeKube) PatchResourceArgsForCall(i int) (context.Context, kube.Resource, client.Patch) {
+	fake.patchResourceMutex.RLock()
+	defer fake.patchResourceMutex.RUnlock()
+	argsForCall := fake.patchResourceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeKube) PatchResourceReturns(result1 error) {
+	fake.patchResourceMutex.Lock()
+	defer fake.patchResourceMutex.Unlock()
+	fake.PatchResourceStub = nil
+	fake.patchResourceReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeKube) PatchResourceReturnsOnCall(i int, result1 error) {
+	fake.patchResourceMutex.Lock()
+	defer fake.patchResourceMutex.Unlock()
+	fake.PatchResourceStub = nil
+	if fake.patchResourceReturnsOnCall == nil {
+		fake.patchResourceReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.patchResourceReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeKube) SecretPresent(arg1 context.Context, arg2 string, arg3 string) (bool, error) {
 	fake.secretPresentMutex.Lock()
 	ret, specificReturn := fake.secretPresentReturnsOnCall[len(fake.secretPresentArgsForCall)]
@@ -898,6 +1051,10 @@ func (fake *FakeKube) Invocations() map[string][][]interface{} {
 	defer fake.getResourceMutex.RUnlock()
 	fake.getSecretMutex.RLock()
 	defer fake.getSecretMutex.RUnlock()
+	fake.listResourcesMutex.RLock()
+	defer fake.listResourcesMutex.RUnlock()
+	fake.patchResourceMutex.RLock()
+	defer fake.patchResourceMutex.RUnlock()
 	fake.secretPresentMutex.RLock()
 	defer fake.secretPresentMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -14,12 +14,14 @@ import (
 	"k8s.io/client-go/restmapper"
 	"k8s.io/client-go/tools/clientcmd"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 
 	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
 	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1beta1"
 	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
 	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
 	appsv1 "k8s.io/api/apps/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	corev1 "k8s.io/api/core/v1"
 	extensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -41,6 +43,7 @@ func CreateScheme() *apiruntime.Scheme {
 	_ = corev1.AddToScheme(scheme)
 	_ = extensionsv1.AddToScheme(scheme)
 	_ = appsv1.AddToScheme(scheme)
+	_ = authorizationv1.AddToScheme(scheme)
 
 	return scheme
 }
@@ -79,10 +82,22 @@ func NewKubeHTTPClient() (Kube, client.Client, error) {
 		}
 	}
 
+	return NewKubeHTTPClientWithConfig(config, kubeContext)
+}
+
+// NewKubeHTTPClientWithConfig creates a client for the cluster described by a rest config.
+// The REST mappings are discovered lazily so creating a client does not require the cluster to be reachable.
+func NewKubeHTTPClientWithConfig(config *rest.Config, clusterName string) (Kube, client.Client, error) {
 	scheme := CreateScheme()
 
+	mapper, err := apiutil.NewDynamicRESTMapper(config, apiutil.WithLazyDiscovery)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to initialize rest mapper: %w", err)
+	}
+
 	rawClient, err := client.New(config, client.Options{
 		Scheme: scheme,
+		Mapper: mapper,
 	})
 	if err != nil {
 		return nil, nil, fmt.Errorf("kubernetes client initialization failed: %w", err)
@@ -93,14 +108,14 @@ func NewKubeHTTPClient() (Kube, client.Client, error) {
 		return nil, nil, fmt.Errorf("failed to initialize discovery client: %s", err)
 	}
 
-	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(dc))
+	deferredMapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(dc))
 
 	dyn, err := dynamic.NewForConfig(config)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to initialize dynamic client: %s", err)
 	}
 
-	return &KubeHTTP{Client: rawClient, ClusterName: kubeContext, RestMapper: mapper, DynClient: dyn}, rawClient, nil
+	return &KubeHTTP{Client: rawClient, ClusterName: clusterName, RestMapper: deferredMapper, DynClient: dyn}, rawClient, nil
 }
 
 // This is an alternative implementation of the kube.Kube interface,
@@ -310,6 +325,22 @@ func (k *KubeHTTP) GetResource(ctx context.Context, name types.NamespacedName, r
 	return nil
 }
 
+func (k *KubeHTTP) ListResources(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	if err := k.Client.List(ctx, list, opts...); err != nil {
+		return fmt.Errorf("error listing resources: %w", err)
+	}
+
+	return nil
+}
+
+func (k *KubeHTTP) PatchResource(ctx context.Context, resource Resource, patch client.Patch) error {
+	if err := k.Client.Patch(ctx, resource, patch); err != nil {
+		return fmt.Errorf("error patching resource: %w", err)
+	}
+
+	return nil
+}
+
 func initialContexts(cfgLoadingRules *clientcmd.ClientConfigLoadingRules) (contexts []string, currentCtx string, err error) {
 	rules, err := cfgLoadingRules.Load()
 
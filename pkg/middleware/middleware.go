@@ -23,6 +23,13 @@ func (r *statusRecorder) WriteHeader(status int) {
 	r.ResponseWriter.WriteHeader(status)
 }
 
+// Flush lets streaming handlers push their responses through the recorder
+func (r *statusRecorder) Flush() {
+	if f, ok := r.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
 var RequestOkText = "request success"
 var RequestErrorText = "request error"
 var ServerErrorText = "server error"
@@ -68,6 +75,9 @@ func WithLogging(log logr.Logger, mux *runtime.ServeMux) http.Handler {
 
 type contextVals struct {
 	ProviderToken *oauth2.Token
+	User          *auth.User
+	// Token is the token the request was authenticated with
+	Token string
 }
 
 type key int
@@ -81,6 +91,13 @@ func WithProviderToken(jwtClient auth.JWTClient, h http.Handler, log logr.Logger
 		tokenStr := r.Header.Get("Authorization")
 		tokenSlice := strings.Split(tokenStr, "token ")
 
+		// Users logged in with a browser carry their token in the session cookie
+		fromCookie := false
+		if cookie, err := r.Cookie(auth.SessionCookieName); err == nil && len(tokenSlice) < 2 {
+			tokenSlice = []string{"", cookie.Value}
+			fromCookie = true
+		}
+
 		if len(tokenSlice) < 2 {
 			// No token specified. Nothing to be done.
 			// We do NOT return 400 here because there may be some 'unauthenticated' routes (ie /login)
@@ -92,6 +109,10 @@ func WithProviderToken(jwtClient auth.JWTClient, h http.Handler, log logr.Logger
 		token := tokenSlice[1]
 
 		claims, err := jwtClient.VerifyJWT(token)
+		if err != nil && fromCookie {
+			token, claims, err = refreshSession(jwtClient, w, r)
+		}
+
 		if err != nil {
 			log.V(logger.LogLevelWarn).Info("could not parse claims")
 			// Certain routes do not require a token, so pass the request through.
@@ -101,7 +122,7 @@ func WithProviderToken(jwtClient auth.JWTClient, h http.Handler, log logr.Logger
 			return
 		}
 
-		vals := contextVals{ProviderToken: &oauth2.Token{AccessToken: claims.ProviderToken}}
+		vals := contextVals{ProviderToken: &oauth2.Token{AccessToken: claims.ProviderToken}, User: claims.User(), Token: token}
 
 		c := context.WithValue(r.Context(), tokenKey, vals)
 		r = r.WithContext(c)
@@ -109,6 +130,28 @@ func WithProviderToken(jwtClient auth.JWTClient, h http.Handler, log logr.Logger
 	})
 }
 
+// refreshSession exchanges the refresh cookie of a browser session for new session cookies
+func refreshSession(jwtClient auth.JWTClient, w http.ResponseWriter, r *http.Request) (string, *auth.Claims, error) {
+	cookie, err := r.Cookie(auth.RefreshCookieName)
+	if err != nil {
+		return "", nil, err
+	}
+
+	accessToken, refreshToken, err := jwtClient.RefreshJWT(cookie.Value)
+	if err != nil {
+		return "", nil, err
+	}
+
+	claims, err := jwtClient.VerifyJWT(accessToken)
+	if err != nil {
+		return "", nil, err
+	}
+
+	auth.SetSessionCookies(w, r, accessToken, refreshToken)
+
+	return accessToken, claims, nil
+}
+
 // Get the token from request context.
 func ExtractProviderToken(ctx context.Context) (*oauth2.Token, error) {
 	c := ctx.Value(tokenKey)
@@ -124,3 +167,31 @@ func ExtractProviderToken(ctx context.Context) (*oauth2.Token, error) {
 
 	return vals.ProviderToken, nil
 }
+
+// Get the user the token of the request was issued to from request context.
+func ExtractUser(ctx context.Context) (*auth.User, error) {
+	vals, ok := ctx.Value(tokenKey).(contextVals)
+	if !ok || vals.User == nil {
+		return nil, errors.New("no user specified")
+	}
+
+	return vals.User, nil
+}
+
+// ContextWithUser returns a copy of the context holding the user, as WithProviderToken does for authenticated requests.
+func ContextWithUser(ctx context.Context, user *auth.User) context.Context {
+	vals, _ := ctx.Value(tokenKey).(contextVals)
+	vals.User = user
+
+	return context.WithValue(ctx, tokenKey, vals)
+}
+
+// Get the token the request was authenticated with from request context.
+func ExtractToken(ctx context.Context) (string, error) {
+	vals, ok := ctx.Value(tokenKey).(contextVals)
+	if !ok || vals.Token == "" {
+		return "", errors.New("no token specified")
+	}
+
+	return vals.Token, nil
+}
@@ -57,6 +57,49 @@ var _ = Describe("WithProviderToken", func() {
 		Expect(jwtClient.VerifyJWTArgsForCall(0)).To(Equal("my-jwt-token"))
 	})
 
+	It("extracts JWT token from the session cookie", func() {
+		midware := middleware.WithProviderToken(jwtClient, defaultHandler, log)
+
+		req := httptest.NewRequest(http.MethodGet, "http://www.foo.com", nil)
+		req.AddCookie(&http.Cookie{Name: auth.SessionCookieName, Value: "my-session-token"})
+
+		midware.ServeHTTP(httptest.NewRecorder(), req)
+
+		Expect(jwtClient.VerifyJWTArgsForCall(0)).To(Equal("my-session-token"))
+	})
+
+	It("refreshes an expired session with the refresh cookie", func() {
+		jwtClient.VerifyJWTStub = func(s string) (*auth.Claims, error) {
+			if s != "new-session-token" {
+				return nil, auth.ErrUnauthorizedToken
+			}
+
+			return &auth.Claims{ProviderToken: "provider-token"}, nil
+		}
+		jwtClient.RefreshJWTReturns("new-session-token", "new-refresh-token", nil)
+
+		midware := middleware.WithProviderToken(jwtClient, defaultHandler, log)
+
+		req := httptest.NewRequest(http.MethodGet, "http://www.foo.com", nil)
+		req.AddCookie(&http.Cookie{Name: auth.SessionCookieName, Value: "expired-session-token"})
+		req.AddCookie(&http.Cookie{Name: auth.RefreshCookieName, Value: "my-refresh-token"})
+
+		res := httptest.NewRecorder()
+		midware.ServeHTTP(res, req)
+
+		Expect(jwtClient.RefreshJWTArgsForCall(0)).To(Equal("my-refresh-token"))
+
+		cookies := map[string]string{}
+		for _, c := range res.Result().Cookies() {
+			cookies[c.Name] = c.Value
+		}
+
+		Expect(cookies).To(Equal(map[string]string{
+			auth.SessionCookieName: "new-session-token",
+			auth.RefreshCookieName: "new-refresh-token",
+		}))
+	})
+
 	It("passes the request through when a token is invalid", func() {
 		jwtClient.VerifyJWTStub = func(s string) (*auth.Claims, error) {
 			return nil, auth.ErrUnauthorizedToken
@@ -127,3 +170,50 @@ var _ = Describe("ExtractProviderToken", func() {
 		Expect(err).To(MatchError("no token specified"))
 	})
 })
+
+var _ = Describe("ExtractUser", func() {
+	var request *http.Request
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request = r
+	})
+
+	serve := func() {
+		midware := middleware.WithProviderToken(jwtClient, next, log)
+		req := httptest.NewRequest(http.MethodGet, "http://www.foo.com", nil)
+		req.Header.Add("Authorization", "token my-jwt-token")
+
+		midware.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	It("passes the user of the token into the context", func() {
+		jwtClient = &authfakes.FakeJWTClient{
+			VerifyJWTStub: func(s string) (*auth.Claims, error) {
+				claims := &auth.Claims{Groups: []string{"developers"}}
+				claims.Subject = "jane"
+
+				return claims, nil
+			},
+		}
+
+		serve()
+
+		user, err := middleware.ExtractUser(request.Context())
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(user).To(Equal(&auth.User{Name: "jane", Groups: []string{"developers"}}))
+	})
+
+	It("errors out when the token does not identify a user", func() {
+		jwtClient = &authfakes.FakeJWTClient{
+			VerifyJWTStub: func(s string) (*auth.Claims, error) {
+				return &auth.Claims{ProviderToken: "provider-token"}, nil
+			},
+		}
+
+		serve()
+
+		_, err := middleware.ExtractUser(request.Context())
+		Expect(err).To(MatchError("no user specified"))
+	})
+})
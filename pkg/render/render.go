@@ -0,0 +1,224 @@
+package render
+
+// Renders the manifests of the sources of applications the way Flux applies them: kustomizations are built
+// in-process with the kustomize API of the kustomize-controller, charts are rendered with the helm binary.
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/weaveworks/weave-gitops/pkg/runner"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/kustomize/api/filesys"
+	"sigs.k8s.io/kustomize/api/krusty"
+	kustypes "sigs.k8s.io/kustomize/api/types"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
+
+// HelmBinary is the binary charts are rendered with, looked up in the PATH
+const HelmBinary = "helm"
+
+// ErrRendererNotAvailable is returned when the binary manifests are rendered with is not installed
+var ErrRendererNotAvailable = errors.New("renderer not available")
+
+// kustomizationFiles are the file names kustomize reads a kustomization from
+var kustomizationFiles = []string{"kustomization.yaml", "kustomization.yml", "Kustomization"}
+
+// Renderer returns the manifests Flux applies for the source of an application
+//counterfeiter:generate . Renderer
+type Renderer interface {
+	// Kustomize returns the manifests built from a directory of a repository. As with the kustomize-controller,
+	// the manifests of the directory and its subdirectories are returned when it holds no kustomization.
+	Kustomize(dir string) ([]byte, error)
+	// Helm returns the manifests of a chart rendered with helm template
+	Helm(opts HelmOptions) ([]byte, error)
+}
+
+type HelmOptions struct {
+	ReleaseName string
+	Namespace   string
+	// Chart is the name of a chart of RepoURL, or the directory of a chart when RepoURL is empty
+	Chart   string
+	RepoURL string
+	// Version is the version, or semver constraint of the versions, of the chart. The latest version is used when empty
+	Version string
+	// Values are the values of the chart, as YAML or JSON
+	Values []byte
+}
+
+// LocalRenderer renders the manifests from the local copies of the sources
+type LocalRenderer struct {
+	runner runner.Runner
+}
+
+func New(cliRunner runner.Runner) *LocalRenderer {
+	return &LocalRenderer{runner: cliRunner}
+}
+
+func (r *LocalRenderer) Kustomize(dir string) ([]byte, error) {
+	if !hasKustomization(dir) {
+		return readManifests(dir)
+	}
+
+	// The build options of the kustomize-controller
+	kustomizer := krusty.MakeKustomizer(&krusty.Options{
+		DoLegacyResourceSort: true,
+		LoadRestrictions:     kustypes.LoadRestrictionsNone,
+		PluginConfig:         kustypes.DisabledPluginConfig(),
+	})
+
+	resources, err := kustomizer.Run(filesys.MakeFsOnDisk(), dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build kustomization %s: %w", dir, err)
+	}
+
+	manifests, err := resources.AsYaml()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build kustomization %s: %w", dir, err)
+	}
+
+	return manifests, nil
+}
+
+func (r *LocalRenderer) Helm(opts HelmOptions) ([]byte, error) {
+	workDir, err := ioutil.TempDir("", "helm-template-")
+	if err != nil {
+		return nil, fmt.Errorf("failed creating temp. directory to render chart: %w", err)
+	}
+	defer os.RemoveAll(workDir)
+
+	outDir := filepath.Join(workDir, "manifests")
+	if err := os.Mkdir(outDir, 0700); err != nil {
+		return nil, fmt.Errorf("failed creating temp. directory to render chart: %w", err)
+	}
+
+	args := []string{"template", opts.ReleaseName, opts.Chart, "--include-crds", "--output-dir", outDir}
+
+	if opts.Namespace != "" {
+		args = append(args, "--namespace", opts.Namespace)
+	}
+
+	if opts.RepoURL != "" {
+		args = append(args, "--repo", opts.RepoURL)
+	}
+
+	if opts.Version != "" {
+		args = append(args, "--version", opts.Version)
+	}
+
+	if len(opts.Values) > 0 {
+		valuesFile := filepath.Join(workDir, "values.yaml")
+		if err := ioutil.WriteFile(valuesFile, opts.Values, 0600); err != nil {
+			return nil, fmt.Errorf("failed writing chart values: %w", err)
+		}
+
+		args = append(args, "--values", valuesFile)
+	}
+
+	if output, err := r.runner.Run(HelmBinary, args...); err != nil {
+		if errors.Is(err, exec.ErrNotFound) {
+			return nil, fmt.Errorf("%w: the %s binary is needed to render helm charts", ErrRendererNotAvailable, HelmBinary)
+		}
+
+		return nil, fmt.Errorf("failed to run helm with output: %s and error: %w", string(output), err)
+	}
+
+	return readManifests(outDir)
+}
+
+// Objects returns the objects of a multi-document YAML or JSON stream, skipping the empty documents
+func Objects(manifests []byte) ([]unstructured.Unstructured, error) {
+	decoder := k8syaml.NewYAMLOrJSONDecoder(bytes.NewReader(manifests), 4096)
+	objects := []unstructured.Unstructured{}
+
+	for {
+		obj := map[string]interface{}{}
+
+		if err := decoder.Decode(&obj); err != nil {
+			if err == io.EOF {
+				break
+			}
+
+			return nil, fmt.Errorf("could not parse manifests: %w", err)
+		}
+
+		if len(obj) == 0 {
+			continue
+		}
+
+		u := unstructured.Unstructured{Object: obj}
+		if u.GetKind() == "" || u.GetAPIVersion() == "" {
+			continue
+		}
+
+		objects = append(objects, u)
+	}
+
+	return objects, nil
+}
+
+func hasKustomization(dir string) bool {
+	for _, name := range kustomizationFiles {
+		if _, err := os.Stat(filepath.Join(dir, name)); err == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// readManifests concatenates the YAML files of a directory and its subdirectories, sorted by path.
+// Hidden files and directories are skipped.
+func readManifests(dir string) ([]byte, error) {
+	paths := []string{}
+
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if path != dir && strings.HasPrefix(info.Name(), ".") {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if !info.IsDir() && (strings.HasSuffix(path, ".yaml") || strings.HasSuffix(path, ".yml")) {
+			paths = append(paths, path)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not read manifests of %s: %w", dir, err)
+	}
+
+	sort.Strings(paths)
+
+	var manifests bytes.Buffer
+
+	for _, path := range paths {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("could not read manifest %s: %w", path, err)
+		}
+
+		manifests.WriteString("---\n")
+		manifests.Write(data)
+		manifests.WriteString("\n")
+	}
+
+	return manifests.Bytes(), nil
+}
@@ -0,0 +1,150 @@
+package render_test
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/pkg/render"
+	"github.com/weaveworks/weave-gitops/pkg/runner/runnerfakes"
+)
+
+const deployment = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: podinfo
+`
+
+var (
+	runner   *runnerfakes.FakeRunner
+	renderer *render.LocalRenderer
+	dir      string
+)
+
+var _ = BeforeEach(func() {
+	runner = &runnerfakes.FakeRunner{}
+	renderer = render.New(runner)
+
+	var err error
+	dir, err = ioutil.TempDir("", "render-test-")
+	Expect(err).NotTo(HaveOccurred())
+})
+
+var _ = AfterEach(func() {
+	os.RemoveAll(dir)
+})
+
+func writeFile(path, content string) {
+	Expect(os.MkdirAll(filepath.Dir(path), 0755)).To(Succeed())
+	Expect(ioutil.WriteFile(path, []byte(content), 0644)).To(Succeed())
+}
+
+// outputFlag returns the value of a flag of the command line of the runner
+func outputFlag(args []string, flag string) string {
+	for i, arg := range args {
+		if arg == flag && i+1 < len(args) {
+			return args[i+1]
+		}
+	}
+
+	return ""
+}
+
+var _ = Describe("Kustomize", func() {
+	It("reads the manifests of a directory without kustomization", func() {
+		writeFile(filepath.Join(dir, "deployment.yaml"), deployment)
+		writeFile(filepath.Join(dir, "nested", "service.yml"), "apiVersion: v1\nkind: Service\nmetadata:\n  name: podinfo\n")
+		writeFile(filepath.Join(dir, ".hidden", "secret.yaml"), "apiVersion: v1\nkind: Secret\n")
+		writeFile(filepath.Join(dir, "README.md"), "# podinfo")
+
+		manifests, err := renderer.Kustomize(dir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(runner.RunCallCount()).To(Equal(0))
+
+		objects, err := render.Objects(manifests)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(objects).To(HaveLen(2))
+		Expect(objects[0].GetKind()).To(Equal("Deployment"))
+		Expect(objects[1].GetKind()).To(Equal("Service"))
+	})
+
+	It("builds the kustomization of a directory", func() {
+		writeFile(filepath.Join(dir, "kustomization.yaml"), "namePrefix: dev-\nresources:\n- deployment.yaml\n")
+		writeFile(filepath.Join(dir, "deployment.yaml"), deployment)
+
+		manifests, err := renderer.Kustomize(dir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(runner.RunCallCount()).To(Equal(0))
+
+		objects, err := render.Objects(manifests)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(objects).To(HaveLen(1))
+		Expect(objects[0].GetName()).To(Equal("dev-podinfo"))
+	})
+
+	It("fails when the kustomization is invalid", func() {
+		writeFile(filepath.Join(dir, "kustomization.yaml"), "resources:\n- missing.yaml\n")
+
+		_, err := renderer.Kustomize(dir)
+		Expect(err).To(MatchError(ContainSubstring("failed to build kustomization")))
+	})
+})
+
+var _ = Describe("Helm", func() {
+	It("renders a chart of a helm repository", func() {
+		runner.RunStub = func(_ string, args ...string) ([]byte, error) {
+			writeFile(filepath.Join(outputFlag(args, "--output-dir"), "podinfo", "templates", "deployment.yaml"), deployment)
+
+			values, err := ioutil.ReadFile(outputFlag(args, "--values"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(values)).To(Equal("replicaCount: 2"))
+
+			return nil, nil
+		}
+
+		manifests, err := renderer.Helm(render.HelmOptions{
+			ReleaseName: "podinfo",
+			Namespace:   "apps",
+			Chart:       "podinfo",
+			RepoURL:     "https://stefanprodan.github.io/podinfo",
+			Version:     "6.0.0",
+			Values:      []byte("replicaCount: 2"),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(manifests)).To(ContainSubstring("kind: Deployment"))
+
+		cmd, args := runner.RunArgsForCall(0)
+		Expect(cmd).To(Equal(render.HelmBinary))
+		Expect(strings.Join(args, " ")).To(HavePrefix("template podinfo podinfo --include-crds --output-dir "))
+		Expect(outputFlag(args, "--namespace")).To(Equal("apps"))
+		Expect(outputFlag(args, "--repo")).To(Equal("https://stefanprodan.github.io/podinfo"))
+		Expect(outputFlag(args, "--version")).To(Equal("6.0.0"))
+	})
+
+	It("fails when helm is not installed", func() {
+		runner.RunReturns(nil, &exec.Error{Name: render.HelmBinary, Err: exec.ErrNotFound})
+
+		_, err := renderer.Helm(render.HelmOptions{ReleaseName: "podinfo", Chart: "podinfo"})
+		Expect(err).To(MatchError(render.ErrRendererNotAvailable))
+	})
+
+	It("fails when helm fails", func() {
+		runner.RunReturns([]byte("chart not found"), os.ErrNotExist)
+
+		_, err := renderer.Helm(render.HelmOptions{ReleaseName: "podinfo", Chart: "podinfo"})
+		Expect(err).To(MatchError(ContainSubstring("chart not found")))
+	})
+})
+
+var _ = Describe("Objects", func() {
+	It("skips empty documents and documents which are not objects", func() {
+		objects, err := render.Objects([]byte("---\n# comment\n---\n" + deployment + "---\nfoo: bar\n"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(objects).To(HaveLen(1))
+		Expect(objects[0].GetName()).To(Equal("podinfo"))
+	})
+})
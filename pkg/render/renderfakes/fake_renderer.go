@@ -0,0 +1,195 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package renderfakes
+
+import (
+	"sync"
+
+	"github.com/weaveworks/weave-gitops/pkg/render"
+)
+
+type FakeRenderer struct {
+	HelmStub        func(render.HelmOptions) ([]byte, error)
+	helmMutex       sync.RWMutex
+	helmArgsForCall []struct {
+		arg1 render.HelmOptions
+	}
+	helmReturns struct {
+		result1 []byte
+		result2 error
+	}
+	helmReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 error
+	}
+	KustomizeStub        func(string) ([]byte, error)
+	kustomizeMutex       sync.RWMutex
+	kustomizeArgsForCall []struct {
+		arg1 string
+	}
+	kustomizeReturns struct {
+		result1 []byte
+		result2 error
+	}
+	kustomizeReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRenderer) Helm(arg1 render.HelmOptions) ([]byte, error) {
+	fake.helmMutex.Lock()
+	ret, specificReturn := fake.helmReturnsOnCall[len(fake.helmArgsForCall)]
+	fake.helmArgsForCall = append(fake.helmArgsForCall, struct {
+		arg1 render.HelmOptions
+	}{arg1})
+	stub := fake.HelmStub
+	fakeReturns := fake.helmReturns
+	fake.recordInvocation("Helm", []interface{}{arg1})
+	fake.helmMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRenderer) HelmCallCount() int {
+	fake.helmMutex.RLock()
+	defer fake.helmMutex.RUnlock()
+	return len(fake.helmArgsForCall)
+}
+
+func (fake *FakeRenderer) HelmCalls(stub func(render.HelmOptions) ([]byte, error)) {
+	fake.helmMutex.Lock()
+	defer fake.helmMutex.Unlock()
+	fake.HelmStub = stub
+}
+
+func (fake *FakeRenderer) HelmArgsForCall(i int) render.HelmOptions {
+	fake.helmMutex.RLock()
+	defer fake.helmMutex.RUnlock()
+	argsForCall := fake.helmArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeRenderer) HelmReturns(result1 []byte, result2 error) {
+	fake.helmMutex.Lock()
+	defer fake.helmMutex.Unlock()
+	fake.HelmStub = nil
+	fake.helmReturns = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRenderer) HelmReturnsOnCall(i int, result1 []byte, result2 error) {
+	fake.helmMutex.Lock()
+	defer fake.helmMutex.Unlock()
+	fake.HelmStub = nil
+	if fake.helmReturnsOnCall == nil {
+		fake.helmReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 error
+		})
+	}
+	fake.helmReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRenderer) Kustomize(arg1 string) ([]byte, error) {
+	fake.kustomizeMutex.Lock()
+	ret, specificReturn := fake.kustomizeReturnsOnCall[len(fake.kustomizeArgsForCall)]
+	fake.kustomizeArgsForCall = append(fake.kustomizeArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.KustomizeStub
+	fakeReturns := fake.kustomizeReturns
+	fake.recordInvocation("Kustomize", []interface{}{arg1})
+	fake.kustomizeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRenderer) KustomizeCallCount() int {
+	fake.kustomizeMutex.RLock()
+	defer fake.kustomizeMutex.RUnlock()
+	return len(fake.kustomizeArgsForCall)
+}
+
+func (fake *FakeRenderer) KustomizeCalls(stub func(string) ([]byte, error)) {
+	fake.kustomizeMutex.Lock()
+	defer fake.kustomizeMutex.Unlock()
+	fake.KustomizeStub = stub
+}
+
+func (fake *FakeRenderer) KustomizeArgsForCall(i int) string {
+	fake.kustomizeMutex.RLock()
+	defer fake.kustomizeMutex.RUnlock()
+	argsForCall := fake.kustomizeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeRenderer) KustomizeReturns(result1 []byte, result2 error) {
+	fake.kustomizeMutex.Lock()
+	defer fake.kustomizeMutex.Unlock()
+	fake.KustomizeStub = nil
+	fake.kustomizeReturns = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRenderer) KustomizeReturnsOnCall(i int, result1 []byte, result2 error) {
+	fake.kustomizeMutex.Lock()
+	defer fake.kustomizeMutex.Unlock()
+	fake.KustomizeStub = nil
+	if fake.kustomizeReturnsOnCall == nil {
+		fake.kustomizeReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 error
+		})
+	}
+	fake.kustomizeReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRenderer) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.helmMutex.RLock()
+	defer fake.helmMutex.RUnlock()
+	fake.kustomizeMutex.RLock()
+	defer fake.kustomizeMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeRenderer) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ render.Renderer = new(FakeRenderer)
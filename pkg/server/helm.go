@@ -0,0 +1,100 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/applications"
+	"github.com/weaveworks/weave-gitops/pkg/helm"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Labels added by the helm-controller post-renderer to the objects of a HelmRelease
+const HelmNameKey string = "helm.toolkit.fluxcd.io/name"
+const HelmNamespaceKey string = "helm.toolkit.fluxcd.io/namespace"
+
+// getHelmReleaseManifest returns the objects rendered by the deployed revision of a HelmRelease,
+// as recorded by Helm in its storage Secret. No objects are returned until a revision is deployed.
+func getHelmReleaseManifest(ctx context.Context, kubeClient client.Client, hr *helmv2.HelmRelease) ([]unstructured.Unstructured, error) {
+	secrets := &corev1.SecretList{}
+
+	opts := helm.DeployedStorageLabels(hr.GetReleaseName())
+
+	if err := kubeClient.List(ctx, secrets, client.InNamespace(hr.GetStorageNamespace()), opts); err != nil {
+		return nil, fmt.Errorf("could not list helm storage secrets: %w", err)
+	}
+
+	return helm.DeployedManifest(secrets.Items)
+}
+
+// getHelmReleaseObjects returns the objects of the given kinds created for a HelmRelease.
+// Those are found with the labels of the helm-controller, then with the manifest of the deployed release
+// to include the objects created before helm-controller labelled them.
+func getHelmReleaseObjects(ctx context.Context, kubeClient client.Client, name types.NamespacedName, kinds []*pb.GroupVersionKind) ([]unstructured.Unstructured, error) {
+	hr := &helmv2.HelmRelease{}
+	if err := kubeClient.Get(ctx, name, hr); err != nil {
+		return nil, fmt.Errorf("could not get helm release %s: %w", name, err)
+	}
+
+	result, err := listLabelledObjects(ctx, kubeClient, kinds, client.MatchingLabels{
+		HelmNameKey:      hr.Name,
+		HelmNamespaceKey: hr.Namespace,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	found := map[types.UID]bool{}
+	for _, obj := range result {
+		found[obj.GetUID()] = true
+	}
+
+	manifest, err := getHelmReleaseManifest(ctx, kubeClient, hr)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range manifest {
+		m := &manifest[i]
+
+		if !hasKind(kinds, m) {
+			continue
+		}
+
+		obj := unstructured.Unstructured{}
+		obj.SetGroupVersionKind(m.GroupVersionKind())
+
+		if err := kubeClient.Get(ctx, client.ObjectKeyFromObject(m), &obj); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+
+			return nil, fmt.Errorf("could not get %s %s: %w", m.GetKind(), m.GetName(), err)
+		}
+
+		if !found[obj.GetUID()] {
+			found[obj.GetUID()] = true
+
+			result = append(result, obj)
+		}
+	}
+
+	return result, nil
+}
+
+func hasKind(kinds []*pb.GroupVersionKind, obj *unstructured.Unstructured) bool {
+	gvk := obj.GroupVersionKind()
+
+	for _, k := range kinds {
+		if k.Group == gvk.Group && k.Version == gvk.Version && k.Kind == gvk.Kind {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,140 @@
+package server
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/applications"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const podinfoManifest = `---
+# Source: podinfo/templates/service.yaml
+apiVersion: v1
+kind: Service
+metadata:
+  name: podinfo
+spec:
+  ports:
+  - port: 9898
+---
+# Source: podinfo/templates/hpa.yaml
+---
+# Source: podinfo/templates/deployment.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: podinfo
+`
+
+// helmStorageSecret encodes a release the way the Helm secrets storage driver does
+func helmStorageSecret(name, namespace, manifest string, version string, status string) *corev1.Secret {
+	release, err := json.Marshal(map[string]string{"name": name, "namespace": namespace, "manifest": manifest})
+	Expect(err).NotTo(HaveOccurred())
+
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	_, err = w.Write(release)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(w.Close()).To(Succeed())
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "sh.helm.release.v1." + name + ".v" + version,
+			Namespace: "wego-system",
+			Labels: map[string]string{
+				"owner":   "helm",
+				"name":    name,
+				"status":  status,
+				"version": version,
+			},
+		},
+		Data: map[string][]byte{"release": []byte(base64.StdEncoding.EncodeToString(buf.Bytes()))},
+	}
+}
+
+var _ = Describe("Helm releases", func() {
+	var (
+		kubeClient client.Client
+		hr         *helmv2.HelmRelease
+	)
+
+	BeforeEach(func() {
+		hr = &helmv2.HelmRelease{
+			ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: "wego-system"},
+			Spec:       helmv2.HelmReleaseSpec{TargetNamespace: "apps"},
+		}
+
+		labelled := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "podinfo-redis",
+				Namespace: "apps",
+				UID:       "redis-uid",
+				Labels: map[string]string{
+					HelmNameKey:      "podinfo",
+					HelmNamespaceKey: "wego-system",
+				},
+			},
+		}
+		unlabelled := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: "apps", UID: "podinfo-uid"},
+		}
+		service := &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: "apps", UID: "service-uid"},
+		}
+
+		kubeClient = fake.NewClientBuilder().WithScheme(kube.CreateScheme()).WithObjects(
+			hr, labelled, unlabelled, service,
+			helmStorageSecret("apps-podinfo", "apps", "", "1", "superseded"),
+			helmStorageSecret("apps-podinfo", "apps", podinfoManifest, "2", "deployed"),
+		).Build()
+	})
+
+	It("reads the manifest of the deployed release", func() {
+		objects, err := getHelmReleaseManifest(context.Background(), kubeClient, hr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(objects).To(HaveLen(2))
+
+		Expect(objects[0].GetKind()).To(Equal("Service"))
+		Expect(objects[0].GetNamespace()).To(Equal("apps"))
+		Expect(objects[1].GetKind()).To(Equal("Deployment"))
+
+		kinds := addHelmReconciledKinds([]*pb.GroupVersionKind{}, objects)
+		Expect(kinds).To(HaveLen(2))
+		Expect(kinds[1].Group).To(Equal("apps"))
+	})
+
+	It("returns no objects before a release is deployed", func() {
+		hr.Name = "not-installed"
+
+		objects, err := getHelmReleaseManifest(context.Background(), kubeClient, hr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(objects).To(BeEmpty())
+	})
+
+	It("finds the objects of the requested kinds", func() {
+		kinds := []*pb.GroupVersionKind{{Group: "apps", Version: "v1", Kind: "Deployment"}}
+
+		objects, err := getHelmReleaseObjects(context.Background(), kubeClient, types.NamespacedName{Name: "podinfo", Namespace: "wego-system"}, kinds)
+		Expect(err).NotTo(HaveOccurred())
+
+		names := []string{}
+		for _, o := range objects {
+			names = append(names, o.GetName())
+		}
+
+		Expect(names).To(Equal([]string{"podinfo-redis", "podinfo"}))
+	})
+})
@@ -0,0 +1,204 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1beta1"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/applications"
+	"github.com/weaveworks/weave-gitops/pkg/middleware"
+	"github.com/weaveworks/weave-gitops/pkg/services/auth"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// accessReview answers the authorization questions of a request, remembering the answers given to its user.
+// A nil accessReview allows everything, which is how the server behaves when no Authorizer is configured.
+type accessReview struct {
+	authorizer auth.Authorizer
+	kubeClient client.Client
+	user       *auth.User
+
+	mu      sync.Mutex
+	answers map[authorizationv1.ResourceAttributes]bool
+}
+
+// requireUser returns the user the token of the request was issued to. The requests changing the state of the
+// cluster need one even when no Authorizer is configured, as the server makes the changes with its own service account.
+func requireUser(ctx context.Context) (*auth.User, error) {
+	user, err := middleware.ExtractUser(ctx)
+	if err != nil {
+		return nil, grpcStatus.Error(codes.Unauthenticated, err.Error())
+	}
+
+	return user, nil
+}
+
+// accessReview returns the access review of the user of the request in the named cluster
+func (s *applicationServer) accessReview(ctx context.Context, clusterName string) (*accessReview, error) {
+	if s.authorizer == nil {
+		return nil, nil
+	}
+
+	user, err := middleware.ExtractUser(ctx)
+	if err != nil {
+		return nil, grpcStatus.Error(codes.Unauthenticated, err.Error())
+	}
+
+	kubeClient, err := s.kubeClient(clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accessReview{
+		authorizer: s.authorizer,
+		kubeClient: kubeClient,
+		user:       user,
+		answers:    map[authorizationv1.ResourceAttributes]bool{},
+	}, nil
+}
+
+func (r *accessReview) can(ctx context.Context, attrs authorizationv1.ResourceAttributes) (bool, error) {
+	if r == nil {
+		return true, nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if allowed, ok := r.answers[attrs]; ok {
+		return allowed, nil
+	}
+
+	allowed, err := r.authorizer.Authorize(ctx, r.kubeClient, r.user, attrs)
+	if err != nil {
+		return false, err
+	}
+
+	r.answers[attrs] = allowed
+
+	return allowed, nil
+}
+
+// require returns a PermissionDenied error when the user is not allowed to perform the action
+func (r *accessReview) require(ctx context.Context, attrs authorizationv1.ResourceAttributes) error {
+	allowed, err := r.can(ctx, attrs)
+	if err != nil {
+		return err
+	}
+
+	if !allowed {
+		return grpcStatus.Errorf(codes.PermissionDenied, "user %q cannot %s %s %q in namespace %q",
+			r.user.Name, attrs.Verb, attrs.Resource, attrs.Name, attrs.Namespace)
+	}
+
+	return nil
+}
+
+// canKind reports whether the user may perform the action on objects of a kind.
+// The name is empty for collections, the namespace for cluster scoped objects.
+func (r *accessReview) canKind(ctx context.Context, verb string, gvk schema.GroupVersionKind, namespace, name string) (bool, error) {
+	if r == nil {
+		return true, nil
+	}
+
+	attrs, err := r.kindAttributes(verb, gvk, namespace, name)
+	if err != nil {
+		return false, err
+	}
+
+	return r.can(ctx, attrs)
+}
+
+// requireObject returns a PermissionDenied error when the user is not allowed to perform the action on the object
+func (r *accessReview) requireObject(ctx context.Context, verb string, obj client.Object) error {
+	if r == nil {
+		return nil
+	}
+
+	gvk, err := apiutil.GVKForObject(obj, r.kubeClient.Scheme())
+	if err != nil {
+		return fmt.Errorf("could not get the kind of %q: %w", obj.GetName(), err)
+	}
+
+	attrs, err := r.kindAttributes(verb, gvk, obj.GetNamespace(), obj.GetName())
+	if err != nil {
+		return err
+	}
+
+	return r.require(ctx, attrs)
+}
+
+func (r *accessReview) kindAttributes(verb string, gvk schema.GroupVersionKind, namespace, name string) (authorizationv1.ResourceAttributes, error) {
+	mapping, err := r.kubeClient.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return authorizationv1.ResourceAttributes{}, fmt.Errorf("could not get the resource of kind %s: %w", gvk.Kind, err)
+	}
+
+	return authorizationv1.ResourceAttributes{
+		Namespace: namespace,
+		Verb:      verb,
+		Group:     gvk.Group,
+		Resource:  mapping.Resource.Resource,
+		Name:      name,
+	}, nil
+}
+
+// requireAutomation checks the user may get the Kustomization or HelmRelease the reconciled objects are asked for
+func (r *accessReview) requireAutomation(ctx context.Context, msg *pb.GetReconciledObjectsReq) error {
+	var automation client.Object = &kustomizev1.Kustomization{}
+	if msg.AutomationKind == pb.AutomationKind_Helm {
+		automation = &helmv2.HelmRelease{}
+	}
+
+	automation.SetName(msg.AutomationName)
+	automation.SetNamespace(msg.AutomationNamespace)
+
+	return r.requireObject(ctx, "get", automation)
+}
+
+// appAttributes describes an action on the applications of a namespace, or on one of them when the name is set
+func appAttributes(verb, namespace, name string) authorizationv1.ResourceAttributes {
+	return authorizationv1.ResourceAttributes{
+		Namespace: namespace,
+		Verb:      verb,
+		Group:     wego.GroupVersion.Group,
+		Resource:  "apps",
+		Name:      name,
+	}
+}
+
+// filterObjects keeps the objects of the kinds the user may list in their namespace
+func (r *accessReview) filterObjects(ctx context.Context, objects []*pb.UnstructuredObject) ([]*pb.UnstructuredObject, error) {
+	if r == nil {
+		return objects, nil
+	}
+
+	allowed := []*pb.UnstructuredObject{}
+
+	for _, obj := range objects {
+		gvk := schema.GroupVersionKind{
+			Group:   obj.GroupVersionKind.Group,
+			Version: obj.GroupVersionKind.Version,
+			Kind:    obj.GroupVersionKind.Kind,
+		}
+
+		ok, err := r.canKind(ctx, "list", gvk, obj.Namespace, "")
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			allowed = append(allowed, obj)
+		}
+	}
+
+	return allowed, nil
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/fluxcd/go-git-providers/github"
@@ -28,31 +30,56 @@ import (
 	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
 	pb "github.com/weaveworks/weave-gitops/pkg/api/applications"
 	"github.com/weaveworks/weave-gitops/pkg/apputils"
+	"github.com/weaveworks/weave-gitops/pkg/clusters"
 	"github.com/weaveworks/weave-gitops/pkg/middleware"
+	"github.com/weaveworks/weave-gitops/pkg/render"
 	"github.com/weaveworks/weave-gitops/pkg/services/app"
 	"github.com/weaveworks/weave-gitops/pkg/utils"
 	"go.uber.org/zap"
 	grpcStatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/client-go/tools/clientcmd"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/kustomize/kstatus/status"
 )
 
 var ErrEmptyAccessToken = fmt.Errorf("access token is empty")
 
+// The Secrets of the gitops runtime namespace holding the token signing keys and the revoked tokens
+const (
+	JWTKeysSecretName        = "gitops-server-jwt-keys"
+	JWTRevocationsSecretName = "gitops-server-jwt-revocations"
+)
+
+// The paths of the OIDC login, the callback path is the one to register with the OIDC provider
+const (
+	OIDCLoginPath    = "/v1/oidc/login"
+	OIDCCallbackPath = "/v1/oidc/callback"
+)
+
+// providerUserTimeout bounds the requests looking up the git provider user of a login
+const providerUserTimeout = 10 * time.Second
+
 type applicationServer struct {
 	pb.UnimplementedApplicationsServer
 
-	appFactory   apputils.AppFactory
-	jwtClient    auth.JWTClient
-	log          logr.Logger
-	kube         client.Client
-	ghAuthClient auth.GithubAuthClient
+	appFactory    apputils.AppFactory
+	jwtClient     auth.JWTClient
+	log           logr.Logger
+	kube          client.Client
+	ghAuthClient  auth.GithubAuthClient
+	providerUsers auth.ProviderUserClient
+	watcher       *ApplicationWatcher
+	clusters      *clusters.Registry
+	authorizer    auth.Authorizer
 }
 
 // An ApplicationsConfig allows for the customization of an ApplicationsServer.
@@ -63,16 +90,35 @@ type ApplicationsConfig struct {
 	JwtClient        auth.JWTClient
 	KubeClient       client.Client
 	GithubAuthClient auth.GithubAuthClient
+	// ProviderUsers looks up the git provider user of the tokens issued by Authenticate and GetGithubAuthStatus,
+	// so they identify the user to the Authorizer. The tokens identify no user when nil
+	ProviderUsers auth.ProviderUserClient
+	// Watcher serves WatchApplications. The RPC is unimplemented when nil
+	Watcher *ApplicationWatcher
+	// Clusters holds the clusters requests can be sent to.
+	// Only the cluster of the AppFactory and KubeClient is known when nil
+	Clusters *clusters.Registry
+	// KeyRing holds the keys of the JwtClient, they are rotated in the background when set
+	KeyRing *auth.KeyRing
+	// OIDC logs users in with an OpenID Connect provider. Only git provider logins are available when nil
+	OIDC *auth.OIDCAuthenticator
+	// Authorizer restricts the responses to what the user of the request is allowed to see in Kubernetes RBAC.
+	// Every request sees what the service account of the server sees when nil
+	Authorizer auth.Authorizer
 }
 
 // NewApplicationsServer creates a grpc Applications server
 func NewApplicationsServer(cfg *ApplicationsConfig) pb.ApplicationsServer {
 	return &applicationServer{
-		jwtClient:    cfg.JwtClient,
-		log:          cfg.Logger,
-		appFactory:   cfg.AppFactory,
-		kube:         cfg.KubeClient,
-		ghAuthClient: cfg.GithubAuthClient,
+		jwtClient:     cfg.JwtClient,
+		log:           cfg.Logger,
+		appFactory:    cfg.AppFactory,
+		kube:          cfg.KubeClient,
+		ghAuthClient:  cfg.GithubAuthClient,
+		providerUsers: cfg.ProviderUsers,
+		watcher:       cfg.Watcher,
+		clusters:      cfg.Clusters,
+		authorizer:    cfg.Authorizer,
 	}
 }
 
@@ -85,30 +131,95 @@ func DefaultConfig() (*ApplicationsConfig, error) {
 
 	logr := zapr.NewLogger(zapLog)
 
-	rand.Seed(time.Now().UnixNano())
-	secretKey := rand.String(20)
-	jwtClient := auth.NewJwtClient(secretKey)
-
-	_, rawClient, err := kube.NewKubeHTTPClient()
+	kubeClient, rawClient, err := kube.NewKubeHTTPClient()
 	if err != nil {
 		return nil, fmt.Errorf("could not create kube http client: %w", err)
 	}
 
+	// The keys and the revoked tokens are shared by the replicas of the server and survive restarts
+	keyRing := auth.NewSecretKeyRing(rawClient, types.NamespacedName{Name: JWTKeysSecretName, Namespace: wego.DefaultNamespace})
+	if err := keyRing.Load(context.Background()); err != nil {
+		return nil, fmt.Errorf("could not load token signing keys: %w", err)
+	}
+
+	revocations := auth.NewSecretRevocationList(rawClient, types.NamespacedName{Name: JWTRevocationsSecretName, Namespace: wego.DefaultNamespace})
+	jwtClient := auth.NewJwtClientWithKeys(keyRing, revocations)
+
+	registry, err := defaultRegistry(kubeClient, rawClient, logr.WithName("clusters"))
+	if err != nil {
+		return nil, err
+	}
+
+	restCfg, err := ctrl.GetConfig()
+	if err != nil {
+		return nil, fmt.Errorf("could not get kubernetes config: %w", err)
+	}
+
+	// The server is only allowed to read the gitops runtime namespace
+	watchCache, err := cache.New(restCfg, cache.Options{Scheme: kube.CreateScheme(), Namespace: wego.DefaultNamespace})
+	if err != nil {
+		return nil, fmt.Errorf("could not create application cache: %w", err)
+	}
+
+	watcher, err := NewApplicationWatcher(context.Background(), watchCache, logr.WithName("watcher"))
+	if err != nil {
+		return nil, fmt.Errorf("could not create application watcher: %w", err)
+	}
+
 	return &ApplicationsConfig{
 		Logger:           logr,
 		AppFactory:       &apputils.DefaultAppFactory{},
 		JwtClient:        jwtClient,
+		KeyRing:          keyRing,
 		KubeClient:       rawClient,
 		GithubAuthClient: auth.NewGithubAuthProvider(http.DefaultClient),
+		ProviderUsers:    &auth.ProviderUsers{HTTPClient: &http.Client{Timeout: providerUserTimeout}},
+		Watcher:          watcher,
+		Clusters:         registry,
 	}, nil
 }
 
+// defaultRegistry registers the cluster the server runs against, the other contexts of the kubeconfig
+// and the clusters stored in Secrets of the gitops runtime namespace.
+func defaultRegistry(kubeClient kube.Kube, rawClient client.Client, log logr.Logger) (*clusters.Registry, error) {
+	ctx := context.Background()
+
+	name, err := kubeClient.GetClusterName(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get cluster name: %w", err)
+	}
+
+	if name == "" {
+		name = clusters.DefaultClusterName
+	}
+
+	registry := clusters.NewRegistry(&clusters.Cluster{Name: name, Kube: kubeClient, Client: rawClient})
+
+	// Clusters which cannot be loaded are logged and skipped so the others are still served.
+	// There is no kubeconfig when running in a cluster.
+	if config, err := clientcmd.NewDefaultClientConfigLoadingRules().Load(); err == nil {
+		if err := registry.LoadKubeconfig(*config); err != nil {
+			log.Error(err, "skipped kubeconfig clusters")
+		}
+	}
+
+	if err := registry.LoadSecrets(ctx, wego.DefaultNamespace); err != nil {
+		log.Error(err, "skipped cluster secrets")
+	}
+
+	return registry, nil
+}
+
 // NewApplicationsHandler allow for other applications to embed the Weave GitOps HTTP API.
 // This handler can be muxed with other services or used as a standalone service.
 func NewApplicationsHandler(ctx context.Context, cfg *ApplicationsConfig, opts ...runtime.ServeMuxOption) (http.Handler, error) {
 	appsSrv := NewApplicationsServer(cfg)
 
-	mux := runtime.NewServeMux(middleware.WithGrpcErrorLogging(cfg.Logger))
+	mux := runtime.NewServeMux(
+		middleware.WithGrpcErrorLogging(cfg.Logger),
+		runtime.WithMarshalerOption(eventStreamContentType, &eventStreamMarshaler{Marshaler: &runtime.JSONPb{}}),
+		runtime.WithForwardResponseOption(clearSessionOnLogout),
+	)
 	httpHandler := middleware.WithLogging(cfg.Logger, mux)
 	httpHandler = middleware.WithProviderToken(cfg.JwtClient, httpHandler, cfg.Logger)
 
@@ -116,40 +227,194 @@ func NewApplicationsHandler(ctx context.Context, cfg *ApplicationsConfig, opts .
 		return nil, fmt.Errorf("could not register application: %w", err)
 	}
 
+	if cfg.OIDC != nil {
+		if err := mux.HandlePath(http.MethodGet, OIDCLoginPath, handlePath(cfg.OIDC.LoginHandler())); err != nil {
+			return nil, fmt.Errorf("could not register oidc login: %w", err)
+		}
+
+		if err := mux.HandlePath(http.MethodGet, OIDCCallbackPath, handlePath(cfg.OIDC.CallbackHandler())); err != nil {
+			return nil, fmt.Errorf("could not register oidc callback: %w", err)
+		}
+	}
+
+	// Registered last to take precedence over the in-process handler, which does not support streaming
+	if err := mux.HandlePath(http.MethodGet, "/v1/watch/applications", watchApplicationsHandler(mux, appsSrv)); err != nil {
+		return nil, fmt.Errorf("could not register application watch: %w", err)
+	}
+
+	if cfg.KeyRing != nil {
+		go cfg.KeyRing.RotateEvery(ctx, auth.DefaultKeyRotationInterval, auth.DefaultKeysKept, cfg.Logger.WithName("keys"))
+	}
+
+	if cfg.Watcher != nil {
+		go func() {
+			if err := cfg.Watcher.Start(ctx); err != nil {
+				cfg.Logger.Error(err, "application watcher stopped")
+			}
+		}()
+	}
+
 	return httpHandler, nil
 }
 
+// clearSessionOnLogout removes the session cookies of the browsers logging out
+func clearSessionOnLogout(ctx context.Context, w http.ResponseWriter, msg proto.Message) error {
+	if _, ok := msg.(*pb.LogoutResponse); ok {
+		auth.ClearSessionCookies(w)
+	}
+
+	return nil
+}
+
+// handlePath serves a plain http.Handler on a path of the gateway mux
+func handlePath(h http.Handler) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		h.ServeHTTP(w, r)
+	}
+}
+
 func (s *applicationServer) ListApplications(ctx context.Context, msg *pb.ListApplicationsRequest) (*pb.ListApplicationsResponse, error) {
-	kubeService, kubeErr := s.appFactory.GetKubeService()
-	if kubeErr != nil {
-		return nil, fmt.Errorf("failed to create kube service: %w", kubeErr)
+	names := []string{msg.ClusterName}
+
+	if msg.ClusterName == "" && s.clusters != nil {
+		names = []string{}
+		for _, c := range s.clusters.List() {
+			names = append(names, c.Name)
+		}
+	}
+
+	// List the clusters in parallel, the results are merged in the order of the names
+	results := make([][]*pb.Application, len(names))
+	errs := make([]error, len(names))
+
+	var wg sync.WaitGroup
+
+	for i, name := range names {
+		wg.Add(1)
+
+		go func(i int, name string) {
+			defer wg.Done()
+
+			results[i], errs[i] = s.listClusterApplications(ctx, name, msg.Namespace)
+		}(i, name)
+	}
+
+	wg.Wait()
+
+	list := []*pb.Application{}
+	clusterErrors := []*pb.ClusterError{}
+
+	for i, name := range names {
+		if errs[i] != nil {
+			clusterErrors = append(clusterErrors, &pb.ClusterError{ClusterName: name, Message: errs[i].Error()})
+			continue
+		}
+
+		list = append(list, results[i]...)
+	}
+
+	// The applications of the reachable clusters are returned along with the errors of the others,
+	// unless no cluster could be listed
+	if len(clusterErrors) == len(names) {
+		return nil, errs[0]
+	}
+
+	return &pb.ListApplicationsResponse{
+		Applications:  list,
+		ClusterErrors: clusterErrors,
+	}, nil
+}
+
+func (s *applicationServer) listClusterApplications(ctx context.Context, clusterName, namespace string) ([]*pb.Application, error) {
+	kubeService, clusterName, err := s.kubeService(clusterName)
+	if err != nil {
+		return nil, err
 	}
 
-	apps, err := kubeService.GetApplications(ctx, msg.Namespace)
+	review, err := s.accessReview(ctx, clusterName)
 	if err != nil {
 		return nil, err
 	}
 
-	if apps == nil {
-		return &pb.ListApplicationsResponse{
-			Applications: []*pb.Application{},
-		}, nil
+	apps, err := kubeService.GetApplications(ctx, namespace)
+	if err != nil {
+		if clusterName != "" {
+			return nil, fmt.Errorf("could not list applications of cluster %q: %w", clusterName, err)
+		}
+
+		return nil, err
 	}
 
 	list := []*pb.Application{}
+
 	for _, a := range apps {
-		list = append(list, &pb.Application{Name: a.Name})
+		allowed, err := review.can(ctx, appAttributes("list", a.Namespace, ""))
+		if err != nil {
+			return nil, err
+		}
+
+		if allowed {
+			list = append(list, &pb.Application{Name: a.Name, ClusterName: clusterName})
+		}
 	}
 
-	return &pb.ListApplicationsResponse{
-		Applications: list,
-	}, nil
+	return list, nil
+}
+
+// kubeService returns the kube service and the name of the named cluster, or of the default cluster when the name is empty
+func (s *applicationServer) kubeService(clusterName string) (kube.Kube, string, error) {
+	if s.clusters == nil {
+		if clusterName != "" {
+			return nil, "", grpcStatus.Errorf(codes.NotFound, "%s: %s", clusters.ErrClusterNotFound, clusterName)
+		}
+
+		kubeService, err := s.appFactory.GetKubeService()
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to create kube service: %w", err)
+		}
+
+		return kubeService, "", nil
+	}
+
+	cluster, err := s.clusters.Get(clusterName)
+	if err != nil {
+		return nil, "", grpcStatus.Error(codes.NotFound, err.Error())
+	}
+
+	return cluster.Kube, cluster.Name, nil
+}
+
+// kubeClient returns the client of the named cluster, or of the default cluster when the name is empty
+func (s *applicationServer) kubeClient(clusterName string) (client.Client, error) {
+	if s.clusters == nil {
+		if clusterName != "" {
+			return nil, grpcStatus.Errorf(codes.NotFound, "%s: %s", clusters.ErrClusterNotFound, clusterName)
+		}
+
+		return s.kube, nil
+	}
+
+	cluster, err := s.clusters.Get(clusterName)
+	if err != nil {
+		return nil, grpcStatus.Error(codes.NotFound, err.Error())
+	}
+
+	return cluster.Client, nil
 }
 
 func (s *applicationServer) GetApplication(ctx context.Context, msg *pb.GetApplicationRequest) (*pb.GetApplicationResponse, error) {
-	kubeClient, kubeErr := s.appFactory.GetKubeService()
+	kubeClient, clusterName, kubeErr := s.kubeService(msg.ClusterName)
 	if kubeErr != nil {
-		return nil, fmt.Errorf("failed to create kube service: %w", kubeErr)
+		return nil, kubeErr
+	}
+
+	review, err := s.accessReview(ctx, msg.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := review.require(ctx, appAttributes("get", msg.Namespace, msg.Name)); err != nil {
+		return nil, err
 	}
 
 	app, err := kubeClient.GetApplication(ctx, types.NamespacedName{Name: msg.Name, Namespace: msg.Namespace})
@@ -198,6 +463,18 @@ func (s *applicationServer) GetApplication(ctx context.Context, msg *pb.GetAppli
 		case *helmv2.HelmRelease:
 			helmRelease = at
 			deploymentType = pb.AutomationKind_Helm
+
+			rawClient, err := s.kubeClient(msg.ClusterName)
+			if err != nil {
+				return nil, err
+			}
+
+			manifest, err := getHelmReleaseManifest(ctx, rawClient, at)
+			if err != nil {
+				return nil, fmt.Errorf("could not get helm release objects for app %s: %w", app.Name, err)
+			}
+
+			reconciledKinds = addHelmReconciledKinds(reconciledKinds, manifest)
 		}
 	}
 
@@ -211,6 +488,9 @@ func (s *applicationServer) GetApplication(ctx context.Context, msg *pb.GetAppli
 		HelmRelease:           mapHelmReleaseSpecToResponse(helmRelease),
 		Source:                mapSourceSpecToReponse(src),
 		ReconciledObjectKinds: reconciledKinds,
+		Conditions:            mapConditions(app.Status.Conditions),
+		LastAppliedRevision:   app.Status.LastAppliedRevision,
+		ClusterName:           clusterName,
 	}}, nil
 }
 
@@ -293,7 +573,7 @@ func mapSourceSpecToReponse(src client.Object) *pb.Source {
 	return source
 }
 
-//Until the middleware is done this function will not be able to get the token and will fail
+// Until the middleware is done this function will not be able to get the token and will fail
 func (s *applicationServer) ListCommits(ctx context.Context, msg *pb.ListCommitsRequest) (*pb.ListCommitsResponse, error) {
 	providerToken, err := middleware.ExtractProviderToken(ctx)
 	if err != nil {
@@ -313,7 +593,26 @@ func (s *applicationServer) ListCommits(ctx context.Context, msg *pb.ListCommits
 		PageToken:        pageToken,
 	}
 
-	appService, appErr := s.appFactory.GetAppService(ctx, params.Name, params.Namespace)
+	kubeService, _, err := s.kubeService(msg.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	rawClient, err := s.kubeClient(msg.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	review, err := s.accessReview(ctx, msg.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := review.require(ctx, appAttributes("get", params.Namespace, params.Name)); err != nil {
+		return nil, err
+	}
+
+	appService, appErr := s.appFactory.GetClusterAppService(ctx, kubeService, rawClient, params.Name, params.Namespace)
 	if appErr != nil {
 		return nil, fmt.Errorf("failed to create app service: %w", appErr)
 	}
@@ -350,35 +649,376 @@ func (s *applicationServer) ListCommits(ctx context.Context, msg *pb.ListCommits
 	}, nil
 }
 
+func (s *applicationServer) ListEvents(ctx context.Context, msg *pb.ListEventsRequest) (*pb.ListEventsResponse, error) {
+	kubeService, _, err := s.kubeService(msg.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	review, err := s.accessReview(ctx, msg.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := review.require(ctx, appAttributes("get", msg.Namespace, msg.Name)); err != nil {
+		return nil, err
+	}
+
+	params := app.EventsParams{
+		Name:      msg.Name,
+		Namespace: msg.Namespace,
+		Type:      msg.Type,
+	}
+
+	if msg.Since != 0 {
+		params.Since = time.Unix(msg.Since, 0)
+	}
+
+	if msg.Until != 0 {
+		params.Until = time.Unix(msg.Until, 0)
+	}
+
+	events, err := app.ListEvents(ctx, kubeService, params)
+	if err != nil {
+		switch {
+		case errors.Is(err, app.ErrInvalidEventType):
+			return nil, grpcStatus.Error(codes.InvalidArgument, err.Error())
+		case apierrors.IsNotFound(err):
+			return nil, grpcStatus.Error(codes.NotFound, err.Error())
+		}
+
+		return nil, fmt.Errorf("could not list events of application %q: %w", msg.Name, err)
+	}
+
+	list := []*pb.Event{}
+
+	for _, e := range events {
+		allowed, err := review.can(ctx, authorizationv1.ResourceAttributes{Namespace: e.Namespace, Verb: "list", Resource: "events"})
+		if err != nil {
+			return nil, err
+		}
+
+		if !allowed {
+			continue
+		}
+
+		list = append(list, &pb.Event{
+			Type:            e.Type,
+			Reason:          e.Reason,
+			Message:         e.Message,
+			Timestamp:       app.EventTime(e).Unix(),
+			Count:           e.Count,
+			ObjectKind:      e.InvolvedObject.Kind,
+			ObjectName:      e.InvolvedObject.Name,
+			ObjectNamespace: e.InvolvedObject.Namespace,
+			Source:          e.Source.Component,
+		})
+	}
+
+	return &pb.ListEventsResponse{Events: list}, nil
+}
+
+func (s *applicationServer) SyncApplication(ctx context.Context, msg *pb.SyncApplicationRequest) (*pb.SyncApplicationResponse, error) {
+	kubeService, _, err := s.kubeService(msg.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.requireSync(ctx, kubeService, msg); err != nil {
+		return nil, err
+	}
+
+	params := app.SyncParams{
+		Name:      msg.Name,
+		Namespace: msg.Namespace,
+		Wait:      msg.Wait,
+		Timeout:   time.Duration(msg.Timeout) * time.Second,
+	}
+
+	result, err := app.SyncApplication(ctx, kubeService, ioutil.Discard, params)
+	if err != nil {
+		switch {
+		case errors.Is(err, app.ErrAppPaused):
+			return nil, grpcStatus.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, app.ErrSyncTimeout):
+			return nil, grpcStatus.Error(codes.DeadlineExceeded, err.Error())
+		case apierrors.IsNotFound(err):
+			return nil, grpcStatus.Error(codes.NotFound, err.Error())
+		}
+
+		return nil, fmt.Errorf("could not sync application %q: %w", msg.Name, err)
+	}
+
+	return &pb.SyncApplicationResponse{
+		PreviousSourceRevision:  result.PreviousSourceRevision,
+		SourceRevision:          result.SourceRevision,
+		PreviousAppliedRevision: result.PreviousAppliedRevision,
+		AppliedRevision:         result.AppliedRevision,
+	}, nil
+}
+
+// requireSync checks the request is authenticated and its user may patch the flux objects of the application
+func (s *applicationServer) requireSync(ctx context.Context, kubeService kube.Kube, msg *pb.SyncApplicationRequest) error {
+	if _, err := requireUser(ctx); err != nil {
+		return err
+	}
+
+	review, err := s.accessReview(ctx, msg.ClusterName)
+	if err != nil || review == nil {
+		return err
+	}
+
+	if err := review.require(ctx, appAttributes("get", msg.Namespace, msg.Name)); err != nil {
+		return err
+	}
+
+	application, err := kubeService.GetApplication(ctx, types.NamespacedName{Name: msg.Name, Namespace: msg.Namespace})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return grpcStatus.Error(codes.NotFound, err.Error())
+		}
+
+		return fmt.Errorf("could not get application %q: %w", msg.Name, err)
+	}
+
+	src, deployment, err := findFluxObjects(application)
+	if err != nil {
+		return err
+	}
+
+	for _, obj := range []client.Object{src, deployment} {
+		obj.SetName(application.Name)
+		obj.SetNamespace(application.Namespace)
+
+		if err := review.requireObject(ctx, "patch", obj); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *applicationServer) PauseApplications(ctx context.Context, msg *pb.PauseApplicationsRequest) (*pb.PauseApplicationsResponse, error) {
+	params := app.PauseAppsParams{
+		Namespace:     msg.Namespace,
+		All:           msg.All,
+		LabelSelector: msg.LabelSelector,
+		Reason:        msg.Reason,
+	}
+
+	results, err := s.suspendApplications(ctx, msg.ClusterName, wego.SuspendAction, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.PauseApplicationsResponse{Results: results}, nil
+}
+
+func (s *applicationServer) UnpauseApplications(ctx context.Context, msg *pb.UnpauseApplicationsRequest) (*pb.UnpauseApplicationsResponse, error) {
+	params := app.PauseAppsParams{
+		Namespace:     msg.Namespace,
+		All:           msg.All,
+		LabelSelector: msg.LabelSelector,
+	}
+
+	results, err := s.suspendApplications(ctx, msg.ClusterName, wego.ResumeAction, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UnpauseApplicationsResponse{Results: results}, nil
+}
+
+func (s *applicationServer) suspendApplications(ctx context.Context, clusterName string, action wego.SuspendActionType, params app.PauseAppsParams) ([]*pb.PauseResult, error) {
+	user, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The user of the token is recorded as the one pausing the applications
+	params.PausedBy = user.Name
+
+	kubeService, _, err := s.kubeService(clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.requireSuspend(ctx, clusterName, params.Namespace); err != nil {
+		return nil, err
+	}
+
+	results, err := app.SuspendApplications(ctx, kubeService, action, params)
+	if err != nil {
+		if errors.Is(err, app.ErrNoAppsSelected) || errors.Is(err, app.ErrInvalidLabelSelector) {
+			return nil, grpcStatus.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, err
+	}
+
+	list := []*pb.PauseResult{}
+
+	for _, result := range results {
+		r := &pb.PauseResult{
+			Name:      result.Name,
+			Namespace: result.Namespace,
+			Changed:   result.Changed,
+		}
+
+		if result.Err != nil {
+			r.Error = result.Err.Error()
+		}
+
+		list = append(list, r)
+	}
+
+	return list, nil
+}
+
+// requireSuspend checks the user of the request may list the applications of the namespace
+// and patch its Kustomizations and HelmReleases
+func (s *applicationServer) requireSuspend(ctx context.Context, clusterName, namespace string) error {
+	review, err := s.accessReview(ctx, clusterName)
+	if err != nil || review == nil {
+		return err
+	}
+
+	if err := review.require(ctx, appAttributes("list", namespace, "")); err != nil {
+		return err
+	}
+
+	for _, obj := range []client.Object{&kustomizev1.Kustomization{}, &helmv2.HelmRelease{}} {
+		obj.SetNamespace(namespace)
+
+		if err := review.requireObject(ctx, "patch", obj); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *applicationServer) DiffApplication(ctx context.Context, msg *pb.DiffApplicationRequest) (*pb.DiffApplicationResponse, error) {
+	kubeService, _, err := s.kubeService(msg.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	rawClient, err := s.kubeClient(msg.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	review, err := s.accessReview(ctx, msg.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := review.require(ctx, appAttributes("get", msg.Namespace, msg.Name)); err != nil {
+		return nil, err
+	}
+
+	appService, appErr := s.appFactory.GetClusterAppService(ctx, kubeService, rawClient, msg.Name, msg.Namespace)
+	if appErr != nil {
+		return nil, fmt.Errorf("failed to create app service: %w", appErr)
+	}
+
+	result, err := appService.Diff(app.DiffParams{Name: msg.Name, Namespace: msg.Namespace})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, grpcStatus.Error(codes.NotFound, err.Error())
+		}
+
+		if errors.Is(err, render.ErrRendererNotAvailable) {
+			return nil, grpcStatus.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		return nil, fmt.Errorf("could not diff application %q: %w", msg.Name, err)
+	}
+
+	// The diffs show the contents of the objects, only the objects the user may read are kept
+	allowed := &app.DiffResult{}
+
+	for _, obj := range result.Objects {
+		ok, err := review.canKind(ctx, "get", obj.GroupVersionKind, obj.Namespace, obj.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			allowed.Objects = append(allowed.Objects, obj)
+		}
+	}
+
+	objects := []*pb.ObjectDiff{}
+
+	for _, obj := range allowed.Objects {
+		objects = append(objects, &pb.ObjectDiff{
+			GroupVersionKind: &pb.GroupVersionKind{
+				Group:   obj.GroupVersionKind.Group,
+				Version: obj.GroupVersionKind.Version,
+				Kind:    obj.GroupVersionKind.Kind,
+			},
+			Name:      obj.Name,
+			Namespace: obj.Namespace,
+			Status:    string(obj.Status),
+			Diff:      obj.Diff,
+		})
+	}
+
+	summary := allowed.Summary()
+
+	return &pb.DiffApplicationResponse{
+		Objects: objects,
+		Summary: &pb.DriftSummary{
+			InSync:   int32(summary.InSync),
+			Modified: int32(summary.Modified),
+			Missing:  int32(summary.Missing),
+			Extra:    int32(summary.Extra),
+		},
+	}, nil
+}
+
 const KustomizeNameKey string = "kustomize.toolkit.fluxcd.io/name"
 const KustomizeNamespaceKey string = "kustomize.toolkit.fluxcd.io/namespace"
 
 func (s *applicationServer) GetReconciledObjects(ctx context.Context, msg *pb.GetReconciledObjectsReq) (*pb.GetReconciledObjectsRes, error) {
-	if msg.AutomationKind == pb.AutomationKind_Helm {
-		return nil, grpcStatus.Error(codes.Unimplemented, "Helm is not currently supported for this method")
+	kubeClient, err := s.kubeClient(msg.ClusterName)
+	if err != nil {
+		return nil, err
 	}
 
-	result := []unstructured.Unstructured{}
+	review, err := s.accessReview(ctx, msg.ClusterName)
+	if err != nil {
+		return nil, err
+	}
 
-	for _, gvk := range msg.Kinds {
-		list := unstructured.UnstructuredList{}
+	if err := review.requireAutomation(ctx, msg); err != nil {
+		return nil, err
+	}
 
-		list.SetGroupVersionKind(schema.GroupVersionKind{
-			Group:   gvk.Group,
-			Kind:    gvk.Kind,
-			Version: gvk.Version,
-		})
+	var result []unstructured.Unstructured
 
-		opts := client.MatchingLabels{
+	switch msg.AutomationKind {
+	case pb.AutomationKind_Helm:
+		name := types.NamespacedName{Name: msg.AutomationName, Namespace: msg.AutomationNamespace}
+
+		result, err = getHelmReleaseObjects(ctx, kubeClient, name, msg.Kinds)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, grpcStatus.Error(codes.NotFound, err.Error())
+			}
+
+			return nil, err
+		}
+	default:
+		result, err = listLabelledObjects(ctx, kubeClient, msg.Kinds, client.MatchingLabels{
 			KustomizeNameKey:      msg.AutomationName,
 			KustomizeNamespaceKey: msg.AutomationNamespace,
+		})
+		if err != nil {
+			return nil, err
 		}
-
-		if err := s.kube.List(ctx, &list, opts); err != nil {
-			return nil, fmt.Errorf("could not get unstructured list: %s\n", err)
-		}
-
-		result = append(result, list.Items...)
 	}
 
 	objects := []*pb.UnstructuredObject{}
@@ -403,10 +1043,48 @@ func (s *applicationServer) GetReconciledObjects(ctx context.Context, msg *pb.Ge
 		})
 	}
 
+	objects, err = review.filterObjects(ctx, objects)
+	if err != nil {
+		return nil, err
+	}
+
 	return &pb.GetReconciledObjectsRes{Objects: objects}, nil
 }
 
+// listLabelledObjects lists the objects of the given kinds matching the labels
+func listLabelledObjects(ctx context.Context, kubeClient client.Client, kinds []*pb.GroupVersionKind, opts client.MatchingLabels) ([]unstructured.Unstructured, error) {
+	result := []unstructured.Unstructured{}
+
+	for _, gvk := range kinds {
+		list := unstructured.UnstructuredList{}
+
+		list.SetGroupVersionKind(schema.GroupVersionKind{
+			Group:   gvk.Group,
+			Kind:    gvk.Kind,
+			Version: gvk.Version,
+		})
+
+		if err := kubeClient.List(ctx, &list, opts); err != nil {
+			return nil, fmt.Errorf("could not get unstructured list: %s\n", err)
+		}
+
+		result = append(result, list.Items...)
+	}
+
+	return result, nil
+}
+
 func (s *applicationServer) GetChildObjects(ctx context.Context, msg *pb.GetChildObjectsReq) (*pb.GetChildObjectsRes, error) {
+	kubeClient, err := s.kubeClient(msg.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	review, err := s.accessReview(ctx, msg.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+
 	list := unstructured.UnstructuredList{}
 
 	list.SetGroupVersionKind(schema.GroupVersionKind{
@@ -415,7 +1093,7 @@ func (s *applicationServer) GetChildObjects(ctx context.Context, msg *pb.GetChil
 		Kind:    msg.GroupVersionKind.Kind,
 	})
 
-	if err := s.kube.List(ctx, &list); err != nil {
+	if err := kubeClient.List(ctx, &list); err != nil {
 		return nil, fmt.Errorf("could not get unstructured object: %s\n", err)
 	}
 
@@ -452,6 +1130,11 @@ Items:
 		})
 	}
 
+	objects, err = review.filterObjects(ctx, objects)
+	if err != nil {
+		return nil, err
+	}
+
 	return &pb.GetChildObjectsRes{Objects: objects}, nil
 }
 
@@ -477,12 +1160,22 @@ func (s *applicationServer) GetGithubAuthStatus(ctx context.Context, msg *pb.Get
 		return nil, fmt.Errorf("error getting github device code status: %w", err)
 	}
 
-	t, err := s.jwtClient.GenerateJWT(auth.ExpirationTime, gitproviders.GitProviderGitHub, token)
+	claims, err := s.providerClaims(ctx, gitproviders.GitProviderGitHub, token)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := s.jwtClient.GenerateAccessJWT(auth.ExpirationTime, claims)
 	if err != nil {
 		return nil, fmt.Errorf("could not generate token: %w", err)
 	}
 
-	return &pb.GetGithubAuthStatusResponse{AccessToken: t}, nil
+	refreshToken, err := s.jwtClient.GenerateRefreshJWT(auth.RefreshExpirationTime, claims)
+	if err != nil {
+		return nil, fmt.Errorf("could not generate refresh token: %w", err)
+	}
+
+	return &pb.GetGithubAuthStatusResponse{AccessToken: t, RefreshToken: refreshToken}, nil
 }
 
 // Returns k8s objects that can be used to find the cluster objects.
@@ -550,7 +1243,7 @@ func mapConditions(conditions []metav1.Condition) []*pb.Condition {
 var ErrBadProvider = errors.New("wrong provider name")
 
 // Authenticate generates and returns a jwt token using git provider name and git provider token
-func (s *applicationServer) Authenticate(_ context.Context, msg *pb.AuthenticateRequest) (*pb.AuthenticateResponse, error) {
+func (s *applicationServer) Authenticate(ctx context.Context, msg *pb.AuthenticateRequest) (*pb.AuthenticateResponse, error) {
 	if !strings.HasPrefix(github.DefaultDomain, msg.ProviderName) &&
 		!strings.HasPrefix(gitlab.DefaultDomain, msg.ProviderName) {
 		return nil, grpcStatus.Errorf(codes.InvalidArgument, "%s expected github or gitlab, got %s", ErrBadProvider, msg.ProviderName)
@@ -560,12 +1253,84 @@ func (s *applicationServer) Authenticate(_ context.Context, msg *pb.Authenticate
 		return nil, grpcStatus.Error(codes.InvalidArgument, ErrEmptyAccessToken.Error())
 	}
 
-	token, err := s.jwtClient.GenerateJWT(auth.ExpirationTime, gitproviders.GitProviderName(msg.GetProviderName()), msg.GetAccessToken())
+	claims, err := s.providerClaims(ctx, gitproviders.GitProviderName(msg.GetProviderName()), msg.GetAccessToken())
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := s.jwtClient.GenerateAccessJWT(auth.ExpirationTime, claims)
 	if err != nil {
 		return nil, grpcStatus.Errorf(codes.Internal, "error generating jwt token. %s", err)
 	}
 
-	return &pb.AuthenticateResponse{Token: token}, nil
+	refreshToken, err := s.jwtClient.GenerateRefreshJWT(auth.RefreshExpirationTime, claims)
+	if err != nil {
+		return nil, grpcStatus.Errorf(codes.Internal, "error generating jwt refresh token. %s", err)
+	}
+
+	return &pb.AuthenticateResponse{Token: token, RefreshToken: refreshToken}, nil
+}
+
+// providerClaims returns the claims of the tokens of a git provider session, identifying the user of the provider token
+func (s *applicationServer) providerClaims(ctx context.Context, providerName gitproviders.GitProviderName, providerToken string) (auth.Claims, error) {
+	claims := auth.Claims{Provider: providerName, ProviderToken: providerToken}
+
+	if s.providerUsers == nil {
+		return claims, nil
+	}
+
+	user, err := s.providerUsers.GetUser(ctx, providerName, providerToken)
+	if err != nil {
+		return auth.Claims{}, grpcStatus.Errorf(codes.Unauthenticated, "could not get the %s user of the token: %s", providerName, err)
+	}
+
+	claims.Subject = user.Name
+	claims.Groups = user.Groups
+
+	return claims, nil
+}
+
+// RefreshToken exchanges a refresh token for new tokens
+func (s *applicationServer) RefreshToken(_ context.Context, msg *pb.RefreshTokenRequest) (*pb.RefreshTokenResponse, error) {
+	accessToken, refreshToken, err := s.jwtClient.RefreshJWT(msg.RefreshToken)
+	if err != nil {
+		if errors.Is(err, auth.ErrUnauthorizedToken) {
+			return nil, grpcStatus.Error(codes.Unauthenticated, err.Error())
+		}
+
+		return nil, fmt.Errorf("could not refresh token: %w", err)
+	}
+
+	return &pb.RefreshTokenResponse{AccessToken: accessToken, RefreshToken: refreshToken}, nil
+}
+
+// Logout revokes the token the request was authenticated with and the refresh token of the request
+func (s *applicationServer) Logout(ctx context.Context, msg *pb.LogoutRequest) (*pb.LogoutResponse, error) {
+	tokens := []string{}
+
+	if token, err := middleware.ExtractToken(ctx); err == nil {
+		tokens = append(tokens, token)
+	}
+
+	if msg.RefreshToken != "" {
+		tokens = append(tokens, msg.RefreshToken)
+	}
+
+	if len(tokens) == 0 {
+		return nil, grpcStatus.Error(codes.Unauthenticated, "no token to revoke")
+	}
+
+	for _, token := range tokens {
+		if err := s.jwtClient.RevokeJWT(token); err != nil {
+			if errors.Is(err, auth.ErrUnauthorizedToken) {
+				return nil, grpcStatus.Error(codes.Unauthenticated, err.Error())
+			}
+
+			return nil, fmt.Errorf("could not revoke token: %w", err)
+		}
+	}
+
+	return &pb.LogoutResponse{}, nil
 }
 
 func addReconciledKinds(arr []*pb.GroupVersionKind, kustomization *kustomizev1.Kustomization) []*pb.GroupVersionKind {
@@ -606,3 +1371,24 @@ func addReconciledKinds(arr []*pb.GroupVersionKind, kustomization *kustomizev1.K
 
 	return arr
 }
+
+func addHelmReconciledKinds(arr []*pb.GroupVersionKind, objects []unstructured.Unstructured) []*pb.GroupVersionKind {
+	found := map[string]bool{}
+
+	for _, obj := range objects {
+		gvk := obj.GroupVersionKind()
+		s := gvk.String()
+
+		if !found[s] {
+			found[s] = true
+
+			arr = append(arr, &pb.GroupVersionKind{
+				Group:   gvk.Group,
+				Version: gvk.Version,
+				Kind:    gvk.Kind,
+			})
+		}
+	}
+
+	return arr
+}
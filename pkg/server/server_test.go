@@ -21,6 +21,7 @@ import (
 	"github.com/fluxcd/go-git-providers/gitprovider"
 	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
 	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1beta1"
+	"github.com/fluxcd/pkg/apis/meta"
 	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	. "github.com/onsi/ginkgo"
@@ -28,20 +29,28 @@ import (
 	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
 	pb "github.com/weaveworks/weave-gitops/pkg/api/applications"
 	"github.com/weaveworks/weave-gitops/pkg/apputils/apputilsfakes"
+	"github.com/weaveworks/weave-gitops/pkg/clusters"
+	"github.com/weaveworks/weave-gitops/pkg/git/gitfakes"
 	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
 	"github.com/weaveworks/weave-gitops/pkg/gitproviders/gitprovidersfakes"
 	"github.com/weaveworks/weave-gitops/pkg/kube"
 	"github.com/weaveworks/weave-gitops/pkg/kube/kubefakes"
 	"github.com/weaveworks/weave-gitops/pkg/middleware"
+	"github.com/weaveworks/weave-gitops/pkg/render"
+	"github.com/weaveworks/weave-gitops/pkg/render/renderfakes"
 	"github.com/weaveworks/weave-gitops/pkg/services/app"
 	fakelogr "github.com/weaveworks/weave-gitops/pkg/vendorfakes/logr"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	appsv1 "k8s.io/api/apps/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/rand"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 var _ = Describe("ApplicationsServer", func() {
@@ -113,6 +122,29 @@ var _ = Describe("ApplicationsServer", func() {
 			Expect(resp.Application.Name).To(Equal(name))
 		})
 
+		It("fetches the application status", func() {
+			app.Status.LastAppliedRevision = "main/abc"
+			app.Status.Conditions = []metav1.Condition{{
+				Type:               "Ready",
+				Status:             metav1.ConditionTrue,
+				Reason:             "ReconciliationSucceeded",
+				Message:            "Application is ready",
+				LastTransitionTime: metav1.Now(),
+			}}
+			Expect(k8sClient.Status().Update(ctx, app)).Should(Succeed())
+
+			resp, err := appsClient.GetApplication(context.Background(), &pb.GetApplicationRequest{
+				Name:      name,
+				Namespace: namespace.Name,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(resp.Application.LastAppliedRevision).To(Equal("main/abc"))
+			Expect(resp.Application.Conditions).To(HaveLen(1))
+			Expect(resp.Application.Conditions[0].Type).To(Equal("Ready"))
+			Expect(resp.Application.Conditions[0].Status).To(Equal("True"))
+		})
+
 		Describe("fetches the application source", func() {
 			It("fetches a git repository", func() {
 				git := &sourcev1.GitRepository{
@@ -276,17 +308,17 @@ var _ = Describe("ApplicationsServer", func() {
 		provider := "github"
 		token := "token"
 
-		jwtClient := auth.NewJwtClient(secretKey)
-		expectedToken, err := jwtClient.GenerateJWT(auth.ExpirationTime, gitproviders.GitProviderGitHub, token)
-		Expect(err).NotTo(HaveOccurred())
-
 		res, err := appsClient.Authenticate(ctx, &pb.AuthenticateRequest{
 			ProviderName: provider,
 			AccessToken:  token,
 		})
 		Expect(err).NotTo(HaveOccurred())
 
-		Expect(res.Token).To(Equal(expectedToken))
+		claims, err := auth.NewJwtClient(secretKey).VerifyJWT(res.Token)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(claims.Provider).To(Equal(gitproviders.GitProviderGitHub))
+		Expect(claims.ProviderToken).To(Equal(token))
+		Expect(res.RefreshToken).NotTo(BeEmpty())
 	})
 	It("Authorize fails on wrong provider", func() {
 		ctx := context.Background()
@@ -313,6 +345,40 @@ var _ = Describe("ApplicationsServer", func() {
 
 		Expect(err).Should(MatchGRPCError(codes.InvalidArgument, ErrEmptyAccessToken))
 	})
+	Describe("RefreshToken", func() {
+		It("exchanges a refresh token once", func() {
+			ctx := context.Background()
+
+			authRes, err := appsClient.Authenticate(ctx, &pb.AuthenticateRequest{ProviderName: "github", AccessToken: "token"})
+			Expect(err).NotTo(HaveOccurred())
+
+			res, err := appsClient.RefreshToken(ctx, &pb.RefreshTokenRequest{RefreshToken: authRes.RefreshToken})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.AccessToken).NotTo(BeEmpty())
+			Expect(res.RefreshToken).NotTo(Equal(authRes.RefreshToken))
+
+			_, err = appsClient.RefreshToken(ctx, &pb.RefreshTokenRequest{RefreshToken: authRes.RefreshToken})
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+		})
+	})
+	Describe("Logout", func() {
+		It("revokes the refresh token", func() {
+			ctx := context.Background()
+
+			authRes, err := appsClient.Authenticate(ctx, &pb.AuthenticateRequest{ProviderName: "github", AccessToken: "token"})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = appsClient.Logout(ctx, &pb.LogoutRequest{RefreshToken: authRes.RefreshToken})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = appsClient.RefreshToken(ctx, &pb.RefreshTokenRequest{RefreshToken: authRes.RefreshToken})
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+		})
+		It("fails without a token", func() {
+			_, err := appsClient.Logout(context.Background(), &pb.LogoutRequest{})
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+		})
+	})
 	Describe("GetReconciledObjects", func() {
 		It("gets object with a kustomization + git repo configuration", func() {
 			ctx := context.Background()
@@ -393,19 +459,311 @@ var _ = Describe("ApplicationsServer", func() {
 			Expect(first.GroupVersionKind.Kind).To(Equal("Deployment"))
 			Expect(first.Name).To(Equal(reconciledObj.Name))
 		})
-		It("returns an error when helm is specified as an automation type", func() {
+		It("gets the objects of a helm release", func() {
 			ctx := context.Background()
-			name := "my-app"
-			_, err := appsClient.GetReconciledObjects(ctx, &pb.GetReconciledObjectsReq{
+			name := "my-helm-app"
+			release := &helmv2.HelmRelease{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace.Name,
+				},
+				Spec: helmv2.HelmReleaseSpec{
+					Chart: helmv2.HelmChartTemplate{
+						Spec: helmv2.HelmChartTemplateSpec{
+							Chart: "podinfo",
+							SourceRef: helmv2.CrossNamespaceObjectReference{
+								Kind: sourcev1.HelmRepositoryKind,
+								Name: name,
+							},
+						},
+					},
+				},
+			}
+			reconciledObj := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-config",
+					Namespace: namespace.Name,
+					Labels: map[string]string{
+						HelmNameKey:      name,
+						HelmNamespaceKey: namespace.Name,
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, release)).Should(Succeed())
+			Expect(k8sClient.Create(ctx, reconciledObj)).Should(Succeed())
+
+			res, err := appsClient.GetReconciledObjects(ctx, &pb.GetReconciledObjectsReq{
 				AutomationName:      name,
 				AutomationNamespace: namespace.Name,
 				AutomationKind:      pb.AutomationKind_Helm,
+				Kinds:               []*pb.GroupVersionKind{{Group: "", Version: "v1", Kind: "ConfigMap"}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.Objects).To(HaveLen(1))
+			Expect(res.Objects[0].Name).To(Equal("my-config"))
+			Expect(res.Objects[0].Status).To(Equal("Current"))
+		})
+		It("returns not found for a missing helm release", func() {
+			ctx := context.Background()
+			_, err := appsClient.GetReconciledObjects(ctx, &pb.GetReconciledObjectsReq{
+				AutomationName:      "missing",
+				AutomationNamespace: namespace.Name,
+				AutomationKind:      pb.AutomationKind_Helm,
 				Kinds:               []*pb.GroupVersionKind{{Group: "apps", Version: "v1", Kind: "Deployment"}},
 			})
 
 			s, ok := status.FromError(err)
 			Expect(ok).To(BeTrue())
-			Expect(s.Code()).To(Equal(codes.Unimplemented))
+			Expect(s.Code()).To(Equal(codes.NotFound))
+		})
+	})
+	Describe("ListEvents", func() {
+		It("lists the events of an application sorted by time", func() {
+			ctx := context.Background()
+			name := "my-app"
+			app := &wego.Application{ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace.Name,
+			}}
+			Expect(k8sClient.Create(ctx, app)).Should(Succeed())
+
+			t0 := time.Date(2021, time.July, 1, 12, 0, 0, 0, time.UTC)
+
+			for i, obj := range []corev1.ObjectReference{
+				{Kind: "Kustomization", Name: name, Namespace: namespace.Name},
+				{Kind: "Application", Name: name, Namespace: namespace.Name},
+				{Kind: "Application", Name: "other-app", Namespace: namespace.Name},
+			} {
+				event := &corev1.Event{
+					ObjectMeta:     metav1.ObjectMeta{Name: fmt.Sprintf("event-%d", i), Namespace: namespace.Name},
+					InvolvedObject: obj,
+					Type:           corev1.EventTypeNormal,
+					Reason:         "Progressing",
+					Message:        obj.Kind + " " + obj.Name,
+					LastTimestamp:  metav1.NewTime(t0.Add(-time.Duration(i) * time.Minute)),
+				}
+				Expect(k8sClient.Create(ctx, event)).Should(Succeed())
+			}
+
+			res, err := appsClient.ListEvents(ctx, &pb.ListEventsRequest{Name: name, Namespace: namespace.Name})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(res.Events).To(HaveLen(2))
+			Expect(res.Events[0].ObjectKind).To(Equal("Application"))
+			Expect(res.Events[0].Timestamp).To(Equal(t0.Add(-time.Minute).Unix()))
+			Expect(res.Events[1].ObjectKind).To(Equal("Kustomization"))
+
+			res, err = appsClient.ListEvents(ctx, &pb.ListEventsRequest{Name: name, Namespace: namespace.Name, Since: t0.Unix()})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.Events).To(HaveLen(1))
+		})
+
+		It("rejects unknown event types", func() {
+			_, err := appsClient.ListEvents(context.Background(), &pb.ListEventsRequest{Name: "my-app", Namespace: namespace.Name, Type: "Error"})
+
+			s, ok := status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+		})
+	})
+	Describe("SyncApplication", func() {
+		var (
+			ctx  context.Context
+			name string
+			kust *kustomizev1.Kustomization
+		)
+
+		BeforeEach(func() {
+			ctx = middleware.ContextWithUser(context.Background(), &auth.User{Name: "jane"})
+			name = "my-app"
+
+			app := &wego.Application{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace.Name}}
+			src := &sourcev1.GitRepository{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace.Name},
+				Spec:       sourcev1.GitRepositorySpec{URL: "https://github.com/example/my-app"},
+			}
+			kust = &kustomizev1.Kustomization{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace.Name},
+				Spec: kustomizev1.KustomizationSpec{
+					SourceRef: kustomizev1.CrossNamespaceSourceReference{Kind: sourcev1.GitRepositoryKind, Name: name},
+				},
+			}
+
+			Expect(k8sClient.Create(ctx, app)).Should(Succeed())
+			Expect(k8sClient.Create(ctx, src)).Should(Succeed())
+		})
+
+		It("requests the reconciliation of the source and the kustomization", func() {
+			Expect(k8sClient.Create(ctx, kust)).Should(Succeed())
+
+			_, err := apps.SyncApplication(ctx, &pb.SyncApplicationRequest{Name: name, Namespace: namespace.Name})
+			Expect(err).NotTo(HaveOccurred())
+
+			key := types.NamespacedName{Name: name, Namespace: namespace.Name}
+
+			src := &sourcev1.GitRepository{}
+			Expect(k8sClient.Get(ctx, key, src)).To(Succeed())
+			Expect(src.Annotations).To(HaveKey(meta.ReconcileRequestAnnotation))
+
+			Expect(k8sClient.Get(ctx, key, kust)).To(Succeed())
+			Expect(kust.Annotations).To(HaveKey(meta.ReconcileRequestAnnotation))
+		})
+
+		It("rejects anonymous requests", func() {
+			Expect(k8sClient.Create(ctx, kust)).Should(Succeed())
+
+			_, err := appsClient.SyncApplication(context.Background(), &pb.SyncApplicationRequest{Name: name, Namespace: namespace.Name})
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+
+			src := &sourcev1.GitRepository{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace.Name}, src)).To(Succeed())
+			Expect(src.Annotations).NotTo(HaveKey(meta.ReconcileRequestAnnotation))
+		})
+
+		It("fails for a paused application", func() {
+			kust.Spec.Suspend = true
+			Expect(k8sClient.Create(ctx, kust)).Should(Succeed())
+
+			_, err := apps.SyncApplication(ctx, &pb.SyncApplicationRequest{Name: name, Namespace: namespace.Name})
+
+			s, ok := status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(s.Code()).To(Equal(codes.FailedPrecondition))
+		})
+	})
+	Describe("PauseApplications", func() {
+		var ctx context.Context
+
+		BeforeEach(func() {
+			ctx = middleware.ContextWithUser(context.Background(), &auth.User{Name: "jane"})
+
+			for name, team := range map[string]string{"my-app": "payments", "other-app": "web"} {
+				app := &wego.Application{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace.Name, Labels: map[string]string{"team": team}}}
+				kust := &kustomizev1.Kustomization{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace.Name},
+					Spec: kustomizev1.KustomizationSpec{
+						SourceRef: kustomizev1.CrossNamespaceSourceReference{Kind: sourcev1.GitRepositoryKind, Name: name},
+					},
+				}
+
+				Expect(k8sClient.Create(ctx, app)).Should(Succeed())
+				Expect(k8sClient.Create(ctx, kust)).Should(Succeed())
+			}
+		})
+
+		It("pauses and unpauses the applications matching the label selector", func() {
+			res, err := apps.PauseApplications(ctx, &pb.PauseApplicationsRequest{Namespace: namespace.Name, LabelSelector: "team=payments", Reason: "incident"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.Results).To(HaveLen(1))
+			Expect(res.Results[0].Name).To(Equal("my-app"))
+			Expect(res.Results[0].Changed).To(BeTrue())
+			Expect(res.Results[0].Error).To(BeEmpty())
+
+			kust := &kustomizev1.Kustomization{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "my-app", Namespace: namespace.Name}, kust)).To(Succeed())
+			Expect(kust.Spec.Suspend).To(BeTrue())
+			Expect(kust.Annotations).To(HaveKeyWithValue(app.PauseReasonAnnotation, "incident"))
+			Expect(kust.Annotations).To(HaveKeyWithValue(app.PausedByAnnotation, "jane"))
+
+			other := &kustomizev1.Kustomization{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "other-app", Namespace: namespace.Name}, other)).To(Succeed())
+			Expect(other.Spec.Suspend).To(BeFalse())
+
+			unpaused, err := apps.UnpauseApplications(ctx, &pb.UnpauseApplicationsRequest{Namespace: namespace.Name, All: true})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(unpaused.Results).To(HaveLen(2))
+			Expect(unpaused.Results[0].Changed).To(BeTrue())
+			Expect(unpaused.Results[1].Changed).To(BeFalse())
+
+			resumed := &kustomizev1.Kustomization{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "my-app", Namespace: namespace.Name}, resumed)).To(Succeed())
+			Expect(resumed.Spec.Suspend).To(BeFalse())
+			Expect(resumed.Annotations).NotTo(HaveKey(app.PauseReasonAnnotation))
+		})
+
+		It("rejects anonymous requests", func() {
+			_, err := appsClient.PauseApplications(context.Background(), &pb.PauseApplicationsRequest{Namespace: namespace.Name, All: true})
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+
+			_, err = appsClient.UnpauseApplications(context.Background(), &pb.UnpauseApplicationsRequest{Namespace: namespace.Name, All: true})
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+
+			kust := &kustomizev1.Kustomization{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "my-app", Namespace: namespace.Name}, kust)).To(Succeed())
+			Expect(kust.Spec.Suspend).To(BeFalse())
+		})
+
+		It("requires a label selector or all the applications", func() {
+			_, err := apps.PauseApplications(ctx, &pb.PauseApplicationsRequest{Namespace: namespace.Name})
+
+			s, ok := status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Describe("DiffApplication", func() {
+		It("returns the drift of the objects of an application", func() {
+			kubeClient := &kubefakes.FakeKube{}
+			kubeClient.GetApplicationReturns(&wego.Application{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-app", Namespace: "wego-system"},
+				Spec:       wego.ApplicationSpec{URL: "https://github.com/example/my-app", Branch: "main", Path: "./k8s"},
+			}, nil)
+			kubeClient.GetResourceStub = func(_ context.Context, name types.NamespacedName, r kube.Resource) error {
+				if obj, ok := r.(*unstructured.Unstructured); ok && obj.GetKind() == "Deployment" {
+					obj.SetName(name.Name)
+					obj.SetNamespace(name.Namespace)
+					Expect(unstructured.SetNestedField(obj.Object, int64(3), "spec", "replicas")).To(Succeed())
+				}
+
+				return nil
+			}
+
+			renderer := &renderfakes.FakeRenderer{}
+			renderer.KustomizeReturns([]byte("apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: my-deployment\n  namespace: apps\nspec:\n  replicas: 2\n"), nil)
+
+			appFactory := &apputilsfakes.FakeAppFactory{}
+			appFactory.GetKubeServiceReturns(kubeClient, nil)
+			appFactory.GetClusterAppServiceStub = func(ctx context.Context, kubeService kube.Kube, _ client.Client, name, namespace string) (app.AppService, error) {
+				return &app.App{Context: ctx, Kube: kubeService, AppGit: &gitfakes.FakeGit{}, Renderer: renderer}, nil
+			}
+
+			server := NewApplicationsServer(&ApplicationsConfig{AppFactory: appFactory})
+
+			res, err := server.DiffApplication(context.Background(), &pb.DiffApplicationRequest{Name: "my-app", Namespace: "wego-system"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(res.Objects).To(HaveLen(1))
+			Expect(res.Objects[0].GroupVersionKind).To(Equal(&pb.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}))
+			Expect(res.Objects[0].Name).To(Equal("my-deployment"))
+			Expect(res.Objects[0].Status).To(Equal("Modified"))
+			Expect(res.Objects[0].Diff).To(ContainSubstring("-  replicas: 3\n+  replicas: 2\n"))
+			Expect(res.Summary.Modified).To(Equal(int32(1)))
+		})
+
+		It("fails as a precondition when the renderer is not available", func() {
+			kubeClient := &kubefakes.FakeKube{}
+			kubeClient.GetApplicationReturns(&wego.Application{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-app", Namespace: "wego-system"},
+				Spec:       wego.ApplicationSpec{URL: "https://charts.example.com", Path: "my-chart", SourceType: wego.SourceTypeHelm, DeploymentType: wego.DeploymentTypeHelm},
+			}, nil)
+
+			renderer := &renderfakes.FakeRenderer{}
+			renderer.HelmReturns(nil, fmt.Errorf("%w: the helm binary is needed to render helm charts", render.ErrRendererNotAvailable))
+
+			appFactory := &apputilsfakes.FakeAppFactory{}
+			appFactory.GetKubeServiceReturns(kubeClient, nil)
+			appFactory.GetClusterAppServiceStub = func(ctx context.Context, kubeService kube.Kube, _ client.Client, name, namespace string) (app.AppService, error) {
+				return &app.App{Context: ctx, Kube: kubeService, Renderer: renderer}, nil
+			}
+
+			server := NewApplicationsServer(&ApplicationsConfig{AppFactory: appFactory})
+
+			_, err := server.DiffApplication(context.Background(), &pb.DiffApplicationRequest{Name: "my-app", Namespace: "wego-system"})
+
+			s, ok := status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(s.Code()).To(Equal(codes.FailedPrecondition))
 		})
 	})
 	Describe("GetChildObjects", func() {
@@ -471,6 +829,208 @@ var _ = Describe("ApplicationsServer", func() {
 		})
 	})
 
+	Describe("multiple clusters", func() {
+		var (
+			server     pb.ApplicationsServer
+			staging    *kubefakes.FakeKube
+			appFactory *apputilsfakes.FakeAppFactory
+		)
+
+		BeforeEach(func() {
+			local := &kubefakes.FakeKube{}
+			local.GetApplicationsReturns([]wego.Application{{ObjectMeta: metav1.ObjectMeta{Name: "local-app"}}}, nil)
+
+			staging = &kubefakes.FakeKube{}
+			staging.GetApplicationsReturns([]wego.Application{
+				{ObjectMeta: metav1.ObjectMeta{Name: "staging-app"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "other-app"}},
+			}, nil)
+
+			deployment := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "staging-deployment",
+					Namespace: "default",
+					Labels: map[string]string{
+						KustomizeNameKey:      "staging-app",
+						KustomizeNamespaceKey: "wego-system",
+					},
+				},
+			}
+
+			registry := clusters.NewRegistry(&clusters.Cluster{Name: "local", Kube: local, Client: k8sClient})
+			Expect(registry.Add(&clusters.Cluster{
+				Name:   "staging",
+				Kube:   staging,
+				Client: fake.NewClientBuilder().WithScheme(kube.CreateScheme()).WithObjects(deployment).Build(),
+			})).To(Succeed())
+
+			appFactory = &apputilsfakes.FakeAppFactory{}
+
+			server = NewApplicationsServer(&ApplicationsConfig{AppFactory: appFactory, Clusters: registry})
+		})
+
+		It("lists the applications of every cluster", func() {
+			res, err := server.ListApplications(context.Background(), &pb.ListApplicationsRequest{Namespace: "wego-system"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(res.Applications).To(HaveLen(3))
+			Expect(res.Applications[0].Name).To(Equal("local-app"))
+			Expect(res.Applications[0].ClusterName).To(Equal("local"))
+			Expect(res.Applications[1].Name).To(Equal("staging-app"))
+			Expect(res.Applications[1].ClusterName).To(Equal("staging"))
+			Expect(res.Applications[2].ClusterName).To(Equal("staging"))
+		})
+
+		It("lists the applications of one cluster", func() {
+			res, err := server.ListApplications(context.Background(), &pb.ListApplicationsRequest{Namespace: "wego-system", ClusterName: "staging"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.Applications).To(HaveLen(2))
+		})
+
+		It("lists the reachable clusters when a cluster cannot be listed", func() {
+			staging.GetApplicationsReturns(nil, errors.New("connection refused"))
+
+			res, err := server.ListApplications(context.Background(), &pb.ListApplicationsRequest{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(res.Applications).To(HaveLen(1))
+			Expect(res.Applications[0].ClusterName).To(Equal("local"))
+			Expect(res.ClusterErrors).To(HaveLen(1))
+			Expect(res.ClusterErrors[0].ClusterName).To(Equal("staging"))
+			Expect(res.ClusterErrors[0].Message).To(Equal(`could not list applications of cluster "staging": connection refused`))
+		})
+
+		It("fails when the cluster asked for cannot be listed", func() {
+			staging.GetApplicationsReturns(nil, errors.New("connection refused"))
+
+			_, err := server.ListApplications(context.Background(), &pb.ListApplicationsRequest{ClusterName: "staging"})
+			Expect(err).To(MatchError(`could not list applications of cluster "staging": connection refused`))
+		})
+
+		It("gets the reconciled objects of a cluster", func() {
+			res, err := server.GetReconciledObjects(context.Background(), &pb.GetReconciledObjectsReq{
+				AutomationName:      "staging-app",
+				AutomationNamespace: "wego-system",
+				Kinds:               []*pb.GroupVersionKind{{Group: "apps", Version: "v1", Kind: "Deployment"}},
+				ClusterName:         "staging",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.Objects).To(HaveLen(1))
+			Expect(res.Objects[0].Name).To(Equal("staging-deployment"))
+		})
+
+		It("diffs the applications of a cluster with its clients", func() {
+			appFactory.GetClusterAppServiceReturns(nil, errors.New("no git credentials"))
+
+			_, err := server.DiffApplication(context.Background(), &pb.DiffApplicationRequest{Name: "staging-app", Namespace: "wego-system", ClusterName: "staging"})
+			Expect(err).To(MatchError(ContainSubstring("no git credentials")))
+
+			_, kubeService, _, name, _ := appFactory.GetClusterAppServiceArgsForCall(0)
+			Expect(kubeService).To(BeIdenticalTo(staging))
+			Expect(name).To(Equal("staging-app"))
+		})
+
+		It("returns not found for unknown clusters", func() {
+			_, err := server.GetChildObjects(context.Background(), &pb.GetChildObjectsReq{
+				GroupVersionKind: &pb.GroupVersionKind{Group: "apps", Version: "v1", Kind: "ReplicaSet"},
+				ClusterName:      "production",
+			})
+			Expect(err).To(MatchGRPCError(codes.NotFound, errors.New("cluster not found: production")))
+		})
+	})
+
+	Describe("RBAC", func() {
+		var (
+			server     pb.ApplicationsServer
+			kubeClient *kubefakes.FakeKube
+			authorizer *authfakes.FakeAuthorizer
+			ctx        context.Context
+		)
+
+		BeforeEach(func() {
+			kubeClient = &kubefakes.FakeKube{}
+			kubeClient.GetApplicationsReturns([]wego.Application{
+				{ObjectMeta: metav1.ObjectMeta{Name: "team-a-app", Namespace: "team-a"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "team-b-app", Namespace: "team-b"}},
+			}, nil)
+
+			labels := map[string]string{KustomizeNameKey: "team-a-app", KustomizeNamespaceKey: "team-a"}
+			rawClient := fake.NewClientBuilder().WithScheme(kube.CreateScheme()).WithObjects(
+				&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "allowed", Namespace: "team-a", Labels: labels}},
+				&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "denied", Namespace: "team-b", Labels: labels}},
+			).Build()
+
+			// The fake client has no RESTMapper
+			mapper := apimeta.NewDefaultRESTMapper(nil)
+			mapper.Add(appsv1.SchemeGroupVersion.WithKind("Deployment"), apimeta.RESTScopeNamespace)
+			mapper.Add(kustomizev1.GroupVersion.WithKind(kustomizev1.KustomizationKind), apimeta.RESTScopeNamespace)
+
+			appFactory := &apputilsfakes.FakeAppFactory{}
+			appFactory.GetKubeServiceReturns(kubeClient, nil)
+
+			// The user may do anything in the team-a namespace only
+			authorizer = &authfakes.FakeAuthorizer{}
+			authorizer.AuthorizeStub = func(ctx context.Context, c client.Client, user *auth.User, attrs authorizationv1.ResourceAttributes) (bool, error) {
+				return attrs.Namespace == "team-a", nil
+			}
+
+			server = NewApplicationsServer(&ApplicationsConfig{
+				AppFactory: appFactory,
+				KubeClient: &restMappedClient{Client: rawClient, mapper: mapper},
+				Authorizer: authorizer,
+			})
+
+			ctx = middleware.ContextWithUser(context.Background(), &auth.User{Name: "jane", Groups: []string{"team-a"}})
+		})
+
+		It("rejects requests without a user", func() {
+			_, err := server.ListApplications(context.Background(), &pb.ListApplicationsRequest{})
+			Expect(err).To(MatchGRPCError(codes.Unauthenticated, errors.New("no user specified")))
+		})
+
+		It("lists the applications the user is allowed to see", func() {
+			res, err := server.ListApplications(ctx, &pb.ListApplicationsRequest{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(res.Applications).To(HaveLen(1))
+			Expect(res.Applications[0].Name).To(Equal("team-a-app"))
+
+			_, _, user, attrs := authorizer.AuthorizeArgsForCall(0)
+			Expect(user.Name).To(Equal("jane"))
+			Expect(attrs).To(Equal(authorizationv1.ResourceAttributes{Namespace: "team-a", Verb: "list", Group: "wego.weave.works", Resource: "apps"}))
+		})
+
+		It("denies access to the applications of other namespaces", func() {
+			_, err := server.GetApplication(ctx, &pb.GetApplicationRequest{Name: "team-b-app", Namespace: "team-b"})
+
+			st, ok := status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(st.Code()).To(Equal(codes.PermissionDenied))
+			Expect(kubeClient.GetApplicationCallCount()).To(Equal(0))
+		})
+
+		It("only returns the reconciled objects the user is allowed to list", func() {
+			res, err := server.GetReconciledObjects(ctx, &pb.GetReconciledObjectsReq{
+				AutomationName:      "team-a-app",
+				AutomationNamespace: "team-a",
+				AutomationKind:      pb.AutomationKind_Kustomize,
+				Kinds:               []*pb.GroupVersionKind{{Group: "apps", Version: "v1", Kind: "Deployment"}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(res.Objects).To(HaveLen(1))
+			Expect(res.Objects[0].Name).To(Equal("allowed"))
+
+			_, _, _, attrs := authorizer.AuthorizeArgsForCall(0)
+			Expect(attrs).To(Equal(authorizationv1.ResourceAttributes{
+				Namespace: "team-a", Verb: "get", Group: "kustomize.toolkit.fluxcd.io", Resource: "kustomizations", Name: "team-a-app",
+			}))
+
+			_, _, _, attrs = authorizer.AuthorizeArgsForCall(1)
+			Expect(attrs.Resource).To(Equal("deployments"))
+		})
+	})
+
 	Describe("GetGithubDeviceCode", func() {
 		It("returns a device code", func() {
 			ctx := context.Background()
@@ -778,8 +1338,8 @@ var _ = Describe("Applications handler", func() {
 			},
 		}
 
-		appFactory.GetAppServiceStub = func(ctx context.Context, name, namespace string) (app.AppService, error) {
-			return app.New(ctx, nil, nil, nil, gitProviders, nil, kubeClient, nil), nil
+		appFactory.GetClusterAppServiceStub = func(ctx context.Context, kubeService kube.Kube, _ client.Client, name, namespace string) (app.AppService, error) {
+			return app.New(ctx, nil, nil, nil, gitProviders, nil, kubeService, nil), nil
 		}
 
 		appFactory.GetKubeServiceStub = func() (kube.Kube, error) {
@@ -829,6 +1389,96 @@ var _ = Describe("Applications handler", func() {
 		Expect(r.Commits[0].Message).To(Equal("if a message is above fifty characters then it wi..."))
 		Expect(r.Commits[0].Hash).To(Equal("2349898"))
 	})
+
+	It("authorizes the git provider user of the tokens it issues", func() {
+		kubeClient := &kubefakes.FakeKube{}
+		kubeClient.GetApplicationsReturns([]wego.Application{{ObjectMeta: metav1.ObjectMeta{Name: "my-app", Namespace: "wego-system"}}}, nil)
+
+		appFactory := &apputilsfakes.FakeAppFactory{}
+		appFactory.GetKubeServiceReturns(kubeClient, nil)
+
+		providerUsers := &authfakes.FakeProviderUserClient{}
+		providerUsers.GetUserReturns(&auth.User{Name: "octocat", Groups: []string{"acme/platform"}}, nil)
+
+		authorizer := &authfakes.FakeAuthorizer{}
+		authorizer.AuthorizeReturns(true, nil)
+
+		cfg := ApplicationsConfig{
+			Logger:        testutils.MakeFakeLogr(),
+			AppFactory:    appFactory,
+			JwtClient:     auth.NewJwtClient("session-secret"),
+			KubeClient:    fake.NewClientBuilder().WithScheme(kube.CreateScheme()).Build(),
+			ProviderUsers: providerUsers,
+			Authorizer:    authorizer,
+		}
+
+		handler, err := NewApplicationsHandler(context.Background(), &cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		ts := httptest.NewServer(handler)
+		defer ts.Close()
+
+		res, err := ts.Client().Post(ts.URL+"/v1/authenticate/github", "application/json", strings.NewReader(`{"accessToken": "gh-token"}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.StatusCode).To(Equal(http.StatusOK))
+
+		authRes := &pb.AuthenticateResponse{}
+		Expect(json.NewDecoder(res.Body).Decode(authRes)).To(Succeed())
+
+		_, providerName, providerToken := providerUsers.GetUserArgsForCall(0)
+		Expect(providerName).To(Equal(gitproviders.GitProviderGitHub))
+		Expect(providerToken).To(Equal("gh-token"))
+
+		req, err := http.NewRequest(http.MethodGet, ts.URL+"/v1/applications", nil)
+		Expect(err).NotTo(HaveOccurred())
+		req.Header.Add("Authorization", "token "+authRes.Token)
+
+		res, err = ts.Client().Do(req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.StatusCode).To(Equal(http.StatusOK))
+
+		list := &pb.ListApplicationsResponse{}
+		Expect(json.NewDecoder(res.Body).Decode(list)).To(Succeed())
+		Expect(list.Applications).To(HaveLen(1))
+
+		_, _, user, _ := authorizer.AuthorizeArgsForCall(0)
+		Expect(user).To(Equal(&auth.User{Name: "octocat", Groups: []string{"acme/platform"}, Provider: gitproviders.GitProviderGitHub}))
+	})
+
+	It("logs out a browser session", func() {
+		jwtClient := auth.NewJwtClient("session-secret")
+
+		token, err := jwtClient.GenerateUserJWT(auth.ExpirationTime, "jane", nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := ApplicationsConfig{
+			Logger:     testutils.MakeFakeLogr(),
+			AppFactory: &apputilsfakes.FakeAppFactory{},
+			JwtClient:  jwtClient,
+		}
+
+		handler, err := NewApplicationsHandler(context.Background(), &cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		ts := httptest.NewServer(handler)
+		defer ts.Close()
+
+		req, err := http.NewRequest(http.MethodPost, ts.URL+"/v1/logout", strings.NewReader("{}"))
+		Expect(err).NotTo(HaveOccurred())
+		req.AddCookie(&http.Cookie{Name: auth.SessionCookieName, Value: token})
+
+		res, err := ts.Client().Do(req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.StatusCode).To(Equal(http.StatusOK))
+
+		for _, c := range res.Cookies() {
+			Expect(c.MaxAge).To(BeNumerically("<", 0), c.Name)
+		}
+		Expect(res.Cookies()).To(HaveLen(2))
+
+		_, err = jwtClient.VerifyJWT(token)
+		Expect(err).To(MatchError(auth.ErrUnauthorizedToken))
+	})
 })
 
 type fakeCommit struct {
@@ -870,3 +1520,13 @@ func formatLogVals(vals []interface{}) []string {
 
 	return list
 }
+
+// restMappedClient gives a RESTMapper to the fake client
+type restMappedClient struct {
+	client.Client
+	mapper apimeta.RESTMapper
+}
+
+func (c *restMappedClient) RESTMapper() apimeta.RESTMapper {
+	return c.mapper
+}
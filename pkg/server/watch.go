@@ -0,0 +1,446 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1beta1"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
+	"github.com/go-logr/logr"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/applications"
+	"github.com/weaveworks/weave-gitops/pkg/clusters"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	grpcStatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// watchBufferSize is the number of events a subscriber can fall behind before it gets disconnected
+const watchBufferSize = 100
+
+const eventStreamContentType = "text/event-stream"
+
+var ErrWatchNotEnabled = errors.New("watching applications is not enabled on this server")
+
+// An ApplicationWatcher fans out the informer events of Applications and of their
+// flux source and automation objects to the WatchApplications streams.
+type ApplicationWatcher struct {
+	cache cache.Cache
+	log   logr.Logger
+
+	lock        sync.Mutex
+	subscribers map[*watchSubscriber]struct{}
+}
+
+type watchSubscriber struct {
+	namespace string
+	events    chan *pb.WatchApplicationsResponse
+}
+
+// NewApplicationWatcher creates a watcher reading from the informers of the given cache,
+// which must know about the wego and flux types (see kube.CreateScheme()).
+// The informers only run once the watcher is started.
+func NewApplicationWatcher(ctx context.Context, c cache.Cache, log logr.Logger) (*ApplicationWatcher, error) {
+	w := &ApplicationWatcher{
+		cache:       c,
+		log:         log,
+		subscribers: map[*watchSubscriber]struct{}{},
+	}
+
+	watched := []client.Object{
+		&wego.Application{},
+		&sourcev1.GitRepository{},
+		&sourcev1.HelmRepository{},
+		&kustomizev1.Kustomization{},
+		&helmv2.HelmRelease{},
+	}
+
+	for _, obj := range watched {
+		informer, err := c.GetInformer(ctx, obj)
+		if err != nil {
+			return nil, fmt.Errorf("could not get informer for %T: %w", obj, err)
+		}
+
+		informer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				w.publish(pb.WatchApplicationsResponse_Added, obj)
+			},
+			UpdateFunc: func(_, obj interface{}) {
+				w.publish(pb.WatchApplicationsResponse_Modified, obj)
+			},
+			DeleteFunc: func(obj interface{}) {
+				w.publish(pb.WatchApplicationsResponse_Deleted, obj)
+			},
+		})
+	}
+
+	return w, nil
+}
+
+// Start runs the informers until the context is cancelled.
+func (w *ApplicationWatcher) Start(ctx context.Context) error {
+	return w.cache.Start(ctx)
+}
+
+// Subscribe returns a channel receiving the events of the given namespace, or of every namespace when empty.
+// The channel is closed when the subscriber falls too far behind. The returned function must be
+// called once the subscriber is done with the channel.
+func (w *ApplicationWatcher) Subscribe(namespace string) (<-chan *pb.WatchApplicationsResponse, func()) {
+	sub := &watchSubscriber{
+		namespace: namespace,
+		events:    make(chan *pb.WatchApplicationsResponse, watchBufferSize),
+	}
+
+	w.lock.Lock()
+	w.subscribers[sub] = struct{}{}
+	w.lock.Unlock()
+
+	return sub.events, func() {
+		w.lock.Lock()
+		defer w.lock.Unlock()
+
+		if _, ok := w.subscribers[sub]; ok {
+			delete(w.subscribers, sub)
+			close(sub.events)
+		}
+	}
+}
+
+// List returns an Added event for every application of the namespace and for each of its flux objects.
+func (w *ApplicationWatcher) List(ctx context.Context, namespace string) ([]*pb.WatchApplicationsResponse, error) {
+	if !w.cache.WaitForCacheSync(ctx) {
+		return nil, errors.New("application cache did not sync")
+	}
+
+	apps := &wego.ApplicationList{}
+	if err := w.cache.List(ctx, apps, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("could not list applications: %w", err)
+	}
+
+	events := []*pb.WatchApplicationsResponse{}
+
+	for i := range apps.Items {
+		app := &apps.Items[i]
+
+		events = append(events, applicationEvent(pb.WatchApplicationsResponse_Added, app))
+
+		src, deployment, err := findFluxObjects(app)
+		if err != nil {
+			w.log.Error(err, "could not get flux objects", "application", app.Name)
+			continue
+		}
+
+		name := types.NamespacedName{Name: app.Name, Namespace: app.Namespace}
+
+		for _, obj := range []client.Object{src, deployment} {
+			if err := w.cache.Get(ctx, name, obj); err != nil {
+				if apierrors.IsNotFound(err) {
+					// The flux objects may not be created yet
+					continue
+				}
+
+				return nil, fmt.Errorf("could not get flux object for app %s: %w", app.Name, err)
+			}
+
+			events = append(events, fluxObjectEvent(pb.WatchApplicationsResponse_Added, obj))
+		}
+	}
+
+	return events, nil
+}
+
+func (w *ApplicationWatcher) publish(eventType pb.WatchApplicationsResponse_EventType, obj interface{}) {
+	if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+
+	o, ok := obj.(client.Object)
+	if !ok {
+		return
+	}
+
+	var event *pb.WatchApplicationsResponse
+
+	if app, ok := o.(*wego.Application); ok {
+		event = applicationEvent(eventType, app)
+	} else {
+		// Flux objects created for an application share its name and namespace,
+		// other flux objects are of no interest to the watchers.
+		if err := w.cache.Get(context.Background(), client.ObjectKeyFromObject(o), &wego.Application{}); err != nil {
+			return
+		}
+
+		event = fluxObjectEvent(eventType, o)
+	}
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	for sub := range w.subscribers {
+		if sub.namespace != "" && sub.namespace != o.GetNamespace() {
+			continue
+		}
+
+		select {
+		case sub.events <- event:
+		default:
+			w.log.Info("dropping slow application watcher", "namespace", sub.namespace)
+			delete(w.subscribers, sub)
+			close(sub.events)
+		}
+	}
+}
+
+func applicationEvent(eventType pb.WatchApplicationsResponse_EventType, app *wego.Application) *pb.WatchApplicationsResponse {
+	deploymentType := pb.AutomationKind_Kustomize
+	if app.Spec.DeploymentType == wego.DeploymentTypeHelm {
+		deploymentType = pb.AutomationKind_Helm
+	}
+
+	return &pb.WatchApplicationsResponse{
+		Type: eventType,
+		Kind: "Application",
+		Application: &pb.Application{
+			Name:                app.Name,
+			Namespace:           app.Namespace,
+			Url:                 app.Spec.URL,
+			Path:                app.Spec.Path,
+			DeploymentType:      deploymentType,
+			Conditions:          mapConditions(app.Status.Conditions),
+			LastAppliedRevision: app.Status.LastAppliedRevision,
+		},
+	}
+}
+
+func fluxObjectEvent(eventType pb.WatchApplicationsResponse_EventType, obj client.Object) *pb.WatchApplicationsResponse {
+	app := &pb.Application{
+		Name:      obj.GetName(),
+		Namespace: obj.GetNamespace(),
+	}
+
+	var kind string
+
+	switch o := obj.(type) {
+	case *sourcev1.GitRepository:
+		kind = sourcev1.GitRepositoryKind
+		app.Source = mapSourceSpecToReponse(o)
+	case *sourcev1.HelmRepository:
+		kind = sourcev1.HelmRepositoryKind
+		app.Source = mapSourceSpecToReponse(o)
+	case *kustomizev1.Kustomization:
+		kind = kustomizev1.KustomizationKind
+		app.DeploymentType = pb.AutomationKind_Kustomize
+		app.Kustomization = mapKustomizationSpecToResponse(o)
+		app.ReconciledObjectKinds = addReconciledKinds([]*pb.GroupVersionKind{}, o)
+	case *helmv2.HelmRelease:
+		kind = helmv2.HelmReleaseKind
+		app.DeploymentType = pb.AutomationKind_Helm
+		app.HelmRelease = mapHelmReleaseSpecToResponse(o)
+	}
+
+	return &pb.WatchApplicationsResponse{Type: eventType, Kind: kind, Application: app}
+}
+
+func (s *applicationServer) WatchApplications(msg *pb.WatchApplicationsRequest, stream pb.Applications_WatchApplicationsServer) error {
+	if s.watcher == nil {
+		return grpcStatus.Error(codes.Unimplemented, ErrWatchNotEnabled.Error())
+	}
+
+	ctx := stream.Context()
+
+	if err := s.requireWatchedCluster(msg.ClusterName); err != nil {
+		return err
+	}
+
+	review, err := s.accessReview(ctx, msg.ClusterName)
+	if err != nil {
+		return err
+	}
+
+	// Subscribe before listing so no change is missed in between
+	events, unsubscribe := s.watcher.Subscribe(msg.Namespace)
+	defer unsubscribe()
+
+	current, err := s.watcher.List(ctx, msg.Namespace)
+	if err != nil {
+		return fmt.Errorf("could not list applications: %w", err)
+	}
+
+	for _, e := range current {
+		if err := sendAllowed(ctx, stream, review, e); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case e, ok := <-events:
+			if !ok {
+				return grpcStatus.Error(codes.ResourceExhausted, "too many application events were left unread")
+			}
+
+			if err := sendAllowed(ctx, stream, review, e); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// requireWatchedCluster checks the named cluster is the default cluster, the only one the watcher watches
+func (s *applicationServer) requireWatchedCluster(clusterName string) error {
+	if clusterName == "" {
+		return nil
+	}
+
+	if s.clusters == nil {
+		return grpcStatus.Errorf(codes.NotFound, "%s: %s", clusters.ErrClusterNotFound, clusterName)
+	}
+
+	watched, err := s.clusters.Get("")
+	if err != nil {
+		return grpcStatus.Error(codes.NotFound, err.Error())
+	}
+
+	if clusterName != watched.Name {
+		return grpcStatus.Errorf(codes.Unimplemented, "only the applications of cluster %q are watched", watched.Name)
+	}
+
+	return nil
+}
+
+// sendAllowed sends the event when the user may list the applications of its namespace
+func sendAllowed(ctx context.Context, stream pb.Applications_WatchApplicationsServer, review *accessReview, e *pb.WatchApplicationsResponse) error {
+	allowed, err := review.can(ctx, appAttributes("list", e.Application.Namespace, ""))
+	if err != nil || !allowed {
+		return err
+	}
+
+	return stream.Send(e)
+}
+
+// watchApplicationsHandler serves WatchApplications over HTTP.
+// The handlers grpc-gateway generates for in-process servers do not support streaming,
+// so the server stream is bridged to runtime.ForwardResponseStream here.
+func watchApplicationsHandler(mux *runtime.ServeMux, srv pb.ApplicationsServer) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+
+		msg := &pb.WatchApplicationsRequest{}
+
+		if err := req.ParseForm(); err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, grpcStatus.Errorf(codes.InvalidArgument, "%v", err))
+			return
+		}
+
+		if err := runtime.PopulateQueryParameters(msg, req.Form, &utilities.DoubleArray{}); err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, grpcStatus.Errorf(codes.InvalidArgument, "%v", err))
+			return
+		}
+
+		stream := &httpWatchStream{ctx: ctx, events: make(chan *pb.WatchApplicationsResponse)}
+		done := make(chan error, 1)
+
+		go func() {
+			done <- srv.WatchApplications(msg, stream)
+		}()
+
+		recv := func() (proto.Message, error) {
+			select {
+			case e := <-stream.events:
+				return e, nil
+			case err := <-done:
+				if err == nil {
+					return nil, io.EOF
+				}
+
+				return nil, err
+			}
+		}
+
+		ctx = runtime.NewServerMetadataContext(ctx, runtime.ServerMetadata{})
+		runtime.ForwardResponseStream(ctx, mux, outboundMarshaler, w, req, recv)
+	}
+}
+
+// httpWatchStream hands the events sent by WatchApplications over to the HTTP handler.
+type httpWatchStream struct {
+	ctx    context.Context
+	events chan *pb.WatchApplicationsResponse
+}
+
+func (s *httpWatchStream) Send(e *pb.WatchApplicationsResponse) error {
+	select {
+	case s.events <- e:
+		return nil
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
+
+func (s *httpWatchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *httpWatchStream) SetHeader(metadata.MD) error {
+	return nil
+}
+
+func (s *httpWatchStream) SendHeader(metadata.MD) error {
+	return nil
+}
+
+func (s *httpWatchStream) SetTrailer(metadata.MD) {}
+
+func (s *httpWatchStream) SendMsg(m interface{}) error {
+	e, ok := m.(*pb.WatchApplicationsResponse)
+	if !ok {
+		return fmt.Errorf("unexpected message type %T", m)
+	}
+
+	return s.Send(e)
+}
+
+func (s *httpWatchStream) RecvMsg(m interface{}) error {
+	return io.EOF
+}
+
+// eventStreamMarshaler writes each message of a stream as a server-sent event.
+// It is used when the client accepts text/event-stream, like the browser EventSource does.
+type eventStreamMarshaler struct {
+	runtime.Marshaler
+}
+
+func (m *eventStreamMarshaler) ContentType(_ interface{}) string {
+	return eventStreamContentType
+}
+
+func (m *eventStreamMarshaler) Marshal(v interface{}) ([]byte, error) {
+	data, err := m.Marshaler.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte("data: "), data...), nil
+}
+
+func (m *eventStreamMarshaler) Delimiter() []byte {
+	return []byte("\n\n")
+}
@@ -0,0 +1,217 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1beta1"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/applications"
+	"github.com/weaveworks/weave-gitops/pkg/clusters"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/testutils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/cache/informertest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllertest"
+)
+
+// fakeCache serves the objects of a fake client and lets the tests trigger informer events
+type fakeCache struct {
+	*informertest.FakeInformers
+	reader client.Reader
+}
+
+func (c *fakeCache) Get(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+	return c.reader.Get(ctx, key, obj)
+}
+
+func (c *fakeCache) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	return c.reader.List(ctx, list, opts...)
+}
+
+// readWatchEvent reads the next event of a grpc-gateway stream
+func readWatchEvent(r *bufio.Reader) *pb.WatchApplicationsResponse {
+	line, err := r.ReadBytes('\n')
+	Expect(err).NotTo(HaveOccurred())
+
+	chunk := struct {
+		Result json.RawMessage `json:"result"`
+	}{}
+	Expect(json.Unmarshal(line, &chunk)).To(Succeed())
+
+	event := &pb.WatchApplicationsResponse{}
+	Expect(protojson.Unmarshal(chunk.Result, event)).To(Succeed())
+
+	return event
+}
+
+var _ = Describe("WatchApplications", func() {
+	var (
+		informers *informertest.FakeInformers
+		watcher   *ApplicationWatcher
+		ts        *httptest.Server
+		cancel    context.CancelFunc
+		app       *wego.Application
+		kust      *kustomizev1.Kustomization
+	)
+
+	BeforeEach(func() {
+		app = &wego.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-app", Namespace: "wego-system"},
+			Spec:       wego.ApplicationSpec{URL: "ssh://git@github.com/example/my-app", Path: "./k8s"},
+		}
+		src := &sourcev1.GitRepository{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-app", Namespace: "wego-system"},
+			Spec:       sourcev1.GitRepositorySpec{URL: "ssh://git@github.com/example/my-app"},
+		}
+		kust = &kustomizev1.Kustomization{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-app", Namespace: "wego-system"},
+			Spec:       kustomizev1.KustomizationSpec{Path: "./k8s"},
+		}
+		otherApp := &wego.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "other-app", Namespace: "other-ns"},
+		}
+
+		scheme := kube.CreateScheme()
+		informers = &informertest.FakeInformers{Scheme: scheme}
+		c := &fakeCache{
+			FakeInformers: informers,
+			reader:        fake.NewClientBuilder().WithScheme(scheme).WithObjects(app, src, kust, otherApp).Build(),
+		}
+
+		var ctx context.Context
+		ctx, cancel = context.WithCancel(context.Background())
+
+		var err error
+
+		watcher, err = NewApplicationWatcher(ctx, c, testutils.MakeFakeLogr())
+		Expect(err).NotTo(HaveOccurred())
+
+		handler, err := NewApplicationsHandler(ctx, &ApplicationsConfig{
+			Logger:  testutils.MakeFakeLogr(),
+			Watcher: watcher,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		ts = httptest.NewServer(handler)
+	})
+
+	AfterEach(func() {
+		ts.Close()
+		cancel()
+	})
+
+	informerFor := func(obj client.Object) *controllertest.FakeInformer {
+		informer, err := informers.FakeInformerFor(obj)
+		Expect(err).NotTo(HaveOccurred())
+
+		return informer
+	}
+
+	It("streams the applications and their changes", func() {
+		res, err := http.Get(ts.URL + "/v1/watch/applications?namespace=wego-system")
+		Expect(err).NotTo(HaveOccurred())
+		defer res.Body.Close()
+
+		Expect(res.StatusCode).To(Equal(http.StatusOK))
+
+		r := bufio.NewReader(res.Body)
+
+		event := readWatchEvent(r)
+		Expect(event.Type).To(Equal(pb.WatchApplicationsResponse_Added))
+		Expect(event.Kind).To(Equal("Application"))
+		Expect(event.Application.Name).To(Equal("my-app"))
+		Expect(event.Application.Url).To(Equal("ssh://git@github.com/example/my-app"))
+
+		event = readWatchEvent(r)
+		Expect(event.Kind).To(Equal(sourcev1.GitRepositoryKind))
+		Expect(event.Application.Source.Url).To(Equal("ssh://git@github.com/example/my-app"))
+
+		event = readWatchEvent(r)
+		Expect(event.Kind).To(Equal(kustomizev1.KustomizationKind))
+
+		kust.Status.LastAppliedRevision = "main/abc123"
+		informerFor(kust).Update(kust, kust)
+
+		event = readWatchEvent(r)
+		Expect(event.Type).To(Equal(pb.WatchApplicationsResponse_Modified))
+		Expect(event.Kind).To(Equal(kustomizev1.KustomizationKind))
+		Expect(event.Application.Kustomization.LastAppliedRevision).To(Equal("main/abc123"))
+
+		// Neither flux objects without an application nor other namespaces are streamed
+		orphan := &sourcev1.GitRepository{ObjectMeta: metav1.ObjectMeta{Name: "orphan", Namespace: "wego-system"}}
+		informerFor(orphan).Add(orphan)
+		informerFor(app).Add(&wego.Application{ObjectMeta: metav1.ObjectMeta{Name: "other-app", Namespace: "other-ns"}})
+
+		informerFor(app).Delete(app)
+
+		event = readWatchEvent(r)
+		Expect(event.Type).To(Equal(pb.WatchApplicationsResponse_Deleted))
+		Expect(event.Kind).To(Equal("Application"))
+		Expect(event.Application.Name).To(Equal("my-app"))
+	})
+
+	It("sends server-sent events", func() {
+		req, err := http.NewRequest(http.MethodGet, ts.URL+"/v1/watch/applications", nil)
+		Expect(err).NotTo(HaveOccurred())
+		req.Header.Set("Accept", "text/event-stream")
+
+		res, err := ts.Client().Do(req)
+		Expect(err).NotTo(HaveOccurred())
+		defer res.Body.Close()
+
+		Expect(res.Header.Get("Content-Type")).To(Equal("text/event-stream"))
+
+		r := bufio.NewReader(res.Body)
+
+		line, err := r.ReadString('\n')
+		Expect(err).NotTo(HaveOccurred())
+		Expect(line).To(HavePrefix(`data: {"result":`))
+
+		blank, err := r.ReadString('\n')
+		Expect(err).NotTo(HaveOccurred())
+		Expect(blank).To(Equal("\n"))
+	})
+
+	It("rejects the clusters it does not watch", func() {
+		registry := clusters.NewRegistry(&clusters.Cluster{Name: "local"})
+		Expect(registry.Add(&clusters.Cluster{Name: "staging"})).To(Succeed())
+
+		handler, err := NewApplicationsHandler(context.Background(), &ApplicationsConfig{
+			Logger:   testutils.MakeFakeLogr(),
+			Watcher:  watcher,
+			Clusters: registry,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		clusterServer := httptest.NewServer(handler)
+		defer clusterServer.Close()
+
+		res, err := http.Get(clusterServer.URL + "/v1/watch/applications?clusterName=staging")
+		Expect(err).NotTo(HaveOccurred())
+		defer res.Body.Close()
+
+		body, err := ioutil.ReadAll(res.Body)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(body)).To(ContainSubstring(`only the applications of cluster \"local\" are watched`))
+	})
+
+	It("is unimplemented without a watcher", func() {
+		stream, err := appsClient.WatchApplications(context.Background(), &pb.WatchApplicationsRequest{})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = stream.Recv()
+		Expect(err).To(MatchGRPCError(codes.Unimplemented, ErrWatchNotEnabled))
+	})
+})
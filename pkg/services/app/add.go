@@ -11,8 +11,11 @@ import (
 	"strings"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/weaveworks/weave-gitops/pkg/flux"
 	"github.com/weaveworks/weave-gitops/pkg/git"
+	"github.com/weaveworks/weave-gitops/pkg/gitcredentials"
 	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
+	"github.com/weaveworks/weave-gitops/pkg/helm"
 	"github.com/weaveworks/weave-gitops/pkg/kube"
 	"github.com/weaveworks/weave-gitops/pkg/logger/loggerfakes"
 	"github.com/weaveworks/weave-gitops/pkg/utils"
@@ -75,6 +78,21 @@ type AddParams struct {
 	AutoMerge                  bool
 	GitProviderToken           string
 	HelmReleaseTargetNamespace string
+	GitAuth                    wego.GitAuthType
+	GitCredentialsSecret       string
+	// ValuesFiles and SetValues are the Helm values of the chart, merged in this order.
+	// ValuesFrom references ConfigMaps and Secrets holding values, as <configmap|secret>/<name>[:<values key>].
+	ValuesFiles []string
+	SetValues   []string
+	ValuesFrom  []string
+	// ChartVersion is the semver constraint of the versions of the chart, the latest version when empty
+	ChartVersion string
+	// Kustomization holds the settings of the Kustomization of apps deployed with kustomize
+	Kustomization KustomizationParams
+	// DependsOn are the applications of the namespace which must be ready before the app is deployed
+	DependsOn []string
+	// Environments are the targets of the config repository the app is promoted through, in order
+	Environments []string
 }
 
 const (
@@ -127,62 +145,96 @@ type externalRepoManifests struct {
 // - PR created or commit directly pushed for user repo
 
 func (a *App) Add(params AddParams) error {
+	params, info, appHash, err := a.prepareAdd(params)
+	if err != nil {
+		return err
+	}
+
+	secretRef, err := a.appSecretRef(info)
+	if err != nil {
+		return err
+	}
+
+	switch strings.ToUpper(info.Spec.ConfigURL) {
+	case string(ConfigTypeNone):
+		return a.addAppWithNoConfigRepo(info, params.DryRun, secretRef, appHash)
+	case string(ConfigTypeUserRepo):
+		return a.addAppWithConfigInAppRepo(info, params, secretRef, appHash)
+	default:
+		return a.addAppWithConfigInExternalRepo(info, params, secretRef, appHash)
+	}
+}
+
+// prepareAdd completes the parameters of an application and checks it can be added to the cluster
+func (a *App) prepareAdd(params AddParams) (AddParams, *AppResourceInfo, string, error) {
 	ctx := context.Background()
 
 	params, err := a.updateParametersIfNecessary(params)
 	if err != nil {
-		return fmt.Errorf("could not update parameters: %w", err)
+		return params, nil, "", fmt.Errorf("could not update parameters: %w", err)
 	}
 
 	a.printAddSummary(params)
 
 	if err := IsClusterReady(&loggerfakes.FakeLogger{}, a.Kube); err != nil {
-		return err
+		return params, nil, "", err
 	}
 
 	clusterName, err := a.Kube.GetClusterName(ctx)
 	if err != nil {
-		return err
+		return params, nil, "", err
+	}
+
+	application := makeWegoApplication(params)
+
+	if err := setHelmValues(&application, params.ValuesFiles, params.SetValues, params.ValuesFrom); err != nil {
+		return params, nil, "", err
+	}
+
+	if err := setKustomizationSettings(&application, params.Kustomization); err != nil {
+		return params, nil, "", err
 	}
 
-	info := getAppResourceInfo(makeWegoApplication(params), clusterName)
+	info := getAppResourceInfo(application, clusterName)
 
 	appHash := info.getAppHash()
 
 	apps, err := a.Kube.GetApplications(ctx, params.Namespace)
 	if err != nil {
-		return err
+		return params, nil, "", err
 	}
 
 	for _, app := range apps {
 		existingHash := getAppResourceInfo(app, clusterName).getAppHash()
 
 		if appHash == existingHash {
-			return fmt.Errorf("unable to create resource, resource already exists in cluster")
+			return params, nil, "", fmt.Errorf("unable to create resource, resource already exists in cluster")
 		}
 	}
 
-	secretRef := ""
+	if err := validateDependencies(application, apps); err != nil {
+		return params, nil, "", err
+	}
 
-	if params.SourceType != wego.SourceTypeHelm {
-		visibility, visibilityErr := a.GitProvider.GetRepoVisibility(info.Spec.URL)
-		if visibilityErr != nil {
-			return visibilityErr
-		}
+	return params, info, appHash, nil
+}
 
-		if *visibility != gitprovider.RepositoryVisibilityPublic {
-			secretRef = info.repoSecretName(info.Spec.URL).String()
-		}
+// appSecretRef returns the secret the source of a private git repository authenticates with
+func (a *App) appSecretRef(info *AppResourceInfo) (string, error) {
+	if info.Spec.SourceType == wego.SourceTypeHelm {
+		return "", nil
 	}
 
-	switch strings.ToUpper(info.Spec.ConfigURL) {
-	case string(ConfigTypeNone):
-		return a.addAppWithNoConfigRepo(info, params.DryRun, secretRef, appHash)
-	case string(ConfigTypeUserRepo):
-		return a.addAppWithConfigInAppRepo(info, params, secretRef, appHash)
-	default:
-		return a.addAppWithConfigInExternalRepo(info, params, secretRef, appHash)
+	visibility, err := a.GitProvider.GetRepoVisibility(info.Spec.URL)
+	if err != nil {
+		return "", err
+	}
+
+	if *visibility != gitprovider.RepositoryVisibilityPublic {
+		return info.gitSecretRef(info.Spec.URL), nil
 	}
+
+	return "", nil
 }
 
 func (a *App) printAddSummary(params AddParams) {
@@ -197,6 +249,10 @@ func (a *App) printAddSummary(params AddParams) {
 		a.Logger.Println("Chart: %s", params.Chart)
 	}
 
+	if params.ChartVersion != "" {
+		a.Logger.Println("Chart version: %s", params.ChartVersion)
+	}
+
 	a.Logger.Println("")
 }
 
@@ -290,9 +346,74 @@ func (a *App) updateParametersIfNecessary(params AddParams) (AddParams, error) {
 		}
 	}
 
+	if params.ChartVersion != "" {
+		if params.SourceType != wego.SourceTypeHelm {
+			return params, fmt.Errorf("--chart-version can only be used with charts of a helm repository")
+		}
+
+		if err := helm.ValidateVersionConstraint(params.ChartVersion); err != nil {
+			return params, err
+		}
+	}
+
+	if err := validateEnvironments(params.Environments, params.AppConfigUrl); err != nil {
+		return params, err
+	}
+
+	if err := validateGitAuth(params); err != nil {
+		return params, err
+	}
+
+	hasValues := len(params.ValuesFiles) > 0 || len(params.SetValues) > 0 || len(params.ValuesFrom) > 0
+	if hasValues && params.DeploymentType != string(wego.DeploymentTypeHelm) {
+		return params, fmt.Errorf("--values, --set and --values-from can only be used with helm applications")
+	}
+
 	return params, nil
 }
 
+// validateGitAuth checks that the credentials of the git auth are given,
+// and that GitHub App installation tokens are only requested for GitHub repositories
+func validateGitAuth(params AddParams) error {
+	switch params.GitAuth {
+	case "", wego.GitAuthDeployKey:
+		return nil
+	case wego.GitAuthHTTPS, wego.GitAuthGitHubApp:
+		if params.GitCredentialsSecret == "" {
+			return fmt.Errorf("--git-credentials-secret must be specified for %s git auth", params.GitAuth)
+		}
+	default:
+		return fmt.Errorf("unknown git auth %q, can be: %s, %s or %s", params.GitAuth, wego.GitAuthDeployKey, wego.GitAuthHTTPS, wego.GitAuthGitHubApp)
+	}
+
+	if params.GitAuth != wego.GitAuthGitHubApp {
+		return nil
+	}
+
+	repoURLs := []string{}
+
+	if params.SourceType == wego.SourceTypeGit {
+		repoURLs = append(repoURLs, params.Url)
+	}
+
+	if IsExternalConfigUrl(params.AppConfigUrl) {
+		repoURLs = append(repoURLs, params.AppConfigUrl)
+	}
+
+	for _, repoURL := range repoURLs {
+		normalizedUrl, err := gitproviders.NewNormalizedRepoURL(repoURL)
+		if err != nil {
+			return fmt.Errorf("error normalizing url: %w", err)
+		}
+
+		if normalizedUrl.Provider() != gitproviders.GitProviderGitHub {
+			return fmt.Errorf("%s git auth requires a GitHub repository, %s is hosted on %s", wego.GitAuthGitHubApp, repoURL, normalizedUrl.Provider())
+		}
+	}
+
+	return nil
+}
+
 func (a *App) addAppWithNoConfigRepo(info *AppResourceInfo, dryRun bool, secretRef string, appHash string) error {
 	// Returns the source, app spec and kustomization
 	source, appGoat, appSpec, err := a.generateAppManifests(info, secretRef, appHash)
@@ -321,7 +442,7 @@ func (a *App) addAppWithConfigInAppRepo(info *AppResourceInfo, params AddParams,
 	if params.Dir == "" {
 		a.Logger.Actionf("Cloning %s", info.Spec.URL)
 
-		remover, err := a.cloneRepo(a.ConfigGit, info.Spec.URL, info.Spec.Branch, params.DryRun)
+		remover, err := a.cloneRepo(a.ConfigGit, info.gitURL(info.Spec.URL), info.Spec.Branch, params.DryRun)
 		if err != nil {
 			return fmt.Errorf("failed to clone application repo: %w", err)
 		}
@@ -331,7 +452,7 @@ func (a *App) addAppWithConfigInAppRepo(info *AppResourceInfo, params AddParams,
 
 	if !params.DryRun {
 		if !params.AutoMerge {
-			if err := a.createPullRequestToRepo(info, info.Spec.URL, appHash, appSpec, appGoat, source); err != nil {
+			if err := a.createPullRequestToRepo(info, info.Spec.URL, appHash, appSpec, source, appGoat); err != nil {
 				return err
 			}
 		} else {
@@ -375,7 +496,7 @@ func (a *App) addAppWithConfigInExternalRepo(info *AppResourceInfo, params AddPa
 		return fmt.Errorf("could not generate target GitOps Automation manifests: %w", err)
 	}
 
-	remover, err := a.cloneRepo(a.ConfigGit, info.Spec.ConfigURL, configBranch, params.DryRun)
+	remover, err := a.cloneRepo(a.ConfigGit, info.gitURL(info.Spec.ConfigURL), configBranch, params.DryRun)
 	if err != nil {
 		return fmt.Errorf("failed to clone configuration repo: %w", err)
 	}
@@ -384,7 +505,7 @@ func (a *App) addAppWithConfigInExternalRepo(info *AppResourceInfo, params AddPa
 
 	if !params.DryRun {
 		if !params.AutoMerge {
-			if err := a.createPullRequestToRepo(info, info.Spec.ConfigURL, appHash, appSpec, appGoat, appSource); err != nil {
+			if err := a.createPullRequestToRepo(info, info.Spec.ConfigURL, appHash, appSpec, appSource, appGoat); err != nil {
 				return err
 			}
 		} else {
@@ -443,7 +564,8 @@ func (a *App) generateAppWegoManifests(info *AppResourceInfo) ([]byte, []byte, e
 		info.automationAppsDirKustomizationName(),
 		info.Name,
 		info.appYamlDir(),
-		info.Namespace)
+		info.Namespace,
+		flux.KustomizationOptions{})
 	if err != nil {
 		return nil, nil, fmt.Errorf("could not create app dir kustomization for '%s': %w", info.Name, err)
 	}
@@ -452,7 +574,8 @@ func (a *App) generateAppWegoManifests(info *AppResourceInfo) ([]byte, []byte, e
 		info.automationTargetDirKustomizationName(),
 		info.Name,
 		info.appAutomationDir(),
-		info.Namespace)
+		info.Namespace,
+		flux.KustomizationOptions{})
 	if err != nil {
 		return nil, nil, fmt.Errorf("could not create target dir kustomization for '%s': %w", info.Name, err)
 	}
@@ -471,10 +594,10 @@ func (a *App) generateExternalRepoManifests(info *AppResourceInfo, branch string
 	}
 
 	if *visibility != gitprovider.RepositoryVisibilityPublic {
-		secretRef = info.repoSecretName(info.Spec.ConfigURL).String()
+		secretRef = info.gitSecretRef(info.Spec.ConfigURL)
 	}
 
-	targetSource, err := a.Flux.CreateSourceGit(repoName, info.Spec.ConfigURL, branch, secretRef, info.Namespace)
+	targetSource, err := a.Flux.CreateSourceGit(repoName, info.gitURL(info.Spec.ConfigURL), branch, secretRef, info.Namespace)
 	if err != nil {
 		return nil, fmt.Errorf("could not generate target source manifests: %w", err)
 	}
@@ -483,7 +606,8 @@ func (a *App) generateExternalRepoManifests(info *AppResourceInfo, branch string
 		info.automationAppsDirKustomizationName(),
 		repoName,
 		info.appYamlDir(),
-		info.Namespace)
+		info.Namespace,
+		flux.KustomizationOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("could not generate app dir kustomization for '%s': %w", info.Name, err)
 	}
@@ -492,7 +616,8 @@ func (a *App) generateExternalRepoManifests(info *AppResourceInfo, branch string
 		info.automationTargetDirKustomizationName(),
 		repoName,
 		info.appAutomationDir(),
-		info.Namespace)
+		info.Namespace,
+		flux.KustomizationOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("could not generate target dir kustomization for '%s': %w", info.Name, err)
 	}
@@ -531,7 +656,7 @@ func (a *App) commitAndPush(client git.Git, commitMsg string, dryRun bool, filte
 func (a *App) generateSource(info *AppResourceInfo, secretRef string) ([]byte, error) {
 	switch info.Spec.SourceType {
 	case wego.SourceTypeGit:
-		sourceManifest, err := a.Flux.CreateSourceGit(info.Name, info.Spec.URL, info.Spec.Branch, secretRef, info.Namespace)
+		sourceManifest, err := a.Flux.CreateSourceGit(info.Name, info.gitURL(info.Spec.URL), info.Spec.Branch, secretRef, info.Namespace)
 		if err != nil {
 			return nil, fmt.Errorf("could not create git source: %w", err)
 		}
@@ -547,13 +672,13 @@ func (a *App) generateSource(info *AppResourceInfo, secretRef string) ([]byte, e
 func (a *App) generateApplicationGoat(info *AppResourceInfo) ([]byte, error) {
 	switch info.Spec.DeploymentType {
 	case wego.DeploymentTypeKustomize:
-		return a.Flux.CreateKustomization(info.Name, info.Name, info.Spec.Path, info.Namespace)
+		return a.Flux.CreateKustomization(info.Name, info.Name, info.Spec.Path, info.Namespace, kustomizationOptions(info))
 	case wego.DeploymentTypeHelm:
 		switch info.Spec.SourceType {
 		case wego.SourceTypeHelm:
-			return a.Flux.CreateHelmReleaseHelmRepository(info.Name, info.Spec.Path, info.Namespace, info.Spec.HelmTargetNamespace)
+			return a.Flux.CreateHelmReleaseHelmRepository(info.Name, info.Spec.Path, info.Namespace, info.Spec.HelmTargetNamespace, helmReleaseOptions(info))
 		case wego.SourceTypeGit:
-			return a.Flux.CreateHelmReleaseGitRepository(info.Name, info.Name, info.Spec.Path, info.Namespace, info.Spec.HelmTargetNamespace)
+			return a.Flux.CreateHelmReleaseGitRepository(info.Name, info.Name, info.Spec.Path, info.Namespace, info.Spec.HelmTargetNamespace, helmReleaseOptions(info))
 		default:
 			return nil, fmt.Errorf("invalid source type: %v", info.Spec.SourceType)
 		}
@@ -624,13 +749,18 @@ func makeWegoApplication(params AddParams) wego.Application {
 			Namespace: params.Namespace,
 		},
 		Spec: wego.ApplicationSpec{
-			ConfigURL:           params.AppConfigUrl,
-			Branch:              params.Branch,
-			URL:                 params.Url,
-			Path:                params.Path,
-			DeploymentType:      wego.DeploymentType(params.DeploymentType),
-			SourceType:          wego.SourceType(params.SourceType),
-			HelmTargetNamespace: params.HelmReleaseTargetNamespace,
+			ConfigURL:            params.AppConfigUrl,
+			Branch:               params.Branch,
+			URL:                  params.Url,
+			Path:                 params.Path,
+			DeploymentType:       wego.DeploymentType(params.DeploymentType),
+			SourceType:           wego.SourceType(params.SourceType),
+			HelmTargetNamespace:  params.HelmReleaseTargetNamespace,
+			HelmChartVersion:     params.ChartVersion,
+			GitAuth:              params.GitAuth,
+			GitCredentialsSecret: params.GitCredentialsSecret,
+			DependsOn:            params.DependsOn,
+			Environments:         params.Environments,
 		},
 	}
 
@@ -657,6 +787,12 @@ func generateResourceName(url string) string {
 }
 
 func (a *App) createPullRequestToRepo(info *AppResourceInfo, repoURL string, appHash string, appYaml []byte, goatSource, goatDeploy []byte) error {
+	files := appManifestFiles(info, appYaml, goatSource, goatDeploy)
+
+	return a.openPullRequest(repoURL, appHash, fmt.Sprintf("gitops add %s", info.Name), fmt.Sprintf("Added yamls for %s", info.Name), files)
+}
+
+func appManifestFiles(info *AppResourceInfo, appYaml []byte, goatSource, goatDeploy []byte) []gitprovider.CommitFile {
 	appPath := info.appYamlPath()
 	goatSourcePath := info.appAutomationSourcePath()
 	goatDeployPath := info.appAutomationDeployPath()
@@ -665,7 +801,7 @@ func (a *App) createPullRequestToRepo(info *AppResourceInfo, repoURL string, app
 	goatSourceContent := string(goatSource)
 	goatDeployContent := string(goatDeploy)
 
-	files := []gitprovider.CommitFile{
+	return []gitprovider.CommitFile{
 		{
 			Path:    &appPath,
 			Content: &appContent,
@@ -679,7 +815,10 @@ func (a *App) createPullRequestToRepo(info *AppResourceInfo, repoURL string, app
 			Content: &goatDeployContent,
 		},
 	}
+}
 
+// openPullRequest pushes the files to newBranch and opens a pull request against the default branch of the repository
+func (a *App) openPullRequest(repoURL string, newBranch string, title string, description string, files []gitprovider.CommitFile) error {
 	normalizedUrl, err := gitproviders.NewNormalizedRepoURL(repoURL)
 	if err != nil {
 		return fmt.Errorf("error normalizing url: %w", err)
@@ -698,7 +837,7 @@ func (a *App) createPullRequestToRepo(info *AppResourceInfo, repoURL string, app
 	if accountType == gitproviders.AccountTypeOrg {
 		orgRepoRef := gitproviders.NewOrgRepositoryRef(a.GitProvider.GetProviderDomain(), normalizedUrl.Owner(), normalizedUrl.RepositoryName())
 
-		prLink, err := a.GitProvider.CreatePullRequestToOrgRepo(orgRepoRef, configBranch, appHash, files, utils.GetCommitMessage(), fmt.Sprintf("gitops add %s", info.Name), fmt.Sprintf("Added yamls for %s", info.Name))
+		prLink, err := a.GitProvider.CreatePullRequestToOrgRepo(orgRepoRef, configBranch, newBranch, files, utils.GetCommitMessage(), title, description)
 		if err != nil {
 			return fmt.Errorf("unable to create pull request: %w", err)
 		}
@@ -710,7 +849,7 @@ func (a *App) createPullRequestToRepo(info *AppResourceInfo, repoURL string, app
 
 	userRepoRef := gitproviders.NewUserRepositoryRef(a.GitProvider.GetProviderDomain(), normalizedUrl.Owner(), normalizedUrl.RepositoryName())
 
-	prLink, err := a.GitProvider.CreatePullRequestToUserRepo(userRepoRef, configBranch, appHash, files, utils.GetCommitMessage(), fmt.Sprintf("gitops add %s", info.Name), fmt.Sprintf("Added yamls for %s", info.Name))
+	prLink, err := a.GitProvider.CreatePullRequestToUserRepo(userRepoRef, configBranch, newBranch, files, utils.GetCommitMessage(), title, description)
 	if err != nil {
 		return fmt.Errorf("unable to create pull request: %w", err)
 	}
@@ -728,6 +867,14 @@ func getAppResourceInfo(app wego.Application, clusterName string) *AppResourceIn
 	}
 }
 
+// forTarget returns the resources of the app deployed to another target of the config repository
+func (a *AppResourceInfo) forTarget(targetName string) *AppResourceInfo {
+	info := *a
+	info.targetName = targetName
+
+	return &info
+}
+
 func (a *AppResourceInfo) configMode() ConfigMode {
 	if strings.ToUpper(a.Spec.ConfigURL) == string(ConfigTypeNone) {
 		return ConfigModeClusterOnly
@@ -767,7 +914,7 @@ func (a *AppResourceInfo) appAutomationDeployPath() string {
 }
 
 func (a *AppResourceInfo) appAutomationDir() string {
-	return filepath.Join(a.automationRoot(), "targets", a.clusterName, a.Name)
+	return filepath.Join(a.automationRoot(), "targets", a.targetName, a.Name)
 }
 
 func (a *AppResourceInfo) appSourceName() string {
@@ -792,6 +939,38 @@ func (a *AppResourceInfo) repoSecretName(repoURL string) GeneratedSecretName {
 	return CreateRepoSecretName(a.clusterName, repoURL)
 }
 
+// usesDeployKeys tells whether the git repositories of the app are accessed with deploy keys
+func (a *AppResourceInfo) usesDeployKeys() bool {
+	return a.Spec.GitAuth == "" || a.Spec.GitAuth == wego.GitAuthDeployKey
+}
+
+// gitSecretRef returns the name of the Secret the sources of a git repository of the app authenticate with
+func (a *AppResourceInfo) gitSecretRef(repoURL string) string {
+	switch a.Spec.GitAuth {
+	case wego.GitAuthHTTPS:
+		return a.Spec.GitCredentialsSecret
+	case wego.GitAuthGitHubApp:
+		return gitcredentials.GitHubAppTokenSecretName(a.Spec.GitCredentialsSecret)
+	default:
+		return a.repoSecretName(repoURL).String()
+	}
+}
+
+// gitURL returns the url a git repository of the app is cloned from, which is its https url
+// when the repository is accessed with credentials instead of a deploy key
+func (a *AppResourceInfo) gitURL(repoURL string) string {
+	if a.usesDeployKeys() {
+		return repoURL
+	}
+
+	normalizedUrl, err := gitproviders.NewNormalizedRepoURL(repoURL)
+	if err != nil {
+		return repoURL
+	}
+
+	return normalizedUrl.HTTPS()
+}
+
 func nameTooLong(name string) bool {
 	return len(name) > maxKubernetesResourceNameLength
 }
@@ -867,7 +1046,7 @@ func (a *AppResourceInfo) clusterResources() []ResourceRef {
 
 	// Secret for deploy key associated with app repository;
 	// common to all three modes when not using upstream Helm repository
-	if a.sourceKind() == ResourceKindGitRepository {
+	if a.sourceKind() == ResourceKindGitRepository && a.usesDeployKeys() {
 		resources = append(
 			resources,
 			ResourceRef{
@@ -875,6 +1054,16 @@ func (a *AppResourceInfo) clusterResources() []ResourceRef {
 				name: a.repoSecretName(a.Spec.URL).String()})
 	}
 
+	// Secret for the installation token the GitHub App credentials of the app are exchanged for;
+	// common to all three modes
+	if a.Spec.GitAuth == wego.GitAuthGitHubApp {
+		resources = append(
+			resources,
+			ResourceRef{
+				kind: ResourceKindSecret,
+				name: gitcredentials.GitHubAppTokenSecretName(a.Spec.GitCredentialsSecret)})
+	}
+
 	if strings.ToUpper(a.Spec.ConfigURL) == string(ConfigTypeNone) {
 		// Only app resources present in cluster; no resources to manage config
 		return resources
@@ -895,12 +1084,17 @@ func (a *AppResourceInfo) clusterResources() []ResourceRef {
 	// External repo adds a secret and source for the external repo
 	if a.Spec.ConfigURL != string(ConfigTypeUserRepo) && a.Spec.ConfigURL != a.Spec.URL {
 		// Config stored in external repo
+		if a.usesDeployKeys() {
+			resources = append(
+				resources,
+				// Secret for deploy key associated with config repository
+				ResourceRef{
+					kind: ResourceKindSecret,
+					name: a.repoSecretName(a.Spec.ConfigURL).String()})
+		}
+
 		resources = append(
 			resources,
-			// Secret for deploy key associated with config repository
-			ResourceRef{
-				kind: ResourceKindSecret,
-				name: a.repoSecretName(a.Spec.ConfigURL).String()},
 			// Source for config repository
 			ResourceRef{
 				kind: ResourceKindGitRepository,
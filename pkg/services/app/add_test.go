@@ -15,9 +15,11 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/flux"
 	"github.com/weaveworks/weave-gitops/pkg/git"
 	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
 	"github.com/weaveworks/weave-gitops/pkg/kube"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/yaml"
 )
 
@@ -155,7 +157,7 @@ var _ = Describe("Add", func() {
 
 				Expect(fluxClient.CreateKustomizationCallCount()).To(Equal(1))
 
-				name, source, path, namespace := fluxClient.CreateKustomizationArgsForCall(0)
+				name, source, path, namespace, _ := fluxClient.CreateKustomizationArgsForCall(0)
 				Expect(name).To(Equal("bar"))
 				Expect(source).To(Equal("bar"))
 				Expect(path).To(Equal("./kustomize"))
@@ -170,7 +172,7 @@ var _ = Describe("Add", func() {
 
 				Expect(fluxClient.CreateHelmReleaseHelmRepositoryCallCount()).To(Equal(1))
 
-				name, chart, namespace, targetNamespace := fluxClient.CreateHelmReleaseHelmRepositoryArgsForCall(0)
+				name, chart, namespace, targetNamespace, _ := fluxClient.CreateHelmReleaseHelmRepositoryArgsForCall(0)
 				Expect(name).To(Equal("loki"))
 				Expect(chart).To(Equal("loki"))
 				Expect(namespace).To(Equal("wego-system"))
@@ -186,7 +188,7 @@ var _ = Describe("Add", func() {
 
 				Expect(fluxClient.CreateHelmReleaseGitRepositoryCallCount()).To(Equal(1))
 
-				name, source, path, namespace, targetNamespace := fluxClient.CreateHelmReleaseGitRepositoryArgsForCall(0)
+				name, source, path, namespace, targetNamespace, _ := fluxClient.CreateHelmReleaseGitRepositoryArgsForCall(0)
 				Expect(name).To(Equal("bar"))
 				Expect(source).To(Equal("bar"))
 				Expect(path).To(Equal("./charts/my-chart"))
@@ -203,7 +205,7 @@ var _ = Describe("Add", func() {
 
 				Expect(fluxClient.CreateHelmReleaseHelmRepositoryCallCount()).To(Equal(1))
 
-				name, chart, namespace, targetNamespace := fluxClient.CreateHelmReleaseHelmRepositoryArgsForCall(0)
+				name, chart, namespace, targetNamespace, _ := fluxClient.CreateHelmReleaseHelmRepositoryArgsForCall(0)
 				Expect(name).To(Equal("loki"))
 				Expect(chart).To(Equal("loki"))
 				Expect(namespace).To(Equal("wego-system"))
@@ -220,7 +222,7 @@ var _ = Describe("Add", func() {
 
 				Expect(fluxClient.CreateHelmReleaseGitRepositoryCallCount()).To(Equal(1))
 
-				name, source, path, namespace, targetNamespace := fluxClient.CreateHelmReleaseGitRepositoryArgsForCall(0)
+				name, source, path, namespace, targetNamespace, _ := fluxClient.CreateHelmReleaseGitRepositoryArgsForCall(0)
 				Expect(name).To(Equal("bar"))
 				Expect(source).To(Equal("bar"))
 				Expect(path).To(Equal("./charts/my-chart"))
@@ -240,7 +242,7 @@ var _ = Describe("Add", func() {
 			fluxClient.CreateSourceGitStub = func(s1, s2, s3, s4, s5 string) ([]byte, error) {
 				return []byte("git source"), nil
 			}
-			fluxClient.CreateKustomizationStub = func(s1, s2, s3, s4 string) ([]byte, error) {
+			fluxClient.CreateKustomizationStub = func(s1, s2, s3, s4 string, _ flux.KustomizationOptions) ([]byte, error) {
 				return []byte("kustomization"), nil
 			}
 
@@ -320,19 +322,19 @@ var _ = Describe("Add", func() {
 
 				Expect(fluxClient.CreateKustomizationCallCount()).To(Equal(3))
 
-				name, source, path, namespace := fluxClient.CreateKustomizationArgsForCall(0)
+				name, source, path, namespace, _ := fluxClient.CreateKustomizationArgsForCall(0)
 				Expect(name).To(Equal("bar"))
 				Expect(source).To(Equal("bar"))
 				Expect(path).To(Equal("./kustomize"))
 				Expect(namespace).To(Equal("wego-system"))
 
-				name, source, path, namespace = fluxClient.CreateKustomizationArgsForCall(1)
+				name, source, path, namespace, _ = fluxClient.CreateKustomizationArgsForCall(1)
 				Expect(name).To(Equal("bar-apps-dir"))
 				Expect(source).To(Equal("bar"))
 				Expect(path).To(Equal(".wego/apps/bar"))
 				Expect(namespace).To(Equal("wego-system"))
 
-				name, source, path, namespace = fluxClient.CreateKustomizationArgsForCall(2)
+				name, source, path, namespace, _ = fluxClient.CreateKustomizationArgsForCall(2)
 				Expect(name).To(Equal("test-cluster-bar"))
 				Expect(source).To(Equal("bar"))
 				Expect(path).To(Equal(".wego/targets/test-cluster/bar"))
@@ -348,7 +350,7 @@ var _ = Describe("Add", func() {
 
 				Expect(fluxClient.CreateHelmReleaseHelmRepositoryCallCount()).To(Equal(1))
 
-				name, chart, namespace, targetNamespace := fluxClient.CreateHelmReleaseHelmRepositoryArgsForCall(0)
+				name, chart, namespace, targetNamespace, _ := fluxClient.CreateHelmReleaseHelmRepositoryArgsForCall(0)
 				Expect(name).To(Equal("loki"))
 				Expect(chart).To(Equal("loki"))
 				Expect(namespace).To(Equal("wego-system"))
@@ -364,7 +366,7 @@ var _ = Describe("Add", func() {
 
 				Expect(fluxClient.CreateHelmReleaseGitRepositoryCallCount()).To(Equal(1))
 
-				name, source, path, namespace, targetNamespace := fluxClient.CreateHelmReleaseGitRepositoryArgsForCall(0)
+				name, source, path, namespace, targetNamespace, _ := fluxClient.CreateHelmReleaseGitRepositoryArgsForCall(0)
 				Expect(name).To(Equal("bar"))
 				Expect(source).To(Equal("bar"))
 				Expect(path).To(Equal("./charts/my-chart"))
@@ -382,7 +384,7 @@ var _ = Describe("Add", func() {
 
 				Expect(fluxClient.CreateHelmReleaseHelmRepositoryCallCount()).To(Equal(1))
 
-				name, chart, namespace, targetNamespace := fluxClient.CreateHelmReleaseHelmRepositoryArgsForCall(0)
+				name, chart, namespace, targetNamespace, _ := fluxClient.CreateHelmReleaseHelmRepositoryArgsForCall(0)
 				Expect(name).To(Equal("loki"))
 				Expect(chart).To(Equal("loki"))
 				Expect(namespace).To(Equal("wego-system"))
@@ -399,7 +401,7 @@ var _ = Describe("Add", func() {
 
 				Expect(fluxClient.CreateHelmReleaseGitRepositoryCallCount()).To(Equal(1))
 
-				name, source, path, namespace, targetNamespace := fluxClient.CreateHelmReleaseGitRepositoryArgsForCall(0)
+				name, source, path, namespace, targetNamespace, _ := fluxClient.CreateHelmReleaseGitRepositoryArgsForCall(0)
 				Expect(name).To(Equal("bar"))
 				Expect(source).To(Equal("bar"))
 				Expect(path).To(Equal("./charts/my-chart"))
@@ -433,7 +435,7 @@ var _ = Describe("Add", func() {
 			fluxClient.CreateSourceGitStub = func(s1, s2, s3, s4, s5 string) ([]byte, error) {
 				return []byte("git source"), nil
 			}
-			fluxClient.CreateKustomizationStub = func(s1, s2, s3, s4 string) ([]byte, error) {
+			fluxClient.CreateKustomizationStub = func(s1, s2, s3, s4 string, _ flux.KustomizationOptions) ([]byte, error) {
 				return []byte("kustomization"), nil
 			}
 
@@ -473,7 +475,7 @@ var _ = Describe("Add", func() {
 				fluxClient.CreateSourceGitStub = func(s1, s2, s3, s4, s5 string) ([]byte, error) {
 					return []byte("git"), nil
 				}
-				fluxClient.CreateKustomizationStub = func(s1, s2, s3, s4 string) ([]byte, error) {
+				fluxClient.CreateKustomizationStub = func(s1, s2, s3, s4 string, _ flux.KustomizationOptions) ([]byte, error) {
 					return []byte("kustomization"), nil
 				}
 
@@ -603,19 +605,34 @@ var _ = Describe("Add", func() {
 
 				Expect(fluxClient.CreateKustomizationCallCount()).To(Equal(3))
 
-				name, source, path, namespace := fluxClient.CreateKustomizationArgsForCall(0)
+				name, source, path, namespace, _ := fluxClient.CreateKustomizationArgsForCall(0)
 				Expect(name).To(Equal("repo"))
 				Expect(source).To(Equal("repo"))
 				Expect(path).To(Equal("./kustomize"))
 				Expect(namespace).To(Equal("wego-system"))
 
-				name, source, path, namespace = fluxClient.CreateKustomizationArgsForCall(1)
+				name, source, path, namespace, _ = fluxClient.CreateKustomizationArgsForCall(1)
 				Expect(name).To(Equal("repo-apps-dir"))
 				Expect(source).To(Equal("bar"))
 				Expect(path).To(Equal("apps/repo"))
 				Expect(namespace).To(Equal("wego-system"))
 			})
 
+			It("renders the kustomization settings into the app kustomization only", func() {
+				addParams.Kustomization = KustomizationParams{TargetNamespace: "podinfo", DependsOn: []string{"infrastructure"}}
+
+				err := appSrv.Add(addParams)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				_, _, _, _, opts := fluxClient.CreateKustomizationArgsForCall(0)
+				Expect(opts.TargetNamespace).To(Equal("podinfo"))
+				Expect(opts.DependsOn).To(HaveLen(1))
+				Expect(opts.DependsOn[0].Name).To(Equal("infrastructure"))
+
+				_, _, _, _, opts = fluxClient.CreateKustomizationArgsForCall(1)
+				Expect(opts).To(Equal(flux.KustomizationOptions{}))
+			})
+
 			It("creates helm release using a helm repository if source type is helm", func() {
 				addParams.Chart = "loki"
 
@@ -624,7 +641,7 @@ var _ = Describe("Add", func() {
 
 				Expect(fluxClient.CreateHelmReleaseHelmRepositoryCallCount()).To(Equal(1))
 
-				name, chart, namespace, targetNamespace := fluxClient.CreateHelmReleaseHelmRepositoryArgsForCall(0)
+				name, chart, namespace, targetNamespace, _ := fluxClient.CreateHelmReleaseHelmRepositoryArgsForCall(0)
 				Expect(name).To(Equal("loki"))
 				Expect(chart).To(Equal("loki"))
 				Expect(namespace).To(Equal("wego-system"))
@@ -640,7 +657,7 @@ var _ = Describe("Add", func() {
 
 				Expect(fluxClient.CreateHelmReleaseGitRepositoryCallCount()).To(Equal(1))
 
-				name, source, path, namespace, targetNamespace := fluxClient.CreateHelmReleaseGitRepositoryArgsForCall(0)
+				name, source, path, namespace, targetNamespace, _ := fluxClient.CreateHelmReleaseGitRepositoryArgsForCall(0)
 				Expect(name).To(Equal("repo"))
 				Expect(source).To(Equal("repo"))
 				Expect(path).To(Equal("./charts/my-chart"))
@@ -657,7 +674,7 @@ var _ = Describe("Add", func() {
 
 				Expect(fluxClient.CreateHelmReleaseHelmRepositoryCallCount()).To(Equal(1))
 
-				name, chart, namespace, targetNamespace := fluxClient.CreateHelmReleaseHelmRepositoryArgsForCall(0)
+				name, chart, namespace, targetNamespace, _ := fluxClient.CreateHelmReleaseHelmRepositoryArgsForCall(0)
 				Expect(name).To(Equal("loki"))
 				Expect(chart).To(Equal("loki"))
 				Expect(namespace).To(Equal("wego-system"))
@@ -674,7 +691,7 @@ var _ = Describe("Add", func() {
 
 				Expect(fluxClient.CreateHelmReleaseGitRepositoryCallCount()).To(Equal(1))
 
-				name, source, path, namespace, targetNamespace := fluxClient.CreateHelmReleaseGitRepositoryArgsForCall(0)
+				name, source, path, namespace, targetNamespace, _ := fluxClient.CreateHelmReleaseGitRepositoryArgsForCall(0)
 				Expect(name).To(Equal("repo"))
 				Expect(source).To(Equal("repo"))
 				Expect(path).To(Equal("./charts/my-chart"))
@@ -694,7 +711,7 @@ var _ = Describe("Add", func() {
 			fluxClient.CreateSourceGitStub = func(s1, s2, s3, s4, s5 string) ([]byte, error) {
 				return []byte("git source"), nil
 			}
-			fluxClient.CreateKustomizationStub = func(s1, s2, s3, s4 string) ([]byte, error) {
+			fluxClient.CreateKustomizationStub = func(s1, s2, s3, s4 string, _ flux.KustomizationOptions) ([]byte, error) {
 				return []byte("kustomization"), nil
 			}
 
@@ -728,7 +745,7 @@ var _ = Describe("Add", func() {
 			fluxClient.CreateSourceGitStub = func(s1, s2, s3, s4, s5 string) ([]byte, error) {
 				return []byte("git"), nil
 			}
-			fluxClient.CreateKustomizationStub = func(s1, s2, s3, s4 string) ([]byte, error) {
+			fluxClient.CreateKustomizationStub = func(s1, s2, s3, s4 string, _ flux.KustomizationOptions) ([]byte, error) {
 				return []byte("kustomization"), nil
 			}
 
@@ -803,6 +820,31 @@ var _ = Describe("Add", func() {
 			Expect(err.Error()).To(HavePrefix("failed to retrieve account type"))
 		})
 
+		It("writes the source and deploy manifests to their own paths", func() {
+			gitProviders.GetAccountTypeStub = func(s string) (gitproviders.ProviderAccountType, error) {
+				return gitproviders.AccountTypeUser, nil
+			}
+
+			fluxClient.CreateSourceGitReturns([]byte("source"), nil)
+			fluxClient.CreateKustomizationReturns([]byte("deploy"), nil)
+
+			addParams.AppConfigUrl = "https://github.com/foo/bar"
+			addParams.AutoMerge = false
+
+			Expect(appSrv.Add(addParams)).To(Succeed())
+			Expect(gitProviders.CreatePullRequestToUserRepoCallCount()).To(Equal(1))
+
+			_, _, _, files, _, _, _ := gitProviders.CreatePullRequestToUserRepoArgsForCall(0)
+			written := map[string]string{}
+
+			for _, file := range files {
+				written[*file.Path] = *file.Content
+			}
+
+			Expect(written).To(HaveKeyWithValue("targets/test-cluster/repo/repo-gitops-source.yaml", "source"))
+			Expect(written).To(HaveKeyWithValue("targets/test-cluster/repo/repo-gitops-deploy.yaml", "deploy"))
+		})
+
 		Context("uses the default app branch for config in app repository", func() {
 			BeforeEach(func() {
 				addParams.AppConfigUrl = ""
@@ -856,6 +898,169 @@ var _ = Describe("Add", func() {
 		})
 	})
 
+	Context("add app with git credentials", func() {
+		BeforeEach(func() {
+			addParams.Url = "git@github.com:user/repo.git"
+			addParams.AppConfigUrl = "https://github.com/foo/bar"
+			addParams.GitAuth = wego.GitAuthHTTPS
+			addParams.GitCredentialsSecret = "git-credentials"
+		})
+
+		It("clones the repositories over https with the credentials secret", func() {
+			Expect(appSrv.Add(addParams)).To(Succeed())
+
+			_, url, _, secretRef, _ := fluxClient.CreateSourceGitArgsForCall(0)
+			Expect(url).To(Equal("https://github.com/user/repo.git"))
+			Expect(secretRef).To(Equal("git-credentials"))
+
+			_, url, _, secretRef, _ = fluxClient.CreateSourceGitArgsForCall(1)
+			Expect(url).To(Equal("https://github.com/foo/bar.git"))
+			Expect(secretRef).To(Equal("git-credentials"))
+
+			_, _, cloneURL, _ := gitClient.CloneArgsForCall(0)
+			Expect(cloneURL).To(Equal("https://github.com/foo/bar.git"))
+		})
+
+		It("reads the github app installation token secret", func() {
+			addParams.GitAuth = wego.GitAuthGitHubApp
+
+			Expect(appSrv.Add(addParams)).To(Succeed())
+
+			_, _, _, secretRef, _ := fluxClient.CreateSourceGitArgsForCall(0)
+			Expect(secretRef).To(Equal("git-credentials-token"))
+		})
+
+		It("requires the credentials secret", func() {
+			addParams.GitCredentialsSecret = ""
+
+			err := appSrv.Add(addParams)
+			Expect(err).To(MatchError(ContainSubstring("--git-credentials-secret must be specified for https git auth")))
+		})
+
+		It("only requests github app tokens for github repositories", func() {
+			addParams.GitAuth = wego.GitAuthGitHubApp
+			addParams.AppConfigUrl = "https://gitlab.com/foo/bar"
+
+			err := appSrv.Add(addParams)
+			Expect(err).To(MatchError(ContainSubstring("github-app git auth requires a GitHub repository")))
+		})
+	})
+
+	Context("add app with helm values", func() {
+		It("renders the values into the helm release", func() {
+			addParams.Url = "https://charts.kube-ops.io"
+			addParams.Chart = "loki"
+			addParams.SetValues = []string{"replicaCount=2"}
+			addParams.ValuesFrom = []string{"configmap/loki-values"}
+
+			Expect(appSrv.Add(addParams)).To(Succeed())
+
+			_, _, _, _, opts := fluxClient.CreateHelmReleaseHelmRepositoryArgsForCall(0)
+			Expect(string(opts.Values.Raw)).To(Equal(`{"replicaCount":2}`))
+			Expect(opts.ValuesFrom).To(HaveLen(1))
+			Expect(opts.ValuesFrom[0].Kind).To(Equal("ConfigMap"))
+			Expect(opts.ValuesFrom[0].Name).To(Equal("loki-values"))
+
+			_, appManifest, _ := kubeClient.ApplyArgsForCall(2)
+			Expect(string(appManifest)).To(ContainSubstring("replicaCount: 2"))
+		})
+
+		It("only accepts values for helm applications", func() {
+			addParams.SetValues = []string{"replicaCount=2"}
+
+			err := appSrv.Add(addParams)
+			Expect(err).To(MatchError(ContainSubstring("can only be used with helm applications")))
+		})
+	})
+
+	Context("add app with kustomization settings", func() {
+		It("stores the settings in the app spec", func() {
+			addParams.Kustomization = KustomizationParams{
+				HealthChecks: []string{"Deployment/podinfo.podinfo"},
+				Substitute:   []string{"cluster_env=dev"},
+			}
+
+			Expect(appSrv.Add(addParams)).To(Succeed())
+
+			_, _, _, _, opts := fluxClient.CreateKustomizationArgsForCall(0)
+			Expect(opts.HealthChecks).To(HaveLen(1))
+			Expect(opts.PostBuild.Substitute).To(Equal(map[string]string{"cluster_env": "dev"}))
+
+			_, appManifest, _ := kubeClient.ApplyArgsForCall(2)
+			Expect(string(appManifest)).To(ContainSubstring("cluster_env: dev"))
+		})
+
+		It("only accepts the settings for kustomize applications", func() {
+			addParams.Url = "https://charts.kube-ops.io"
+			addParams.Chart = "loki"
+			addParams.Kustomization = KustomizationParams{TargetNamespace: "loki"}
+
+			err := appSrv.Add(addParams)
+			Expect(err).To(MatchError(ContainSubstring("can only be used with applications deployed with kustomize")))
+		})
+	})
+
+	Context("add app depending on other apps", func() {
+		It("makes the app kustomization depend on the kustomizations of the apps", func() {
+			kubeClient.GetApplicationsReturns([]wego.Application{{
+				ObjectMeta: metav1.ObjectMeta{Name: "redis", Namespace: "wego-system"},
+				Spec:       wego.ApplicationSpec{DeploymentType: wego.DeploymentTypeKustomize},
+			}}, nil)
+
+			addParams.DependsOn = []string{"redis"}
+
+			Expect(appSrv.Add(addParams)).To(Succeed())
+
+			_, _, _, _, opts := fluxClient.CreateKustomizationArgsForCall(0)
+			Expect(opts.DependsOn).To(HaveLen(1))
+			Expect(opts.DependsOn[0].Name).To(Equal("redis"))
+		})
+
+		It("rejects a cycle", func() {
+			kubeClient.GetApplicationsReturns([]wego.Application{{
+				ObjectMeta: metav1.ObjectMeta{Name: "redis", Namespace: "wego-system"},
+				Spec:       wego.ApplicationSpec{DeploymentType: wego.DeploymentTypeKustomize, DependsOn: []string{"bar"}},
+			}}, nil)
+
+			addParams.DependsOn = []string{"redis"}
+
+			err := appSrv.Add(addParams)
+			Expect(err).To(MatchError(ContainSubstring("dependency cycle: bar -> redis -> bar")))
+		})
+	})
+
+	Context("add app with a chart version", func() {
+		It("renders the version constraint into the helm release", func() {
+			addParams.Url = "https://charts.kube-ops.io"
+			addParams.Chart = "loki"
+			addParams.ChartVersion = "~2.8.0"
+
+			Expect(appSrv.Add(addParams)).To(Succeed())
+
+			_, _, _, _, opts := fluxClient.CreateHelmReleaseHelmRepositoryArgsForCall(0)
+			Expect(opts.Version).To(Equal("~2.8.0"))
+
+			_, appManifest, _ := kubeClient.ApplyArgsForCall(2)
+			Expect(string(appManifest)).To(ContainSubstring("helm_chart_version: ~2.8.0"))
+		})
+
+		It("rejects an invalid version constraint", func() {
+			addParams.Url = "https://charts.kube-ops.io"
+			addParams.Chart = "loki"
+			addParams.ChartVersion = "latest"
+
+			err := appSrv.Add(addParams)
+			Expect(err).To(MatchError(ContainSubstring("invalid chart version constraint")))
+		})
+
+		It("only accepts a version for charts of a helm repository", func() {
+			addParams.ChartVersion = "1.0.0"
+
+			err := appSrv.Add(addParams)
+			Expect(err).To(MatchError(ContainSubstring("--chart-version can only be used")))
+		})
+	})
+
 	Context("when using dry-run", func() {
 		It("doesnt execute any action", func() {
 			addParams.DryRun = true
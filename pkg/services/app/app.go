@@ -5,8 +5,6 @@ import (
 	"fmt"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
-	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
-	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1beta1"
 	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
 	"github.com/weaveworks/weave-gitops/pkg/flux"
 	"github.com/weaveworks/weave-gitops/pkg/git"
@@ -14,8 +12,11 @@ import (
 	"github.com/weaveworks/weave-gitops/pkg/kube"
 	"github.com/weaveworks/weave-gitops/pkg/logger"
 	"github.com/weaveworks/weave-gitops/pkg/osys"
+	"github.com/weaveworks/weave-gitops/pkg/render"
+	"github.com/weaveworks/weave-gitops/pkg/runner"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
-	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // type DeploymentType string
@@ -35,16 +36,36 @@ type AppService interface {
 	Add(params AddParams) error
 	// Get returns a given applicaiton
 	Get(name types.NamespacedName) (*wego.Application, error)
+	// GetInfo returns a summary of an application and the state of its automation
+	GetInfo(name types.NamespacedName) (*AppInfo, error)
+	// ListInfo returns the summary of every application of a namespace
+	ListInfo(namespace string) ([]AppInfo, error)
 	// GetCommits returns a list of commits for an application
 	GetCommits(params CommitParams, application *wego.Application) ([]gitprovider.Commit, error)
 	// Remove removes an application from the cluster
 	Remove(params RemoveParams) error
 	// Status returns flux resources status and the last successful reconciliation time
 	Status(params StatusParams) (string, string, error)
-	// Pause pauses the gitops automation for an app
-	Pause(params PauseParams) error
-	// Unpause resumes the gitops automation for an app
-	Unpause(params UnpauseParams) error
+	// Update changes an existing application and regenerates its gitops automation
+	Update(params UpdateParams) error
+	// Events returns the Kubernetes events of an application and of the objects it manages, sorted by time
+	Events(params EventsParams) ([]corev1.Event, error)
+	// Sync asks Flux to reconcile the source and the automation of an app
+	Sync(params SyncParams) (*SyncResult, error)
+	// RotateKeys replaces the deploy keys of the repositories of an app
+	RotateKeys(params RotateKeysParams) error
+	// ChartVersions returns the versions of the chart of an app published in its helm repository, newest first
+	ChartVersions(name types.NamespacedName) ([]string, error)
+	// Graph returns the dependencies between the applications of a namespace
+	Graph(params GraphParams) (DependencyGraph, error)
+	// Promote opens a pull request deploying to an environment of an app what the previous environment deploys
+	Promote(params PromoteParams) error
+	// Diff compares the manifests rendered from the source of an app with the objects of the cluster
+	Diff(params DiffParams) (*DiffResult, error)
+	// PreviewAdd returns the files and objects adding an app would write and apply, without making any change
+	PreviewAdd(params AddParams) (*AddPreview, error)
+	// GarbageCollectedObjects returns the objects Flux deletes from the cluster when an app is removed
+	GarbageCollectedObjects(params RemoveParams) ([]unstructured.Unstructured, error)
 }
 
 type App struct {
@@ -56,6 +77,7 @@ type App struct {
 	Kube        kube.Kube
 	Logger      logger.Logger
 	GitProvider gitproviders.GitProvider
+	Renderer    render.Renderer
 }
 
 func New(ctx context.Context, logger logger.Logger, appGit, configGit git.Git, gitProvider gitproviders.GitProvider, flux flux.Flux, kube kube.Kube, osys osys.Osys) AppService {
@@ -68,6 +90,7 @@ func New(ctx context.Context, logger logger.Logger, appGit, configGit git.Git, g
 		Logger:      logger,
 		Osys:        osys,
 		GitProvider: gitProvider,
+		Renderer:    render.New(&runner.CLIRunner{}),
 	}
 }
 
@@ -83,90 +106,6 @@ func (a *App) getDeploymentType(ctx context.Context, name string, namespace stri
 	return wego.DeploymentType(app.Spec.DeploymentType), nil
 }
 
-func (a *App) getSuspendedStatus(ctx context.Context, name, namespace string, deploymentType wego.DeploymentType) (bool, error) {
-	var automation client.Object
-
-	switch deploymentType {
-	case wego.DeploymentTypeKustomize:
-		automation = &kustomizev1.Kustomization{}
-	case wego.DeploymentTypeHelm:
-		automation = &helmv2.HelmRelease{}
-	default:
-		return false, fmt.Errorf("invalid deployment type: %v", deploymentType)
-	}
-
-	if err := a.Kube.GetResource(ctx, types.NamespacedName{Namespace: namespace, Name: name}, automation); err != nil {
-		return false, err
-	}
-
-	suspendStatus := false
-
-	switch at := automation.(type) {
-	case *kustomizev1.Kustomization:
-		suspendStatus = at.Spec.Suspend
-	case *helmv2.HelmRelease:
-		suspendStatus = at.Spec.Suspend
-	}
-
-	return suspendStatus, nil
-}
-
-func (a *App) pauseOrUnpause(suspendAction wego.SuspendActionType, name, namespace string) error {
-	ctx := context.Background()
-
-	deploymentType, err := a.getDeploymentType(ctx, name, namespace)
-	if err != nil {
-		return fmt.Errorf("unable to determine deployment type for %s: %s", name, err)
-	}
-
-	suspendStatus, err := a.getSuspendedStatus(ctx, name, namespace, deploymentType)
-	if err != nil {
-		return fmt.Errorf("failed to get suspended status: %s", err)
-	}
-
-	switch deploymentType {
-	case wego.DeploymentTypeKustomize:
-		deploymentType = "kustomization"
-	case wego.DeploymentTypeHelm:
-		deploymentType = "helmrelease"
-	default:
-		return fmt.Errorf("invalid deployment type: %v", deploymentType)
-	}
-
-	switch suspendAction {
-	case wego.SuspendAction:
-		if suspendStatus {
-			a.Logger.Printf("app %s is already paused\n", name)
-			return nil
-		}
-
-		out, err := a.Flux.SuspendOrResumeApp(suspendAction, name, namespace, string(deploymentType))
-		if err != nil {
-			return fmt.Errorf("unable to pause %s err: %s", name, err)
-		}
-
-		a.Logger.Printf("%s\n gitops automation paused for %s\n", string(out), name)
-
-		return nil
-	case wego.ResumeAction:
-		if !suspendStatus {
-			a.Logger.Printf("app %s is already reconciling\n", name)
-			return nil
-		}
-
-		out, err := a.Flux.SuspendOrResumeApp(suspendAction, name, namespace, string(deploymentType))
-		if err != nil {
-			return fmt.Errorf("unable to unpause %s err: %s", name, err)
-		}
-
-		a.Logger.Printf("%s\n gitops automation unpaused for %s\n", string(out), name)
-
-		return nil
-	}
-
-	return fmt.Errorf("invalid suspend action")
-}
-
 func IsClusterReady(l logger.Logger, k kube.Kube) error {
 	l.Waitingf("Checking cluster status")
 
@@ -0,0 +1,201 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1beta1"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// The annotations of a paused Kustomization or HelmRelease recording who paused it, why and when.
+// They are removed when the application is unpaused.
+const (
+	PausedByAnnotation    = "wego.weave.works/paused-by"
+	PauseReasonAnnotation = "wego.weave.works/pause-reason"
+	PausedAtAnnotation    = "wego.weave.works/paused-at"
+)
+
+// maxConcurrentPauses is how many applications are paused or unpaused at once
+const maxConcurrentPauses = 10
+
+var (
+	ErrNoAppsSelected       = errors.New("either an app name, all the apps or a label selector must be given")
+	ErrInvalidLabelSelector = errors.New("invalid label selector")
+)
+
+type PauseAppsParams struct {
+	Namespace string
+	// Name selects one application, All every application of the namespace
+	// and LabelSelector the applications whose labels match it
+	Name          string
+	All           bool
+	LabelSelector string
+	// PausedBy and Reason are recorded in annotations of the automations paused, when set
+	PausedBy string
+	Reason   string
+}
+
+// PauseResult reports the outcome of pausing or unpausing one application
+type PauseResult struct {
+	Name      string
+	Namespace string
+	// Changed is false when the application already was paused, or unpaused
+	Changed bool
+	Err     error
+}
+
+// SuspendApplications suspends or resumes the Kustomization or HelmRelease of the selected applications of a namespace,
+// patching several of them at once. The results are sorted by name, the failure to pause or unpause an application
+// being reported in its result.
+func SuspendApplications(ctx context.Context, kubeService kube.Kube, action wego.SuspendActionType, params PauseAppsParams) ([]PauseResult, error) {
+	apps, err := selectApplications(ctx, kubeService, params)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]PauseResult, len(apps))
+	sem := make(chan struct{}, maxConcurrentPauses)
+
+	var wg sync.WaitGroup
+
+	for i := range apps {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			app := apps[i]
+			changed, err := suspendApplication(ctx, kubeService, action, &app, params)
+
+			results[i] = PauseResult{Name: app.Name, Namespace: app.Namespace, Changed: changed, Err: err}
+		}(i)
+	}
+
+	wg.Wait()
+
+	return results, nil
+}
+
+func selectApplications(ctx context.Context, kubeService kube.Kube, params PauseAppsParams) ([]wego.Application, error) {
+	selections := 0
+
+	for _, selected := range []bool{params.Name != "", params.All, params.LabelSelector != ""} {
+		if selected {
+			selections++
+		}
+	}
+
+	if selections != 1 {
+		return nil, ErrNoAppsSelected
+	}
+
+	if params.Name != "" {
+		app, err := kubeService.GetApplication(ctx, types.NamespacedName{Name: params.Name, Namespace: params.Namespace})
+		if err != nil {
+			return nil, fmt.Errorf("could not get application %q: %w", params.Name, err)
+		}
+
+		return []wego.Application{*app}, nil
+	}
+
+	selector := labels.Everything()
+
+	if params.LabelSelector != "" {
+		var err error
+
+		if selector, err = labels.Parse(params.LabelSelector); err != nil {
+			return nil, fmt.Errorf("%w %q: %s", ErrInvalidLabelSelector, params.LabelSelector, err)
+		}
+	}
+
+	apps, err := kubeService.GetApplications(ctx, params.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("could not list applications: %w", err)
+	}
+
+	selected := []wego.Application{}
+
+	for _, app := range apps {
+		if selector.Matches(labels.Set(app.Labels)) {
+			selected = append(selected, app)
+		}
+	}
+
+	sort.Slice(selected, func(i, j int) bool {
+		return selected[i].Name < selected[j].Name
+	})
+
+	return selected, nil
+}
+
+// suspendApplication suspends or resumes the automation of an application, reporting whether it was changed
+func suspendApplication(ctx context.Context, kubeService kube.Kube, action wego.SuspendActionType, app *wego.Application, params PauseAppsParams) (bool, error) {
+	name := types.NamespacedName{Name: app.Name, Namespace: app.Namespace}
+
+	automation := automationOf(app)
+
+	found, err := getOptionalResource(ctx, kubeService, name, automation)
+	if err != nil {
+		return false, err
+	}
+
+	if !found {
+		return false, fmt.Errorf("could not find %s %s", kindOf(automation), name)
+	}
+
+	suspend := action == wego.SuspendAction
+	if isSuspended(automation) == suspend {
+		return false, nil
+	}
+
+	patch := client.MergeFrom(automation.DeepCopyObject().(client.Object))
+
+	switch o := automation.(type) {
+	case *kustomizev1.Kustomization:
+		o.Spec.Suspend = suspend
+	case *helmv2.HelmRelease:
+		o.Spec.Suspend = suspend
+	}
+
+	annotations := automation.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	if suspend {
+		annotations[PausedAtAnnotation] = time.Now().UTC().Format(time.RFC3339)
+
+		if params.PausedBy != "" {
+			annotations[PausedByAnnotation] = params.PausedBy
+		}
+
+		if params.Reason != "" {
+			annotations[PauseReasonAnnotation] = params.Reason
+		}
+	} else {
+		delete(annotations, PausedByAnnotation)
+		delete(annotations, PauseReasonAnnotation)
+		delete(annotations, PausedAtAnnotation)
+	}
+
+	automation.SetAnnotations(annotations)
+
+	if err := kubeService.PatchResource(ctx, automation, patch); err != nil {
+		return false, fmt.Errorf("could not patch %s %s: %w", kindOf(automation), name, err)
+	}
+
+	return true, nil
+}
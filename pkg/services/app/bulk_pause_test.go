@@ -0,0 +1,172 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1beta1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("SuspendApplications", func() {
+	var (
+		mu      sync.Mutex
+		patched map[string]kube.Resource
+		params  PauseAppsParams
+	)
+
+	newApp := func(name, team string, deploymentType wego.DeploymentType) wego.Application {
+		return wego.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: wego.DefaultNamespace, Labels: map[string]string{"team": team}},
+			Spec:       wego.ApplicationSpec{DeploymentType: deploymentType},
+		}
+	}
+
+	BeforeEach(func() {
+		patched = map[string]kube.Resource{}
+		params = PauseAppsParams{Namespace: wego.DefaultNamespace, PausedBy: "alice", Reason: "incident 42"}
+
+		kubeClient.GetApplicationsReturns([]wego.Application{
+			newApp("podinfo", "payments", wego.DeploymentTypeKustomize),
+			newApp("billing", "payments", wego.DeploymentTypeHelm),
+			newApp("frontend", "web", wego.DeploymentTypeKustomize),
+		}, nil)
+
+		kubeClient.GetResourceStub = func(_ context.Context, name types.NamespacedName, r kube.Resource) error {
+			switch obj := r.(type) {
+			case *kustomizev1.Kustomization:
+				obj.Name = name.Name
+				obj.Namespace = name.Namespace
+				obj.Spec.Suspend = name.Name == "frontend"
+			case *helmv2.HelmRelease:
+				obj.Name = name.Name
+				obj.Namespace = name.Namespace
+			}
+
+			return nil
+		}
+
+		kubeClient.PatchResourceStub = func(_ context.Context, r kube.Resource, _ client.Patch) error {
+			mu.Lock()
+			defer mu.Unlock()
+
+			patched[r.GetName()] = r
+
+			return nil
+		}
+	})
+
+	It("pauses the apps matching the label selector", func() {
+		params.LabelSelector = "team=payments"
+
+		results, err := SuspendApplications(context.Background(), kubeClient, wego.SuspendAction, params)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(results).To(Equal([]PauseResult{
+			{Name: "billing", Namespace: wego.DefaultNamespace, Changed: true},
+			{Name: "podinfo", Namespace: wego.DefaultNamespace, Changed: true},
+		}))
+
+		Expect(patched).To(HaveLen(2))
+		Expect(patched["billing"].(*helmv2.HelmRelease).Spec.Suspend).To(BeTrue())
+
+		kust := patched["podinfo"].(*kustomizev1.Kustomization)
+		Expect(kust.Spec.Suspend).To(BeTrue())
+		Expect(kust.Annotations).To(HaveKeyWithValue(PausedByAnnotation, "alice"))
+		Expect(kust.Annotations).To(HaveKeyWithValue(PauseReasonAnnotation, "incident 42"))
+		Expect(kust.Annotations).To(HaveKey(PausedAtAnnotation))
+	})
+
+	It("pauses every app of the namespace, leaving the paused apps unchanged", func() {
+		params.All = true
+
+		results, err := SuspendApplications(context.Background(), kubeClient, wego.SuspendAction, params)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(results).To(HaveLen(3))
+		Expect(results[1]).To(Equal(PauseResult{Name: "frontend", Namespace: wego.DefaultNamespace}))
+		Expect(patched).To(HaveLen(2))
+	})
+
+	It("unpauses the apps, removing the pause annotations", func() {
+		params.LabelSelector = "team=web"
+
+		results, err := SuspendApplications(context.Background(), kubeClient, wego.ResumeAction, params)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(results).To(Equal([]PauseResult{{Name: "frontend", Namespace: wego.DefaultNamespace, Changed: true}}))
+
+		kust := patched["frontend"].(*kustomizev1.Kustomization)
+		Expect(kust.Spec.Suspend).To(BeFalse())
+		Expect(kust.Annotations).NotTo(HaveKey(PausedByAnnotation))
+	})
+
+	It("pauses the app of the given name, recording why", func() {
+		podinfo := newApp("podinfo", "payments", wego.DeploymentTypeKustomize)
+		kubeClient.GetApplicationReturns(&podinfo, nil)
+
+		params.Name = "podinfo"
+
+		results, err := SuspendApplications(context.Background(), kubeClient, wego.SuspendAction, params)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(results).To(Equal([]PauseResult{{Name: "podinfo", Namespace: wego.DefaultNamespace, Changed: true}}))
+
+		_, name := kubeClient.GetApplicationArgsForCall(0)
+		Expect(name).To(Equal(types.NamespacedName{Name: "podinfo", Namespace: wego.DefaultNamespace}))
+
+		kust := patched["podinfo"].(*kustomizev1.Kustomization)
+		Expect(kust.Annotations).To(HaveKeyWithValue(PauseReasonAnnotation, "incident 42"))
+	})
+
+	It("reports the apps which could not be paused", func() {
+		params.All = true
+
+		kubeClient.PatchResourceStub = func(_ context.Context, r kube.Resource, _ client.Patch) error {
+			if r.GetName() == "billing" {
+				return errors.New("forbidden")
+			}
+
+			return nil
+		}
+
+		results, err := SuspendApplications(context.Background(), kubeClient, wego.SuspendAction, params)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(results[0].Name).To(Equal("billing"))
+		Expect(results[0].Err).To(MatchError(ContainSubstring("forbidden")))
+		Expect(results[2].Changed).To(BeTrue())
+	})
+
+	It("requires either an app name, all the apps or a label selector", func() {
+		_, err := SuspendApplications(context.Background(), kubeClient, wego.SuspendAction, params)
+		Expect(err).To(MatchError(ErrNoAppsSelected))
+
+		params.All = true
+		params.LabelSelector = "team=web"
+
+		_, err = SuspendApplications(context.Background(), kubeClient, wego.SuspendAction, params)
+		Expect(err).To(MatchError(ErrNoAppsSelected))
+
+		params.Name = "podinfo"
+		params.LabelSelector = ""
+
+		_, err = SuspendApplications(context.Background(), kubeClient, wego.SuspendAction, params)
+		Expect(err).To(MatchError(ErrNoAppsSelected))
+	})
+
+	It("rejects invalid label selectors", func() {
+		params.LabelSelector = "team in ("
+
+		_, err := SuspendApplications(context.Background(), kubeClient, wego.SuspendAction, params)
+		Expect(errors.Is(err, ErrInvalidLabelSelector)).To(BeTrue())
+	})
+})
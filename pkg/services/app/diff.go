@@ -0,0 +1,620 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/drone/envsubst"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1beta1"
+	"github.com/pmezard/go-difflib/difflib"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/git"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/render"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/yaml"
+)
+
+// defaultValuesKey is the entry of the values objects holding the Helm values when not given
+const defaultValuesKey = "values.yaml"
+
+// substituteKey is the label or annotation of the objects whose variables are not substituted when disabled
+var substituteKey = kustomizev1.GroupVersion.Group + "/substitute"
+
+// varNameRegexp matches the names of the post build variables accepted by the kustomize-controller
+var varNameRegexp = regexp.MustCompile("^[_[:alpha:]][_[:alpha:][:digit:]]*$")
+
+type DiffParams struct {
+	Name      string
+	Namespace string
+}
+
+// DriftStatus tells how an object of the cluster differs from its manifest in the source of its app
+type DriftStatus string
+
+const (
+	DriftStatusInSync   DriftStatus = "InSync"
+	DriftStatusModified DriftStatus = "Modified"
+	// DriftStatusMissing is for the objects of the source which are not in the cluster
+	DriftStatusMissing DriftStatus = "Missing"
+	// DriftStatusExtra is for the objects reconciled by the app which are no longer in the source
+	DriftStatusExtra DriftStatus = "Extra"
+)
+
+// ObjectDiff compares an object rendered from the source of an app with the live object of the cluster
+type ObjectDiff struct {
+	GroupVersionKind schema.GroupVersionKind
+	Name             string
+	Namespace        string
+	Status           DriftStatus
+	// Diff is the unified diff from the live object to the rendered one, on the fields set by the source.
+	// The contents of Secrets are not compared.
+	Diff string
+}
+
+// DiffResult holds the objects of an app, sorted by kind, namespace and name
+type DiffResult struct {
+	Objects []ObjectDiff
+}
+
+// DriftSummary counts the objects of an app by drift status
+type DriftSummary struct {
+	InSync   int
+	Modified int
+	Missing  int
+	Extra    int
+}
+
+func (r *DiffResult) Summary() DriftSummary {
+	summary := DriftSummary{}
+
+	for _, obj := range r.Objects {
+		switch obj.Status {
+		case DriftStatusInSync:
+			summary.InSync++
+		case DriftStatusModified:
+			summary.Modified++
+		case DriftStatusMissing:
+			summary.Missing++
+		case DriftStatusExtra:
+			summary.Extra++
+		}
+	}
+
+	return summary
+}
+
+// Drifted tells whether any object of the cluster differs from the source
+func (s DriftSummary) Drifted() bool {
+	return s.Modified > 0 || s.Missing > 0 || s.Extra > 0
+}
+
+func (a *App) Diff(params DiffParams) (*DiffResult, error) {
+	return DiffApplication(a.Context, a.Kube, a.AppGit, a.Renderer, params)
+}
+
+// DiffApplication renders the manifests of the source of an application the way Flux does, and compares them
+// with the live objects of the cluster reconciled by the application
+func DiffApplication(ctx context.Context, kubeService kube.Kube, gitClient git.Git, renderer render.Renderer, params DiffParams) (*DiffResult, error) {
+	app, err := kubeService.GetApplication(ctx, types.NamespacedName{Name: params.Name, Namespace: params.Namespace})
+	if err != nil {
+		return nil, fmt.Errorf("could not get application %q: %w", params.Name, err)
+	}
+
+	desired, err := renderApplication(ctx, kubeService, gitClient, renderer, app)
+	if err != nil {
+		return nil, err
+	}
+
+	name := types.NamespacedName{Name: app.Name, Namespace: app.Namespace}
+
+	var (
+		reconciled       []unstructured.Unstructured
+		defaultNamespace = metav1.NamespaceDefault
+	)
+
+	if deploymentType(*app) == wego.DeploymentTypeHelm {
+		_, defaultNamespace = helmRelease(app)
+		reconciled, err = helmReleaseObjects(ctx, kubeService, name)
+	} else {
+		if app.Spec.Kustomization != nil && app.Spec.Kustomization.TargetNamespace != "" {
+			defaultNamespace = app.Spec.Kustomization.TargetNamespace
+
+			for i := range desired {
+				desired[i].SetNamespace(defaultNamespace)
+			}
+		}
+
+		reconciled, err = kustomizationObjects(ctx, kubeService, name)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return compareObjects(ctx, kubeService, desired, reconciled, defaultNamespace)
+}
+
+// renderApplication returns the objects of the source of an application, cloning its repository for git sources
+func renderApplication(ctx context.Context, kubeService kube.Kube, gitClient git.Git, renderer render.Renderer, app *wego.Application) ([]unstructured.Unstructured, error) {
+	if deploymentType(*app) != wego.DeploymentTypeHelm {
+		manifests, err := renderRepositoryPath(ctx, gitClient, app, renderer.Kustomize)
+		if err != nil {
+			return nil, err
+		}
+
+		objects, err := render.Objects(manifests)
+		if err != nil {
+			return nil, err
+		}
+
+		return substituteVariables(ctx, kubeService, app, objects)
+	}
+
+	values, err := helmValues(ctx, kubeService, app)
+	if err != nil {
+		return nil, err
+	}
+
+	releaseName, releaseNamespace := helmRelease(app)
+
+	opts := render.HelmOptions{
+		ReleaseName: releaseName,
+		Namespace:   releaseNamespace,
+		Chart:       app.Spec.Path,
+		RepoURL:     app.Spec.URL,
+		Version:     app.Spec.HelmChartVersion,
+		Values:      values,
+	}
+
+	var manifests []byte
+
+	if app.Spec.SourceType == wego.SourceTypeHelm {
+		manifests, err = renderer.Helm(opts)
+		if err != nil {
+			return nil, fmt.Errorf("could not render chart %s: %w", app.Spec.Path, err)
+		}
+	} else {
+		manifests, err = renderRepositoryPath(ctx, gitClient, app, func(path string) ([]byte, error) {
+			opts.Chart, opts.RepoURL, opts.Version = path, "", ""
+			return renderer.Helm(opts)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return render.Objects(manifests)
+}
+
+// renderRepositoryPath clones the repository of an application and renders the directory of its path
+func renderRepositoryPath(ctx context.Context, gitClient git.Git, app *wego.Application, renderPath func(string) ([]byte, error)) ([]byte, error) {
+	repoDir, err := ioutil.TempDir("", "user-repo-")
+	if err != nil {
+		return nil, fmt.Errorf("failed creating temp. directory to clone repo: %w", err)
+	}
+	defer os.RemoveAll(repoDir)
+
+	info := getAppResourceInfo(*app, "")
+
+	if _, err := gitClient.Clone(ctx, repoDir, info.gitURL(app.Spec.URL), app.Spec.Branch); err != nil {
+		return nil, fmt.Errorf("failed cloning user repo: %s: %w", app.Spec.URL, err)
+	}
+
+	manifests, err := renderPath(filepath.Join(repoDir, app.Spec.Path))
+	if err != nil {
+		return nil, fmt.Errorf("could not render path %s: %w", app.Spec.Path, err)
+	}
+
+	return manifests, nil
+}
+
+// helmRelease returns the name and the namespace of the Helm release of an application,
+// as chosen by the helm-controller
+func helmRelease(app *wego.Application) (string, string) {
+	if app.Spec.HelmTargetNamespace != "" {
+		return app.Spec.HelmTargetNamespace + "-" + app.Name, app.Spec.HelmTargetNamespace
+	}
+
+	return app.Name, app.Namespace
+}
+
+// substituteVariables replaces the post build variables of the Kustomization of an application in the objects,
+// the way the kustomize-controller does before applying them
+func substituteVariables(ctx context.Context, kubeService kube.Kube, app *wego.Application, objects []unstructured.Unstructured) ([]unstructured.Unstructured, error) {
+	vars, err := postBuildVariables(ctx, kubeService, app)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(vars) == 0 {
+		return objects, nil
+	}
+
+	for name := range vars {
+		if !varNameRegexp.MatchString(name) {
+			return nil, fmt.Errorf("'%s' var name is invalid, must match '%s'", name, varNameRegexp)
+		}
+	}
+
+	result := []unstructured.Unstructured{}
+
+	for _, obj := range objects {
+		if obj.GetLabels()[substituteKey] == kustomizev1.DisabledValue || obj.GetAnnotations()[substituteKey] == kustomizev1.DisabledValue {
+			result = append(result, obj)
+			continue
+		}
+
+		data, err := yaml.Marshal(obj.Object)
+		if err != nil {
+			return nil, err
+		}
+
+		out, err := envsubst.Eval(string(data), func(name string) string {
+			return vars[name]
+		})
+		if err != nil {
+			return nil, fmt.Errorf("variable substitution failed for %s %s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+
+		substituted := unstructured.Unstructured{}
+		if err := yaml.Unmarshal([]byte(out), &substituted.Object); err != nil {
+			return nil, fmt.Errorf("variable substitution failed for %s %s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+
+		result = append(result, substituted)
+	}
+
+	return result, nil
+}
+
+// postBuildVariables returns the variables of the ConfigMaps and Secrets of the application,
+// overridden by its inline variables
+func postBuildVariables(ctx context.Context, kubeService kube.Kube, app *wego.Application) (map[string]string, error) {
+	settings := app.Spec.Kustomization
+	if settings == nil {
+		return nil, nil
+	}
+
+	vars := map[string]string{}
+
+	for _, ref := range settings.SubstituteFrom {
+		name := types.NamespacedName{Name: ref.Name, Namespace: app.Namespace}
+
+		switch ref.Kind {
+		case "Secret":
+			secret, err := kubeService.GetSecret(ctx, name)
+			if err != nil {
+				return nil, err
+			}
+
+			if secret == nil {
+				return nil, fmt.Errorf("substitute secret %s not found", name)
+			}
+
+			for k, v := range secret.Data {
+				vars[k] = strings.ReplaceAll(string(v), "\n", "")
+			}
+		default:
+			configMap := &corev1.ConfigMap{}
+
+			found, err := getOptionalResource(ctx, kubeService, name, configMap)
+			if err != nil {
+				return nil, err
+			}
+
+			if !found {
+				return nil, fmt.Errorf("substitute configmap %s not found", name)
+			}
+
+			for k, v := range configMap.Data {
+				vars[k] = strings.ReplaceAll(v, "\n", "")
+			}
+		}
+	}
+
+	for k, v := range settings.Substitute {
+		vars[k] = strings.ReplaceAll(v, "\n", "")
+	}
+
+	return vars, nil
+}
+
+// helmValues merges the values of the values references of an application, then its own values
+func helmValues(ctx context.Context, kubeService kube.Kube, app *wego.Application) ([]byte, error) {
+	values := map[string]interface{}{}
+
+	for _, ref := range app.Spec.HelmValuesFrom {
+		key := ref.ValuesKey
+		if key == "" {
+			key = defaultValuesKey
+		}
+
+		name := types.NamespacedName{Name: ref.Name, Namespace: app.Namespace}
+
+		var data []byte
+
+		switch ref.Kind {
+		case "Secret":
+			secret, err := kubeService.GetSecret(ctx, name)
+			if err != nil {
+				return nil, err
+			}
+
+			if secret == nil {
+				return nil, fmt.Errorf("values secret %s not found", name)
+			}
+
+			data = secret.Data[key]
+		default:
+			configMap := &corev1.ConfigMap{}
+
+			found, err := getOptionalResource(ctx, kubeService, name, configMap)
+			if err != nil {
+				return nil, err
+			}
+
+			if !found {
+				return nil, fmt.Errorf("values configmap %s not found", name)
+			}
+
+			data = []byte(configMap.Data[key])
+		}
+
+		refValues := map[string]interface{}{}
+		if err := yaml.Unmarshal(data, &refValues); err != nil {
+			return nil, fmt.Errorf("could not parse the values of %s %s: %w", ref.Kind, name, err)
+		}
+
+		mergeValues(values, refValues)
+	}
+
+	if app.Spec.HelmValues != nil && len(app.Spec.HelmValues.Raw) > 0 {
+		appValues := map[string]interface{}{}
+		if err := json.Unmarshal(app.Spec.HelmValues.Raw, &appValues); err != nil {
+			return nil, fmt.Errorf("could not read the helm values: %w", err)
+		}
+
+		mergeValues(values, appValues)
+	}
+
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	return json.Marshal(values)
+}
+
+// objectKey identifies an object regardless of the version of its kind
+type objectKey struct {
+	group     string
+	kind      string
+	namespace string
+	name      string
+}
+
+func keyOf(obj unstructured.Unstructured) objectKey {
+	gvk := obj.GroupVersionKind()
+
+	return objectKey{group: gvk.Group, kind: gvk.Kind, namespace: obj.GetNamespace(), name: obj.GetName()}
+}
+
+// compareObjects compares the rendered objects with the live ones. The objects reconciled by the app which are
+// not rendered anymore are reported as extra. The rendered objects without namespace are looked up in the
+// default namespace unless they are cluster scoped.
+func compareObjects(ctx context.Context, kubeService kube.Kube, desired, reconciled []unstructured.Unstructured, defaultNamespace string) (*DiffResult, error) {
+	result := &DiffResult{}
+	seen := map[objectKey]bool{}
+
+	for _, obj := range desired {
+		namespace := obj.GetNamespace()
+		if namespace == "" {
+			namespace = defaultNamespace
+		}
+
+		live, err := getLiveObject(ctx, kubeService, obj.GroupVersionKind(), namespace, obj.GetName())
+		if err != nil {
+			return nil, err
+		}
+
+		if live == nil {
+			obj.SetNamespace(namespace)
+			seen[keyOf(obj)] = true
+
+			result.Objects = append(result.Objects, newObjectDiff(obj, DriftStatusMissing, unifiedDiff(obj, "", objectYAML(obj.Object))))
+
+			continue
+		}
+
+		// Cluster scoped objects have no namespace, whatever the source says
+		if live.GetNamespace() == "" {
+			obj.SetNamespace("")
+		} else if obj.GetNamespace() == "" {
+			obj.SetNamespace(namespace)
+		}
+
+		seen[keyOf(*live)] = true
+
+		desiredYAML, liveYAML := comparedYAML(obj, *live)
+		if desiredYAML == liveYAML {
+			result.Objects = append(result.Objects, newObjectDiff(obj, DriftStatusInSync, ""))
+		} else {
+			result.Objects = append(result.Objects, newObjectDiff(obj, DriftStatusModified, unifiedDiff(obj, liveYAML, desiredYAML)))
+		}
+	}
+
+	for _, obj := range reconciled {
+		if seen[keyOf(obj)] {
+			continue
+		}
+
+		seen[keyOf(obj)] = true
+
+		live, err := getLiveObject(ctx, kubeService, obj.GroupVersionKind(), obj.GetNamespace(), obj.GetName())
+		if err != nil {
+			return nil, err
+		}
+
+		if live != nil {
+			result.Objects = append(result.Objects, newObjectDiff(*live, DriftStatusExtra, ""))
+		}
+	}
+
+	sort.SliceStable(result.Objects, func(i, j int) bool {
+		a, b := result.Objects[i], result.Objects[j]
+
+		if a.GroupVersionKind.Kind != b.GroupVersionKind.Kind {
+			return a.GroupVersionKind.Kind < b.GroupVersionKind.Kind
+		}
+
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+
+		return a.Name < b.Name
+	})
+
+	return result, nil
+}
+
+// getLiveObject returns an object of the cluster, or nil when it does not exist,
+// including when its kind is not served by the cluster, as for the custom resources of a CRD not installed yet
+func getLiveObject(ctx context.Context, kubeService kube.Kube, gvk schema.GroupVersionKind, namespace, name string) (*unstructured.Unstructured, error) {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+
+	found, err := getOptionalResource(ctx, kubeService, types.NamespacedName{Name: name, Namespace: namespace}, obj)
+	if err != nil {
+		if isNoMatch(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if !found {
+		return nil, nil
+	}
+
+	return obj, nil
+}
+
+// isNoMatch tells whether an error is returned for a kind the cluster does not serve
+func isNoMatch(err error) bool {
+	var noKind *apimeta.NoKindMatchError
+	var noResource *apimeta.NoResourceMatchError
+
+	return errors.As(err, &noKind) || errors.As(err, &noResource)
+}
+
+func newObjectDiff(obj unstructured.Unstructured, status DriftStatus, diff string) ObjectDiff {
+	return ObjectDiff{
+		GroupVersionKind: obj.GroupVersionKind(),
+		Name:             obj.GetName(),
+		Namespace:        obj.GetNamespace(),
+		Status:           status,
+		Diff:             diff,
+	}
+}
+
+// comparedYAML returns the YAML of a rendered object and of the fields of the live object set by the rendered one,
+// leaving out the fields added by the API server and the controllers
+func comparedYAML(desired, live unstructured.Unstructured) (string, string) {
+	desiredObject := desired.DeepCopy().Object
+	liveObject := live.DeepCopy().Object
+
+	if desired.GetKind() == "Secret" {
+		for _, obj := range []map[string]interface{}{desiredObject, liveObject} {
+			delete(obj, "data")
+			delete(obj, "stringData")
+		}
+	}
+
+	projected := projectFields(desiredObject, liveObject)
+
+	projectedObject, _ := projected.(map[string]interface{})
+
+	return objectYAML(desiredObject), objectYAML(projectedObject)
+}
+
+// projectFields returns the fields of the live value which are set in the desired value. The items of lists are
+// projected one by one when both lists have the same length, the whole live list is returned otherwise.
+func projectFields(desired, live interface{}) interface{} {
+	switch d := desired.(type) {
+	case map[string]interface{}:
+		l, ok := live.(map[string]interface{})
+		if !ok {
+			return live
+		}
+
+		result := map[string]interface{}{}
+
+		for key, value := range d {
+			if liveValue, ok := l[key]; ok {
+				result[key] = projectFields(value, liveValue)
+			}
+		}
+
+		return result
+	case []interface{}:
+		l, ok := live.([]interface{})
+		if !ok || len(l) != len(d) {
+			return live
+		}
+
+		result := make([]interface{}, len(l))
+		for i := range l {
+			result[i] = projectFields(d[i], l[i])
+		}
+
+		return result
+	default:
+		return live
+	}
+}
+
+func objectYAML(obj map[string]interface{}) string {
+	if obj == nil {
+		return ""
+	}
+
+	data, err := yaml.Marshal(obj)
+	if err != nil {
+		return fmt.Sprintf("%v\n", obj)
+	}
+
+	return string(data)
+}
+
+func unifiedDiff(obj unstructured.Unstructured, live, desired string) string {
+	id := obj.GetKind() + "/" + obj.GetName()
+	if obj.GetNamespace() != "" {
+		id = obj.GetKind() + "/" + obj.GetNamespace() + "/" + obj.GetName()
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(live),
+		B:        difflib.SplitLines(desired),
+		FromFile: "live/" + id,
+		ToFile:   "source/" + id,
+		Context:  3,
+	})
+	if err != nil {
+		return ""
+	}
+
+	return diff
+}
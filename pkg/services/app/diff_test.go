@@ -0,0 +1,334 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1beta1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/render"
+	"github.com/weaveworks/weave-gitops/pkg/render/renderfakes"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const sourceManifests = `---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: podinfo
+  namespace: apps
+spec:
+  replicas: 2
+  template:
+    spec:
+      containers:
+      - name: podinfo
+        image: ghcr.io/stefanprodan/podinfo:6.0.0
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: podinfo
+  namespace: apps
+spec:
+  ports:
+  - port: 9898
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: podinfo-config
+  namespace: apps
+data:
+  color: blue
+`
+
+func liveObject(manifest string) unstructured.Unstructured {
+	objects, err := render.Objects([]byte(manifest))
+	Expect(err).NotTo(HaveOccurred())
+	Expect(objects).To(HaveLen(1))
+
+	return objects[0]
+}
+
+var _ = Describe("Diff", func() {
+	var (
+		renderer    *renderfakes.FakeRenderer
+		existingApp wego.Application
+		live        []unstructured.Unstructured
+	)
+
+	BeforeEach(func() {
+		renderer = &renderfakes.FakeRenderer{}
+		renderer.KustomizeReturns([]byte(sourceManifests), nil)
+		renderer.HelmReturns([]byte(sourceManifests), nil)
+
+		existingApp = wego.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: wego.DefaultNamespace},
+			Spec: wego.ApplicationSpec{
+				URL:            "ssh://git@github.com/foo/podinfo.git",
+				Branch:         "main",
+				Path:           "./deploy",
+				SourceType:     wego.SourceTypeGit,
+				DeploymentType: wego.DeploymentTypeKustomize,
+			},
+		}
+
+		live = []unstructured.Unstructured{
+			liveObject(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: podinfo
+  namespace: apps
+  uid: 1234
+  labels:
+    kustomize.toolkit.fluxcd.io/name: podinfo
+spec:
+  replicas: 5
+  template:
+    spec:
+      containers:
+      - name: podinfo
+        image: ghcr.io/stefanprodan/podinfo:6.0.0
+        imagePullPolicy: IfNotPresent
+status:
+  readyReplicas: 5
+`),
+			liveObject(`
+apiVersion: v1
+kind: Service
+metadata:
+  name: podinfo
+  namespace: apps
+spec:
+  clusterIP: 10.0.0.1
+  ports:
+  - port: 9898
+    protocol: TCP
+`),
+			liveObject(`
+apiVersion: v1
+kind: Secret
+metadata:
+  name: old-secret
+  namespace: apps
+`),
+		}
+
+		kubeClient.GetApplicationStub = func(_ context.Context, name types.NamespacedName) (*wego.Application, error) {
+			app := existingApp
+			return &app, nil
+		}
+
+		kubeClient.GetResourceStub = func(_ context.Context, name types.NamespacedName, r kube.Resource) error {
+			switch obj := r.(type) {
+			case *kustomizev1.Kustomization:
+				obj.Name = name.Name
+				obj.Status.Snapshot = &kustomizev1.Snapshot{Entries: []kustomizev1.SnapshotEntry{
+					{Namespace: "apps", Kinds: map[string]string{"apps/v1, Kind=Deployment": "Deployment"}},
+				}}
+			case *corev1.ConfigMap:
+				obj.Name = name.Name
+				obj.Data = map[string]string{"values.yaml": "replicaCount: 2\nimage:\n  tag: 6.0.0\n"}
+			case *unstructured.Unstructured:
+				for _, l := range live {
+					if l.GetKind() == obj.GetKind() && l.GetName() == name.Name && l.GetNamespace() == name.Namespace {
+						l.DeepCopyInto(obj)
+					}
+				}
+			}
+
+			return nil
+		}
+
+		kubeClient.ListResourcesStub = func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+			if l, ok := list.(*unstructured.UnstructuredList); ok {
+				l.Items = live
+			}
+
+			return nil
+		}
+	})
+
+	diff := func() *DiffResult {
+		result, err := DiffApplication(context.Background(), kubeClient, gitClient, renderer, DiffParams{Name: "podinfo", Namespace: wego.DefaultNamespace})
+		Expect(err).NotTo(HaveOccurred())
+
+		return result
+	}
+
+	It("reports the drift of every object of the application", func() {
+		result := diff()
+
+		Expect(gitClient.CloneCallCount()).To(Equal(1))
+		_, _, url, branch := gitClient.CloneArgsForCall(0)
+		Expect(url).To(Equal("ssh://git@github.com/foo/podinfo.git"))
+		Expect(branch).To(Equal("main"))
+
+		Expect(renderer.KustomizeArgsForCall(0)).To(HaveSuffix("/deploy"))
+
+		Expect(result.Objects).To(HaveLen(4))
+
+		statuses := map[string]DriftStatus{}
+		for _, obj := range result.Objects {
+			statuses[obj.GroupVersionKind.Kind+"/"+obj.Name] = obj.Status
+		}
+
+		Expect(statuses).To(Equal(map[string]DriftStatus{
+			"ConfigMap/podinfo-config": DriftStatusMissing,
+			"Deployment/podinfo":       DriftStatusModified,
+			"Secret/old-secret":        DriftStatusExtra,
+			"Service/podinfo":          DriftStatusInSync,
+		}))
+
+		Expect(result.Summary()).To(Equal(DriftSummary{InSync: 1, Modified: 1, Missing: 1, Extra: 1}))
+		Expect(result.Summary().Drifted()).To(BeTrue())
+	})
+
+	It("reports the objects of kinds the cluster does not serve as missing", func() {
+		renderer.KustomizeReturns([]byte(sourceManifests+"---\napiVersion: flagger.app/v1beta1\nkind: Canary\nmetadata:\n  name: podinfo\n  namespace: apps\n"), nil)
+
+		getResource := kubeClient.GetResourceStub
+		kubeClient.GetResourceStub = func(ctx context.Context, name types.NamespacedName, r kube.Resource) error {
+			if obj, ok := r.(*unstructured.Unstructured); ok && obj.GetKind() == "Canary" {
+				return fmt.Errorf("error getting resource: %w", &apimeta.NoKindMatchError{GroupKind: obj.GroupVersionKind().GroupKind()})
+			}
+
+			return getResource(ctx, name, r)
+		}
+
+		result := diff()
+
+		statuses := map[string]DriftStatus{}
+		for _, obj := range result.Objects {
+			statuses[obj.GroupVersionKind.Kind+"/"+obj.Name] = obj.Status
+		}
+
+		Expect(statuses).To(HaveKeyWithValue("Canary/podinfo", DriftStatusMissing))
+	})
+
+	It("diffs the fields set by the source", func() {
+		result := diff()
+
+		deployment := result.Objects[1]
+		Expect(deployment.GroupVersionKind.Kind).To(Equal("Deployment"))
+		Expect(deployment.Diff).To(ContainSubstring("--- live/Deployment/apps/podinfo"))
+		Expect(deployment.Diff).To(ContainSubstring("+++ source/Deployment/apps/podinfo"))
+		Expect(deployment.Diff).To(ContainSubstring("-  replicas: 5\n+  replicas: 2\n"))
+		Expect(deployment.Diff).NotTo(ContainSubstring("imagePullPolicy"))
+		Expect(deployment.Diff).NotTo(ContainSubstring("readyReplicas"))
+
+		configMap := result.Objects[0]
+		Expect(configMap.Diff).To(ContainSubstring("+  color: blue"))
+	})
+
+	It("substitutes the post build variables before comparing", func() {
+		renderer.KustomizeReturns([]byte(`---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: podinfo
+  namespace: apps
+spec:
+  replicas: ${replicas}
+  template:
+    spec:
+      containers:
+      - name: podinfo
+        image: ghcr.io/stefanprodan/podinfo:${version:=6.0.0}
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: podinfo-config
+  namespace: apps
+  annotations:
+    kustomize.toolkit.fluxcd.io/substitute: disabled
+data:
+  color: ${color}
+`), nil)
+
+		existingApp.Spec.Kustomization = &wego.KustomizationSettings{
+			Substitute:     map[string]string{"replicas": "5"},
+			SubstituteFrom: []wego.SubstituteReference{{Kind: "Secret", Name: "cluster-vars"}},
+		}
+
+		kubeClient.GetSecretReturns(&corev1.Secret{Data: map[string][]byte{"replicas": []byte("3"), "color": []byte("blue")}}, nil)
+
+		result := diff()
+
+		statuses := map[string]DriftStatus{}
+		for _, obj := range result.Objects {
+			statuses[obj.GroupVersionKind.Kind+"/"+obj.Name] = obj.Status
+
+			if obj.GroupVersionKind.Kind == "ConfigMap" {
+				Expect(obj.Diff).To(ContainSubstring("color: ${color}"))
+			}
+		}
+
+		Expect(statuses).To(HaveKeyWithValue("Deployment/podinfo", DriftStatusInSync))
+
+		_, secretName := kubeClient.GetSecretArgsForCall(0)
+		Expect(secretName).To(Equal(types.NamespacedName{Name: "cluster-vars", Namespace: wego.DefaultNamespace}))
+	})
+
+	It("rejects invalid post build variable names", func() {
+		existingApp.Spec.Kustomization = &wego.KustomizationSettings{Substitute: map[string]string{"cluster-env": "dev"}}
+
+		_, err := DiffApplication(context.Background(), kubeClient, gitClient, renderer, DiffParams{Name: "podinfo", Namespace: wego.DefaultNamespace})
+		Expect(err).To(MatchError(ContainSubstring("'cluster-env' var name is invalid")))
+	})
+
+	It("renders the chart of a helm application with its values", func() {
+		existingApp.Spec.SourceType = wego.SourceTypeHelm
+		existingApp.Spec.DeploymentType = wego.DeploymentTypeHelm
+		existingApp.Spec.URL = "https://stefanprodan.github.io/podinfo"
+		existingApp.Spec.Path = "podinfo"
+		existingApp.Spec.HelmChartVersion = "6.0.0"
+		existingApp.Spec.HelmTargetNamespace = "apps"
+		existingApp.Spec.HelmValues = &apiextensionsv1.JSON{Raw: []byte(`{"image":{"tag":"6.0.1"}}`)}
+		existingApp.Spec.HelmValuesFrom = []wego.HelmValuesReference{{Kind: "ConfigMap", Name: "podinfo-values"}}
+
+		diff()
+
+		Expect(gitClient.CloneCallCount()).To(Equal(0))
+		Expect(renderer.HelmCallCount()).To(Equal(1))
+
+		opts := renderer.HelmArgsForCall(0)
+		Expect(opts.ReleaseName).To(Equal("apps-podinfo"))
+		Expect(opts.Namespace).To(Equal("apps"))
+		Expect(opts.Chart).To(Equal("podinfo"))
+		Expect(opts.RepoURL).To(Equal("https://stefanprodan.github.io/podinfo"))
+		Expect(opts.Version).To(Equal("6.0.0"))
+		Expect(string(opts.Values)).To(MatchJSON(`{"replicaCount":2,"image":{"tag":"6.0.1"}}`))
+	})
+
+	It("reports every object as in sync when nothing drifted", func() {
+		renderer.KustomizeReturns([]byte(`---
+apiVersion: v1
+kind: Service
+metadata:
+  name: podinfo
+  namespace: apps
+spec:
+  ports:
+  - port: 9898
+`), nil)
+		live = live[1:2]
+
+		result := diff()
+
+		Expect(result.Objects).To(HaveLen(1))
+		Expect(result.Summary().Drifted()).To(BeFalse())
+	})
+})
@@ -0,0 +1,246 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1beta1"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/helm"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Labels added by the kustomize-controller to the objects it applies
+const (
+	kustomizeNameKey      = "kustomize.toolkit.fluxcd.io/name"
+	kustomizeNamespaceKey = "kustomize.toolkit.fluxcd.io/namespace"
+)
+
+var ErrInvalidEventType = errors.New("invalid event type")
+
+type EventsParams struct {
+	Name      string
+	Namespace string
+	// Type keeps only the events of that type, Normal or Warning. All events are kept when empty.
+	Type string
+	// Since and Until bound the time window of the events, when set
+	Since time.Time
+	Until time.Time
+}
+
+// eventObject identifies the object an event is about
+type eventObject struct {
+	kind      string
+	namespace string
+	name      string
+}
+
+func (a *App) Events(params EventsParams) ([]corev1.Event, error) {
+	return ListEvents(a.Context, a.Kube, params)
+}
+
+// ListEvents returns the Kubernetes events of an application, of its source and automation objects and of the
+// objects reconciled by the automation, merged in a single timeline sorted by time.
+func ListEvents(ctx context.Context, kubeService kube.Kube, params EventsParams) ([]corev1.Event, error) {
+	if params.Type != "" && params.Type != corev1.EventTypeNormal && params.Type != corev1.EventTypeWarning {
+		return nil, fmt.Errorf("%w %q, expected %s or %s", ErrInvalidEventType, params.Type, corev1.EventTypeNormal, corev1.EventTypeWarning)
+	}
+
+	app, err := kubeService.GetApplication(ctx, types.NamespacedName{Name: params.Name, Namespace: params.Namespace})
+	if err != nil {
+		return nil, fmt.Errorf("could not get application %q: %w", params.Name, err)
+	}
+
+	objects, err := applicationEventObjects(ctx, kubeService, app)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaces := map[string]bool{}
+	for obj := range objects {
+		namespaces[obj.namespace] = true
+	}
+
+	events := []corev1.Event{}
+
+	for ns := range namespaces {
+		list := &corev1.EventList{}
+		if err := kubeService.ListResources(ctx, list, client.InNamespace(ns)); err != nil {
+			return nil, fmt.Errorf("could not list events of namespace %q: %w", ns, err)
+		}
+
+		for _, e := range list.Items {
+			involved := eventObject{kind: e.InvolvedObject.Kind, namespace: e.InvolvedObject.Namespace, name: e.InvolvedObject.Name}
+			if involved.namespace == "" {
+				involved.namespace = e.Namespace
+			}
+
+			if !objects[involved] || !keepEvent(e, params) {
+				continue
+			}
+
+			events = append(events, e)
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return EventTime(events[i]).Before(EventTime(events[j]))
+	})
+
+	return events, nil
+}
+
+// EventTime returns the last time an event occurred
+func EventTime(e corev1.Event) time.Time {
+	switch {
+	case !e.LastTimestamp.IsZero():
+		return e.LastTimestamp.Time
+	case !e.EventTime.IsZero():
+		return e.EventTime.Time
+	case !e.FirstTimestamp.IsZero():
+		return e.FirstTimestamp.Time
+	default:
+		return e.CreationTimestamp.Time
+	}
+}
+
+func keepEvent(e corev1.Event, params EventsParams) bool {
+	if params.Type != "" && e.Type != params.Type {
+		return false
+	}
+
+	t := EventTime(e)
+
+	if !params.Since.IsZero() && t.Before(params.Since) {
+		return false
+	}
+
+	if !params.Until.IsZero() && t.After(params.Until) {
+		return false
+	}
+
+	return true
+}
+
+// applicationEventObjects returns the objects whose events belong to the application
+func applicationEventObjects(ctx context.Context, kubeService kube.Kube, app *wego.Application) (map[eventObject]bool, error) {
+	objects := map[eventObject]bool{
+		{kind: "Application", namespace: app.Namespace, name: app.Name}: true,
+	}
+
+	sourceKind := sourcev1.GitRepositoryKind
+	if app.Spec.SourceType == wego.SourceTypeHelm {
+		sourceKind = sourcev1.HelmRepositoryKind
+	}
+
+	objects[eventObject{kind: sourceKind, namespace: app.Namespace, name: app.Name}] = true
+
+	name := types.NamespacedName{Name: app.Name, Namespace: app.Namespace}
+
+	var (
+		reconciled []unstructured.Unstructured
+		err        error
+	)
+
+	if app.Spec.DeploymentType == wego.DeploymentTypeHelm {
+		objects[eventObject{kind: helmv2.HelmReleaseKind, namespace: app.Namespace, name: app.Name}] = true
+
+		reconciled, err = helmReleaseObjects(ctx, kubeService, name)
+	} else {
+		objects[eventObject{kind: kustomizev1.KustomizationKind, namespace: app.Namespace, name: app.Name}] = true
+
+		reconciled, err = kustomizationObjects(ctx, kubeService, name)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, obj := range reconciled {
+		ns := obj.GetNamespace()
+		if ns == "" {
+			// Events about cluster scoped objects are recorded in the default namespace
+			ns = metav1.NamespaceDefault
+		}
+
+		objects[eventObject{kind: obj.GetKind(), namespace: ns, name: obj.GetName()}] = true
+	}
+
+	return objects, nil
+}
+
+// kustomizationObjects lists the objects applied by a Kustomization, using the kinds of its snapshot
+func kustomizationObjects(ctx context.Context, kubeService kube.Kube, name types.NamespacedName) ([]unstructured.Unstructured, error) {
+	kust := &kustomizev1.Kustomization{}
+	if err := kubeService.GetResource(ctx, name, kust); err != nil {
+		return nil, fmt.Errorf("could not get kustomization %s: %w", name, err)
+	}
+
+	if kust.Status.Snapshot == nil {
+		return nil, nil
+	}
+
+	labels := client.MatchingLabels{
+		kustomizeNameKey:      name.Name,
+		kustomizeNamespaceKey: name.Namespace,
+	}
+
+	result := []unstructured.Unstructured{}
+
+	list := func(gvk schema.GroupVersionKind, opts ...client.ListOption) error {
+		objects := &unstructured.UnstructuredList{}
+		objects.SetGroupVersionKind(gvk)
+
+		if err := kubeService.ListResources(ctx, objects, append(opts, labels)...); err != nil {
+			return fmt.Errorf("could not list %s objects: %w", gvk.Kind, err)
+		}
+
+		result = append(result, objects.Items...)
+
+		return nil
+	}
+
+	for ns, kinds := range kust.Status.Snapshot.NamespacedKinds() {
+		for _, gvk := range kinds {
+			if err := list(gvk, client.InNamespace(ns)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, gvk := range kust.Status.Snapshot.NonNamespacedKinds() {
+		if err := list(gvk); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// helmReleaseObjects returns the objects of the deployed revision of a HelmRelease
+func helmReleaseObjects(ctx context.Context, kubeService kube.Kube, name types.NamespacedName) ([]unstructured.Unstructured, error) {
+	hr := &helmv2.HelmRelease{}
+
+	found, err := getOptionalResource(ctx, kubeService, name, hr)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	secrets := &corev1.SecretList{}
+	if err := kubeService.ListResources(ctx, secrets, client.InNamespace(hr.GetStorageNamespace()), helm.DeployedStorageLabels(hr.GetReleaseName())); err != nil {
+		return nil, fmt.Errorf("could not list helm storage secrets: %w", err)
+	}
+
+	return helm.DeployedManifest(secrets.Items)
+}
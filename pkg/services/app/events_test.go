@@ -0,0 +1,167 @@
+package app
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1beta1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Events", func() {
+	var (
+		t0          = time.Date(2021, time.July, 1, 12, 0, 0, 0, time.UTC)
+		application *wego.Application
+		namespaces  map[string][]corev1.Event
+		objects     []unstructured.Unstructured
+		secrets     []corev1.Secret
+	)
+
+	event := func(kind, namespace, name, eventType string, minutes int) corev1.Event {
+		return corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: name + "." + kind, Namespace: namespace},
+			InvolvedObject: corev1.ObjectReference{Kind: kind, Namespace: namespace, Name: name},
+			Type:           eventType,
+			Reason:         kind + "Event",
+			LastTimestamp:  metav1.NewTime(t0.Add(time.Duration(minutes) * time.Minute)),
+		}
+	}
+
+	reasons := func(events []corev1.Event) []string {
+		result := []string{}
+		for _, e := range events {
+			result = append(result, e.Reason)
+		}
+
+		return result
+	}
+
+	BeforeEach(func() {
+		application = &wego.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-app", Namespace: "wego-system"},
+			Spec:       wego.ApplicationSpec{SourceType: wego.SourceTypeGit, DeploymentType: wego.DeploymentTypeKustomize},
+		}
+
+		deployment := unstructured.Unstructured{}
+		deployment.SetAPIVersion("apps/v1")
+		deployment.SetKind("Deployment")
+		deployment.SetName("podinfo")
+		deployment.SetNamespace("apps")
+		objects = []unstructured.Unstructured{deployment}
+		secrets = nil
+
+		namespaces = map[string][]corev1.Event{
+			"wego-system": {
+				event("Kustomization", "wego-system", "my-app", corev1.EventTypeWarning, 3),
+				event("Application", "wego-system", "my-app", corev1.EventTypeNormal, 1),
+				event("GitRepository", "wego-system", "my-app", corev1.EventTypeNormal, 2),
+				event("GitRepository", "wego-system", "other-app", corev1.EventTypeNormal, 2),
+			},
+			"apps": {
+				event("Deployment", "apps", "podinfo", corev1.EventTypeNormal, 4),
+				event("Deployment", "apps", "other", corev1.EventTypeNormal, 4),
+			},
+		}
+
+		kubeClient.GetApplicationStub = func(ctx context.Context, name types.NamespacedName) (*wego.Application, error) {
+			return application, nil
+		}
+
+		kubeClient.GetResourceStub = func(ctx context.Context, name types.NamespacedName, r kube.Resource) error {
+			switch obj := r.(type) {
+			case *kustomizev1.Kustomization:
+				obj.Name = name.Name
+				obj.Status.Snapshot = &kustomizev1.Snapshot{Entries: []kustomizev1.SnapshotEntry{
+					{Namespace: "apps", Kinds: map[string]string{"apps/v1, Kind=Deployment": "Deployment"}},
+				}}
+			case *helmv2.HelmRelease:
+				obj.Name = name.Name
+				obj.Namespace = name.Namespace
+			}
+
+			return nil
+		}
+
+		kubeClient.ListResourcesStub = func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+			listOpts := &client.ListOptions{}
+			listOpts.ApplyOptions(opts)
+
+			switch l := list.(type) {
+			case *corev1.EventList:
+				l.Items = namespaces[listOpts.Namespace]
+			case *unstructured.UnstructuredList:
+				Expect(listOpts.LabelSelector.String()).To(ContainSubstring("kustomize.toolkit.fluxcd.io/name=my-app"))
+				l.Items = objects
+			case *corev1.SecretList:
+				l.Items = secrets
+			}
+
+			return nil
+		}
+	})
+
+	It("merges the events of the application objects sorted by time", func() {
+		events, err := appSrv.Events(EventsParams{Name: "my-app", Namespace: "wego-system"})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(reasons(events)).To(Equal([]string{"ApplicationEvent", "GitRepositoryEvent", "KustomizationEvent", "DeploymentEvent"}))
+	})
+
+	It("filters the events by type and time", func() {
+		events, err := appSrv.Events(EventsParams{Name: "my-app", Namespace: "wego-system", Type: corev1.EventTypeNormal})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reasons(events)).To(Equal([]string{"ApplicationEvent", "GitRepositoryEvent", "DeploymentEvent"}))
+
+		events, err = appSrv.Events(EventsParams{
+			Name:      "my-app",
+			Namespace: "wego-system",
+			Since:     t0.Add(2 * time.Minute),
+			Until:     t0.Add(3 * time.Minute),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reasons(events)).To(Equal([]string{"GitRepositoryEvent", "KustomizationEvent"}))
+	})
+
+	It("includes the events of the objects of a helm release", func() {
+		application.Spec.SourceType = wego.SourceTypeHelm
+		application.Spec.DeploymentType = wego.DeploymentTypeHelm
+
+		release, err := json.Marshal(map[string]string{
+			"name":      "my-app",
+			"namespace": "apps",
+			"manifest":  "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: podinfo\n",
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		secrets = []corev1.Secret{{
+			ObjectMeta: metav1.ObjectMeta{Name: "sh.helm.release.v1.my-app.v1", Labels: map[string]string{"version": "1"}},
+			Data:       map[string][]byte{"release": []byte(base64.StdEncoding.EncodeToString(release))},
+		}}
+
+		namespaces["wego-system"] = append(namespaces["wego-system"],
+			event("HelmRepository", "wego-system", "my-app", corev1.EventTypeNormal, 0),
+			event("HelmRelease", "wego-system", "my-app", corev1.EventTypeNormal, 5),
+		)
+
+		events, err := appSrv.Events(EventsParams{Name: "my-app", Namespace: "wego-system"})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(reasons(events)).To(Equal([]string{"HelmRepositoryEvent", "ApplicationEvent", "DeploymentEvent", "HelmReleaseEvent"}))
+	})
+
+	It("rejects unknown event types", func() {
+		_, err := appSrv.Events(EventsParams{Name: "my-app", Namespace: "wego-system", Type: "Error"})
+		Expect(err).To(MatchError(ContainSubstring(ErrInvalidEventType.Error())))
+	})
+})
@@ -0,0 +1,218 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+)
+
+var ErrDependencyCycle = errors.New("dependency cycle")
+
+type GraphParams struct {
+	Namespace string
+}
+
+// DependencyGraph maps the applications of a namespace to the applications they depend on
+type DependencyGraph map[string][]string
+
+// NewDependencyGraph returns the dependencies between the applications
+func NewDependencyGraph(apps []wego.Application) DependencyGraph {
+	graph := DependencyGraph{}
+
+	for _, app := range apps {
+		graph[app.Name] = append([]string{}, app.Spec.DependsOn...)
+	}
+
+	return graph
+}
+
+func (a *App) Graph(params GraphParams) (DependencyGraph, error) {
+	return GetDependencyGraph(a.Context, a.Kube, params.Namespace)
+}
+
+// GetDependencyGraph returns the dependencies between the applications of a namespace
+func GetDependencyGraph(ctx context.Context, kubeService kube.Kube, namespace string) (DependencyGraph, error) {
+	apps, err := kubeService.GetApplications(ctx, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("could not list applications: %w", err)
+	}
+
+	return NewDependencyGraph(apps), nil
+}
+
+// Missing returns the applications depended on which are not in the graph, sorted by name
+func (g DependencyGraph) Missing() []string {
+	missing := map[string]bool{}
+
+	for _, deps := range g {
+		for _, dep := range deps {
+			if _, ok := g[dep]; !ok {
+				missing[dep] = true
+			}
+		}
+	}
+
+	return sortedKeys(missing)
+}
+
+// Order returns the applications sorted so that each one comes after its dependencies, by name otherwise.
+// It returns ErrDependencyCycle, naming the applications of the cycle, when there is no such order.
+func (g DependencyGraph) Order() ([]string, error) {
+	const (
+		visiting = 1
+		visited  = 2
+	)
+
+	state := map[string]int{}
+	order := []string{}
+
+	var visit func(name string, path []string) error
+
+	visit = func(name string, path []string) error {
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			start := 0
+			for i, app := range path {
+				if app == name {
+					start = i
+				}
+			}
+
+			return fmt.Errorf("%w: %s", ErrDependencyCycle, strings.Join(append(path[start:], name), " -> "))
+		}
+
+		state[name] = visiting
+
+		deps := append([]string{}, g[name]...)
+		sort.Strings(deps)
+
+		for _, dep := range deps {
+			if _, ok := g[dep]; !ok {
+				continue
+			}
+
+			if err := visit(dep, append(path, name)); err != nil {
+				return err
+			}
+		}
+
+		state[name] = visited
+		order = append(order, name)
+
+		return nil
+	}
+
+	for _, name := range g.names() {
+		if err := visit(name, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return order, nil
+}
+
+// DOT renders the graph in the Graphviz DOT language, each application pointing to its dependencies
+func (g DependencyGraph) DOT() string {
+	var sb strings.Builder
+
+	sb.WriteString("digraph applications {\n")
+
+	for _, name := range g.names() {
+		fmt.Fprintf(&sb, "  %q;\n", name)
+	}
+
+	for _, name := range g.Missing() {
+		fmt.Fprintf(&sb, "  %q [style=dashed];\n", name)
+	}
+
+	for _, name := range g.names() {
+		deps := append([]string{}, g[name]...)
+		sort.Strings(deps)
+
+		for _, dep := range deps {
+			fmt.Fprintf(&sb, "  %q -> %q;\n", name, dep)
+		}
+	}
+
+	sb.WriteString("}\n")
+
+	return sb.String()
+}
+
+func (g DependencyGraph) names() []string {
+	names := map[string]bool{}
+	for name := range g {
+		names[name] = true
+	}
+
+	return sortedKeys(names)
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := []string{}
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// validateDependencies checks that the applications an application depends on exist in its namespace,
+// are deployed the same way so that Flux can order them, and do not depend on the application in turn
+func validateDependencies(app wego.Application, apps []wego.Application) error {
+	if len(app.Spec.DependsOn) == 0 {
+		return nil
+	}
+
+	byName := map[string]wego.Application{}
+	others := []wego.Application{}
+
+	for _, other := range apps {
+		if other.Name == app.Name {
+			continue
+		}
+
+		byName[other.Name] = other
+		others = append(others, other)
+	}
+
+	for _, dep := range app.Spec.DependsOn {
+		if dep == app.Name {
+			return fmt.Errorf("application %s cannot depend on itself", app.Name)
+		}
+
+		other, ok := byName[dep]
+		if !ok {
+			return fmt.Errorf("application %s depends on application %s, which does not exist in namespace %s", app.Name, dep, app.Namespace)
+		}
+
+		if deploymentType(other) != deploymentType(app) {
+			return fmt.Errorf("application %s is deployed with %s and cannot depend on application %s, deployed with %s", app.Name, deploymentType(app), dep, deploymentType(other))
+		}
+	}
+
+	if _, err := NewDependencyGraph(append(others, app)).Order(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// deploymentType returns the deployment type of an application, defaulting to kustomize for the applications
+// created before the field existed
+func deploymentType(app wego.Application) wego.DeploymentType {
+	if app.Spec.DeploymentType == "" {
+		return wego.DeploymentTypeKustomize
+	}
+
+	return app.Spec.DeploymentType
+}
@@ -0,0 +1,100 @@
+package app
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func dependentApp(name string, deploymentType wego.DeploymentType, dependsOn ...string) wego.Application {
+	return wego.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: wego.DefaultNamespace},
+		Spec:       wego.ApplicationSpec{DeploymentType: deploymentType, DependsOn: dependsOn},
+	}
+}
+
+var _ = Describe("DependencyGraph", func() {
+	It("orders the applications after their dependencies", func() {
+		graph := NewDependencyGraph([]wego.Application{
+			dependentApp("podinfo", wego.DeploymentTypeKustomize, "redis", "ingress"),
+			dependentApp("redis", wego.DeploymentTypeKustomize, "crds"),
+			dependentApp("ingress", wego.DeploymentTypeKustomize),
+			dependentApp("crds", wego.DeploymentTypeKustomize),
+		})
+
+		order, err := graph.Order()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(order).To(Equal([]string{"crds", "ingress", "redis", "podinfo"}))
+	})
+
+	It("names the applications of a cycle", func() {
+		graph := NewDependencyGraph([]wego.Application{
+			dependentApp("a", wego.DeploymentTypeKustomize, "b"),
+			dependentApp("b", wego.DeploymentTypeKustomize, "c"),
+			dependentApp("c", wego.DeploymentTypeKustomize, "b"),
+		})
+
+		_, err := graph.Order()
+		Expect(err).To(MatchError(ErrDependencyCycle))
+		Expect(err).To(MatchError(ContainSubstring("b -> c -> b")))
+	})
+
+	It("renders the graph in DOT, the missing applications dashed", func() {
+		graph := NewDependencyGraph([]wego.Application{
+			dependentApp("podinfo", wego.DeploymentTypeKustomize, "redis", "removed"),
+			dependentApp("redis", wego.DeploymentTypeKustomize),
+		})
+
+		Expect(graph.Missing()).To(Equal([]string{"removed"}))
+		Expect(graph.DOT()).To(Equal(`digraph applications {
+  "podinfo";
+  "redis";
+  "removed" [style=dashed];
+  "podinfo" -> "redis";
+  "podinfo" -> "removed";
+}
+`))
+	})
+
+	It("lists the dependencies of the applications of a namespace", func() {
+		kubeClient.GetApplicationsReturns([]wego.Application{dependentApp("podinfo", wego.DeploymentTypeKustomize, "redis")}, nil)
+
+		graph, err := GetDependencyGraph(context.Background(), kubeClient, wego.DefaultNamespace)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(graph).To(Equal(DependencyGraph{"podinfo": {"redis"}}))
+	})
+})
+
+var _ = Describe("validateDependencies", func() {
+	var apps []wego.Application
+
+	BeforeEach(func() {
+		apps = []wego.Application{
+			dependentApp("redis", wego.DeploymentTypeKustomize, "podinfo"),
+			dependentApp("loki", wego.DeploymentTypeHelm),
+			dependentApp("ingress", ""),
+		}
+	})
+
+	It("accepts dependencies on applications deployed the same way", func() {
+		Expect(validateDependencies(dependentApp("podinfo", wego.DeploymentTypeKustomize, "ingress"), apps)).To(Succeed())
+	})
+
+	It("rejects dependencies on unknown applications", func() {
+		err := validateDependencies(dependentApp("podinfo", wego.DeploymentTypeKustomize, "postgres"), apps)
+		Expect(err).To(MatchError(ContainSubstring("application postgres, which does not exist")))
+	})
+
+	It("rejects dependencies on applications deployed another way", func() {
+		err := validateDependencies(dependentApp("podinfo", wego.DeploymentTypeKustomize, "loki"), apps)
+		Expect(err).To(MatchError(ContainSubstring("cannot depend on application loki, deployed with helm")))
+	})
+
+	It("rejects cycles", func() {
+		err := validateDependencies(dependentApp("podinfo", wego.DeploymentTypeKustomize, "redis"), apps)
+		Expect(err).To(MatchError(ErrDependencyCycle))
+	})
+})
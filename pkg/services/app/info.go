@@ -0,0 +1,124 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1beta1"
+	"github.com/fluxcd/pkg/apis/meta"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// AppInfo summarizes an application and the state of its automation
+type AppInfo struct {
+	Name                string `json:"name"`
+	Namespace           string `json:"namespace"`
+	URL                 string `json:"url"`
+	Branch              string `json:"branch,omitempty"`
+	Path                string `json:"path,omitempty"`
+	SourceType          string `json:"sourceType"`
+	DeploymentType      string `json:"deploymentType"`
+	Suspended           bool   `json:"suspended"`
+	Ready               string `json:"ready"`
+	ReadyMessage        string `json:"readyMessage,omitempty"`
+	LastAppliedRevision string `json:"lastAppliedRevision,omitempty"`
+	// LastSuccessfulReconciliation is the last time the automation became Ready
+	LastSuccessfulReconciliation *metav1.Time `json:"lastSuccessfulReconciliation,omitempty"`
+}
+
+func (a *App) GetInfo(name types.NamespacedName) (*AppInfo, error) {
+	return GetAppInfo(a.Context, a.Kube, name)
+}
+
+func (a *App) ListInfo(namespace string) ([]AppInfo, error) {
+	return ListAppInfo(a.Context, a.Kube, namespace)
+}
+
+// GetAppInfo returns a summary of an application and the state of its automation
+func GetAppInfo(ctx context.Context, kubeService kube.Kube, name types.NamespacedName) (*AppInfo, error) {
+	app, err := kubeService.GetApplication(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("could not get application %q: %w", name.Name, err)
+	}
+
+	return getAppInfo(ctx, kubeService, app)
+}
+
+// ListAppInfo returns the summary of every application of a namespace
+func ListAppInfo(ctx context.Context, kubeService kube.Kube, namespace string) ([]AppInfo, error) {
+	apps, err := kubeService.GetApplications(ctx, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("could not list applications: %w", err)
+	}
+
+	infos := []AppInfo{}
+
+	for i := range apps {
+		info, err := getAppInfo(ctx, kubeService, &apps[i])
+		if err != nil {
+			return nil, err
+		}
+
+		infos = append(infos, *info)
+	}
+
+	return infos, nil
+}
+
+func getAppInfo(ctx context.Context, kubeService kube.Kube, app *wego.Application) (*AppInfo, error) {
+	info := &AppInfo{
+		Name:           app.Name,
+		Namespace:      app.Namespace,
+		URL:            app.Spec.URL,
+		Branch:         app.Spec.Branch,
+		Path:           app.Spec.Path,
+		SourceType:     string(app.Spec.SourceType),
+		DeploymentType: string(app.Spec.DeploymentType),
+		Ready:          string(metav1.ConditionUnknown),
+	}
+
+	// Apps created before those fields existed use the defaults of the CLI
+	if info.SourceType == "" {
+		info.SourceType = string(wego.SourceTypeGit)
+	}
+
+	if info.DeploymentType == "" {
+		info.DeploymentType = string(wego.DeploymentTypeKustomize)
+	}
+
+	var automation kube.Resource = &kustomizev1.Kustomization{}
+	if info.DeploymentType == string(wego.DeploymentTypeHelm) {
+		automation = &helmv2.HelmRelease{}
+	}
+
+	found, err := getOptionalResource(ctx, kubeService, types.NamespacedName{Name: app.Name, Namespace: app.Namespace}, automation)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		info.ReadyMessage = fmt.Sprintf("%s not found", kindOf(automation))
+		return info, nil
+	}
+
+	info.Suspended = isSuspended(automation)
+	info.LastAppliedRevision = revisionOf(automation)
+
+	_, conditions := reconcileStatus(automation)
+
+	if ready := apimeta.FindStatusCondition(conditions, meta.ReadyCondition); ready != nil {
+		info.Ready = string(ready.Status)
+		info.ReadyMessage = ready.Message
+
+		if ready.Status == metav1.ConditionTrue {
+			info.LastSuccessfulReconciliation = &ready.LastTransitionTime
+		}
+	}
+
+	return info, nil
+}
@@ -0,0 +1,103 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1beta1"
+	"github.com/fluxcd/pkg/apis/meta"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("Info", func() {
+	var (
+		readyAt = metav1.NewTime(time.Date(2021, time.July, 1, 12, 0, 0, 0, time.UTC))
+		apps    []wego.Application
+	)
+
+	BeforeEach(func() {
+		apps = []wego.Application{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: "wego-system"},
+				Spec: wego.ApplicationSpec{
+					URL:    "ssh://git@github.com/example/podinfo",
+					Branch: "main",
+					Path:   "./k8s",
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-chart", Namespace: "wego-system"},
+				Spec: wego.ApplicationSpec{
+					URL:            "https://charts.example.com",
+					Path:           "my-chart",
+					SourceType:     wego.SourceTypeHelm,
+					DeploymentType: wego.DeploymentTypeHelm,
+				},
+			},
+		}
+
+		kubeClient.GetApplicationStub = func(ctx context.Context, name types.NamespacedName) (*wego.Application, error) {
+			return &apps[0], nil
+		}
+
+		kubeClient.GetApplicationsStub = func(ctx context.Context, namespace string) ([]wego.Application, error) {
+			return apps, nil
+		}
+
+		kubeClient.GetResourceStub = func(ctx context.Context, name types.NamespacedName, r kube.Resource) error {
+			switch obj := r.(type) {
+			case *kustomizev1.Kustomization:
+				obj.Name = name.Name
+				obj.Spec.Suspend = true
+				obj.Status.LastAppliedRevision = "main/abc123"
+				obj.Status.Conditions = []metav1.Condition{{
+					Type:               meta.ReadyCondition,
+					Status:             metav1.ConditionTrue,
+					Message:            "Applied revision: main/abc123",
+					LastTransitionTime: readyAt,
+				}}
+			case *helmv2.HelmRelease:
+				// Not found, the kube service leaves it empty
+			}
+
+			return nil
+		}
+	})
+
+	It("summarizes an application", func() {
+		info, err := appSrv.GetInfo(types.NamespacedName{Name: "podinfo", Namespace: "wego-system"})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(info).To(Equal(&AppInfo{
+			Name:                         "podinfo",
+			Namespace:                    "wego-system",
+			URL:                          "ssh://git@github.com/example/podinfo",
+			Branch:                       "main",
+			Path:                         "./k8s",
+			SourceType:                   string(wego.SourceTypeGit),
+			DeploymentType:               string(wego.DeploymentTypeKustomize),
+			Suspended:                    true,
+			Ready:                        "True",
+			ReadyMessage:                 "Applied revision: main/abc123",
+			LastAppliedRevision:          "main/abc123",
+			LastSuccessfulReconciliation: &readyAt,
+		}))
+	})
+
+	It("lists the applications of a namespace", func() {
+		infos, err := appSrv.ListInfo("wego-system")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(infos).To(HaveLen(2))
+		Expect(infos[1].Name).To(Equal("my-chart"))
+		Expect(infos[1].DeploymentType).To(Equal(string(wego.DeploymentTypeHelm)))
+		Expect(infos[1].Ready).To(Equal("Unknown"))
+		Expect(infos[1].ReadyMessage).To(Equal("HelmRelease not found"))
+	})
+})
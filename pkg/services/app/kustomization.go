@@ -0,0 +1,259 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1beta1"
+	"github.com/fluxcd/pkg/apis/meta"
+	"github.com/fluxcd/pkg/runtime/dependency"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/flux"
+	"github.com/weaveworks/weave-gitops/pkg/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// KustomizationParams holds the settings of the Kustomization of an app given on the command line.
+// Empty fields leave the current setting untouched, the lists replacing the current ones when given.
+type KustomizationParams struct {
+	TargetNamespace string
+	Prune           *bool
+	Interval        time.Duration
+	// HealthChecks are given as <kind>/<name>[.<namespace>]
+	HealthChecks []string
+	// DependsOn are given as <name> or <namespace>/<name>
+	DependsOn []string
+	// Substitute are given as <variable>=<value>
+	Substitute []string
+	// SubstituteFrom are given as <configmap|secret>/<name>
+	SubstituteFrom []string
+}
+
+// healthCheckAPIVersions are the API versions of the kinds of objects health checks are accepted for
+var healthCheckAPIVersions = map[string]string{
+	"Deployment":                  "apps/v1",
+	"DaemonSet":                   "apps/v1",
+	"StatefulSet":                 "apps/v1",
+	helmv2.HelmReleaseKind:        helmv2.GroupVersion.String(),
+	kustomizev1.KustomizationKind: kustomizev1.GroupVersion.String(),
+}
+
+// IsSet tells whether any setting of the Kustomization is given
+func (p KustomizationParams) IsSet() bool {
+	return p.TargetNamespace != "" || p.Prune != nil || p.Interval != 0 || len(p.HealthChecks) > 0 ||
+		len(p.DependsOn) > 0 || len(p.Substitute) > 0 || len(p.SubstituteFrom) > 0
+}
+
+// setKustomizationSettings records the Kustomization settings given on the command line on the spec of an app
+func setKustomizationSettings(app *wego.Application, params KustomizationParams) error {
+	if !params.IsSet() {
+		return nil
+	}
+
+	if app.Spec.DeploymentType != wego.DeploymentTypeKustomize {
+		return fmt.Errorf("kustomization settings can only be used with applications deployed with kustomize")
+	}
+
+	settings := &wego.KustomizationSettings{}
+	if app.Spec.Kustomization != nil {
+		settings = app.Spec.Kustomization.DeepCopy()
+	}
+
+	if params.TargetNamespace != "" {
+		if err := utils.ValidateNamespace(params.TargetNamespace); err != nil {
+			return err
+		}
+
+		settings.TargetNamespace = params.TargetNamespace
+	}
+
+	if params.Prune != nil {
+		prune := *params.Prune
+		settings.Prune = &prune
+	}
+
+	if params.Interval != 0 {
+		if params.Interval < 0 {
+			return fmt.Errorf("invalid interval %s, must be positive", params.Interval)
+		}
+
+		settings.Interval = &metav1.Duration{Duration: params.Interval}
+	}
+
+	if len(params.HealthChecks) > 0 {
+		healthChecks, err := parseHealthChecks(params.HealthChecks)
+		if err != nil {
+			return err
+		}
+
+		settings.HealthChecks = healthChecks
+	}
+
+	if len(params.DependsOn) > 0 {
+		settings.DependsOn = parseDependsOn(params.DependsOn)
+	}
+
+	if len(params.Substitute) > 0 {
+		substitute, err := parseSubstitute(params.Substitute)
+		if err != nil {
+			return err
+		}
+
+		settings.Substitute = substitute
+	}
+
+	if len(params.SubstituteFrom) > 0 {
+		refs, err := parseSubstituteFrom(params.SubstituteFrom)
+		if err != nil {
+			return err
+		}
+
+		settings.SubstituteFrom = refs
+	}
+
+	app.Spec.Kustomization = settings
+
+	return nil
+}
+
+// parseHealthChecks reads the objects given as <kind>/<name>[.<namespace>] as flux create kustomization does
+func parseHealthChecks(healthChecks []string) ([]wego.KustomizationHealthCheck, error) {
+	result := []wego.KustomizationHealthCheck{}
+
+	for _, healthCheck := range healthChecks {
+		parts := strings.SplitN(healthCheck, "/", 2)
+		if len(parts) != 2 || parts[1] == "" {
+			return nil, fmt.Errorf("invalid health check %q, must be of the form <kind>/<name>[.<namespace>]", healthCheck)
+		}
+
+		apiVersion, ok := healthCheckAPIVersions[parts[0]]
+		if !ok {
+			return nil, fmt.Errorf("invalid health check %q, the kind can be Deployment, DaemonSet, StatefulSet, HelmRelease or Kustomization", healthCheck)
+		}
+
+		check := wego.KustomizationHealthCheck{APIVersion: apiVersion, Kind: parts[0], Name: parts[1]}
+
+		if i := strings.LastIndex(check.Name, "."); i >= 0 {
+			check.Name, check.Namespace = check.Name[:i], check.Name[i+1:]
+		}
+
+		result = append(result, check)
+	}
+
+	return result, nil
+}
+
+// parseDependsOn reads the Kustomizations given as <name> or <namespace>/<name>
+func parseDependsOn(dependsOn []string) []wego.KustomizationDependency {
+	result := []wego.KustomizationDependency{}
+
+	for _, dep := range dependsOn {
+		parts := strings.SplitN(dep, "/", 2)
+		if len(parts) == 2 {
+			result = append(result, wego.KustomizationDependency{Namespace: parts[0], Name: parts[1]})
+		} else {
+			result = append(result, wego.KustomizationDependency{Name: dep})
+		}
+	}
+
+	return result
+}
+
+// parseSubstitute reads the post build variables given as <variable>=<value>
+func parseSubstitute(substitute []string) (map[string]string, error) {
+	result := map[string]string{}
+
+	for _, variable := range substitute {
+		parts := strings.SplitN(variable, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid variable %q, must be of the form <variable>=<value>", variable)
+		}
+
+		result[parts[0]] = parts[1]
+	}
+
+	return result, nil
+}
+
+// parseSubstituteFrom reads the objects holding post build variables given as <configmap|secret>/<name>
+func parseSubstituteFrom(refs []string) ([]wego.SubstituteReference, error) {
+	result := []wego.SubstituteReference{}
+
+	for _, ref := range refs {
+		parts := strings.SplitN(ref, "/", 2)
+		if len(parts) != 2 || parts[1] == "" {
+			return nil, fmt.Errorf("invalid variables reference %q, must be of the form <configmap|secret>/<name>", ref)
+		}
+
+		switch strings.ToLower(parts[0]) {
+		case valuesFromConfigMap:
+			result = append(result, wego.SubstituteReference{Kind: "ConfigMap", Name: parts[1]})
+		case valuesFromSecret:
+			result = append(result, wego.SubstituteReference{Kind: "Secret", Name: parts[1]})
+		default:
+			return nil, fmt.Errorf("invalid variables reference %q, variables can be read from a configmap or a secret", ref)
+		}
+	}
+
+	return result, nil
+}
+
+// kustomizationOptions returns the settings of the Kustomization of an app
+func kustomizationOptions(info *AppResourceInfo) flux.KustomizationOptions {
+	opts := flux.KustomizationOptions{DependsOn: appDependencies(info)}
+
+	settings := info.Spec.Kustomization
+	if settings == nil {
+		return opts
+	}
+
+	opts.TargetNamespace = settings.TargetNamespace
+	opts.Prune = settings.Prune
+
+	if settings.Interval != nil {
+		opts.Interval = settings.Interval.Duration
+	}
+
+	for _, check := range settings.HealthChecks {
+		opts.HealthChecks = append(opts.HealthChecks, meta.NamespacedObjectKindReference{
+			APIVersion: check.APIVersion,
+			Kind:       check.Kind,
+			Name:       check.Name,
+			Namespace:  check.Namespace,
+		})
+	}
+
+	for _, dep := range settings.DependsOn {
+		opts.DependsOn = append(opts.DependsOn, dependency.CrossNamespaceDependencyReference{
+			Name:      dep.Name,
+			Namespace: dep.Namespace,
+		})
+	}
+
+	if len(settings.Substitute) > 0 || len(settings.SubstituteFrom) > 0 {
+		opts.PostBuild = &kustomizev1.PostBuild{Substitute: settings.Substitute}
+
+		for _, ref := range settings.SubstituteFrom {
+			opts.PostBuild.SubstituteFrom = append(opts.PostBuild.SubstituteFrom, kustomizev1.SubstituteReference{
+				Kind: ref.Kind,
+				Name: ref.Name,
+			})
+		}
+	}
+
+	return opts
+}
+
+// appDependencies returns the Kustomizations or HelmReleases of the applications an app depends on,
+// which are named after the applications
+func appDependencies(info *AppResourceInfo) []dependency.CrossNamespaceDependencyReference {
+	var deps []dependency.CrossNamespaceDependencyReference
+
+	for _, name := range info.Spec.DependsOn {
+		deps = append(deps, dependency.CrossNamespaceDependencyReference{Name: name})
+	}
+
+	return deps
+}
@@ -0,0 +1,89 @@
+package app
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Kustomization settings", func() {
+	var application wego.Application
+
+	BeforeEach(func() {
+		application = wego.Application{Spec: wego.ApplicationSpec{DeploymentType: wego.DeploymentTypeKustomize}}
+	})
+
+	It("replaces the given settings and keeps the others", func() {
+		prune := false
+		application.Spec.Kustomization = &wego.KustomizationSettings{
+			TargetNamespace: "podinfo",
+			DependsOn:       []wego.KustomizationDependency{{Name: "old"}},
+		}
+
+		Expect(setKustomizationSettings(&application, KustomizationParams{
+			Prune:     &prune,
+			Interval:  5 * time.Minute,
+			DependsOn: []string{"infrastructure", "flux-system/crds"},
+		})).To(Succeed())
+
+		settings := application.Spec.Kustomization
+		Expect(settings.TargetNamespace).To(Equal("podinfo"))
+		Expect(*settings.Prune).To(BeFalse())
+		Expect(settings.Interval).To(Equal(&metav1.Duration{Duration: 5 * time.Minute}))
+		Expect(settings.DependsOn).To(Equal([]wego.KustomizationDependency{
+			{Name: "infrastructure"},
+			{Namespace: "flux-system", Name: "crds"},
+		}))
+	})
+
+	It("leaves the spec untouched when no setting is given", func() {
+		Expect(setKustomizationSettings(&application, KustomizationParams{})).To(Succeed())
+		Expect(application.Spec.Kustomization).To(BeNil())
+	})
+
+	It("parses the health checks", func() {
+		checks, err := parseHealthChecks([]string{"Deployment/podinfo.podinfo", "HelmRelease/redis"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(checks).To(Equal([]wego.KustomizationHealthCheck{
+			{APIVersion: "apps/v1", Kind: "Deployment", Name: "podinfo", Namespace: "podinfo"},
+			{APIVersion: "helm.toolkit.fluxcd.io/v2beta1", Kind: "HelmRelease", Name: "redis"},
+		}))
+	})
+
+	It("rejects health checks of unknown kinds", func() {
+		_, err := parseHealthChecks([]string{"Pod/podinfo"})
+		Expect(err).To(MatchError(ContainSubstring("the kind can be Deployment")))
+	})
+
+	It("parses the variables and their references", func() {
+		substitute, err := parseSubstitute([]string{"cluster_env=dev", "region=eu=west"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(substitute).To(Equal(map[string]string{"cluster_env": "dev", "region": "eu=west"}))
+
+		refs, err := parseSubstituteFrom([]string{"configmap/cluster-vars", "Secret/cluster-secrets"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(refs).To(Equal([]wego.SubstituteReference{
+			{Kind: "ConfigMap", Name: "cluster-vars"},
+			{Kind: "Secret", Name: "cluster-secrets"},
+		}))
+
+		_, err = parseSubstituteFrom([]string{"bucket/vars"})
+		Expect(err).To(MatchError(ContainSubstring("variables can be read from a configmap or a secret")))
+	})
+
+	It("converts the settings to kustomization options", func() {
+		application.Spec.Kustomization = &wego.KustomizationSettings{
+			Interval:       &metav1.Duration{Duration: time.Hour},
+			SubstituteFrom: []wego.SubstituteReference{{Kind: "Secret", Name: "cluster-secrets"}},
+		}
+
+		opts := kustomizationOptions(getAppResourceInfo(application, "test-cluster"))
+		Expect(opts.Interval).To(Equal(time.Hour))
+		Expect(opts.Prune).To(BeNil())
+		Expect(opts.PostBuild.SubstituteFrom).To(HaveLen(1))
+		Expect(opts.PostBuild.SubstituteFrom[0].Name).To(Equal("cluster-secrets"))
+	})
+})
@@ -0,0 +1,224 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/weaveworks/weave-gitops/pkg/render"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// AddPreview holds the changes adding an application makes to its repositories and to the cluster
+type AddPreview struct {
+	// RepoURL is the repository the files are written to, on Branch.
+	// Both are empty when the automation of the application is only stored in the cluster
+	RepoURL string
+	Branch  string
+	Files   []PreviewFile
+	// Objects are the objects applied to the cluster
+	Objects []unstructured.Unstructured
+}
+
+type PreviewFile struct {
+	Path    string
+	Content []byte
+	// Created is set when the file does not exist on the branch
+	Created bool
+	// Diff is a unified diff of the file on the branch and the file written, empty when the file is unchanged
+	Diff string
+}
+
+// PreviewAdd returns the files and objects Add would write and apply for the parameters, without making any change.
+// The repository the files are written to is cloned to compare them with the current contents of the branch.
+func (a *App) PreviewAdd(params AddParams) (*AddPreview, error) {
+	params, info, appHash, err := a.prepareAdd(params)
+	if err != nil {
+		return nil, err
+	}
+
+	secretRef, err := a.appSecretRef(info)
+	if err != nil {
+		return nil, err
+	}
+
+	source, appGoat, appSpec, err := a.generateAppManifests(info, secretRef, appHash)
+	if err != nil {
+		return nil, fmt.Errorf("could not generate application GitOps Automation manifests: %w", err)
+	}
+
+	preview := &AddPreview{}
+
+	var manifests [][]byte
+
+	switch info.configMode() {
+	case ConfigModeClusterOnly:
+		manifests = [][]byte{source, appGoat, appSpec}
+	case ConfigModeUserRepo:
+		appDirGoat, targetDirGoat, err := a.generateAppWegoManifests(info)
+		if err != nil {
+			return nil, fmt.Errorf("could not create GitOps automation for .wego directory: %w", err)
+		}
+
+		preview.RepoURL = info.Spec.URL
+		preview.Branch = info.Spec.Branch
+
+		// Without --auto-merge the files are pushed to a pull request against the default branch
+		if !params.AutoMerge {
+			if preview.Branch, err = a.GitProvider.GetDefaultBranch(info.Spec.URL); err != nil {
+				return nil, err
+			}
+		}
+
+		manifests = [][]byte{source, appDirGoat, targetDirGoat}
+	default:
+		configBranch, err := a.GitProvider.GetDefaultBranch(info.Spec.ConfigURL)
+		if err != nil {
+			return nil, fmt.Errorf("could not determine default branch for config repository: %w", err)
+		}
+
+		extRepoMan, err := a.generateExternalRepoManifests(info, configBranch)
+		if err != nil {
+			return nil, fmt.Errorf("could not generate target GitOps Automation manifests: %w", err)
+		}
+
+		preview.RepoURL = info.Spec.ConfigURL
+		preview.Branch = configBranch
+
+		manifests = [][]byte{extRepoMan.source, extRepoMan.target, extRepoMan.appDir}
+	}
+
+	if preview.RepoURL != "" {
+		repoDir := params.Dir
+
+		if repoDir == "" || info.configMode() != ConfigModeUserRepo {
+			remover, dir, err := a.cloneBranch(info, preview.RepoURL, preview.Branch)
+			if err != nil {
+				return nil, err
+			}
+			defer remover()
+
+			repoDir = dir
+		}
+
+		files, err := previewFiles(repoDir, appManifestFiles(info, appSpec, source, appGoat))
+		if err != nil {
+			return nil, err
+		}
+
+		preview.Files = files
+	}
+
+	for _, manifest := range manifests {
+		objects, err := render.Objects(manifest)
+		if err != nil {
+			return nil, err
+		}
+
+		preview.Objects = append(preview.Objects, objects...)
+	}
+
+	return preview, nil
+}
+
+// Tree returns the paths of the files of the preview as a directory tree
+func (p *AddPreview) Tree() string {
+	paths := []string{}
+	for _, file := range p.Files {
+		paths = append(paths, file.Path)
+	}
+
+	sort.Strings(paths)
+
+	var tree bytes.Buffer
+
+	printed := map[string]bool{}
+
+	for _, path := range paths {
+		parts := strings.Split(filepath.ToSlash(path), "/")
+
+		for i, part := range parts {
+			prefix := strings.Join(parts[:i+1], "/")
+			if printed[prefix] {
+				continue
+			}
+
+			printed[prefix] = true
+
+			name := part
+			if i < len(parts)-1 {
+				name += "/"
+			}
+
+			tree.WriteString(strings.Repeat("    ", i) + name + "\n")
+		}
+	}
+
+	return tree.String()
+}
+
+// cloneBranch clones a branch of a repository to a temp. directory, returning the directory and a func removing it
+func (a *App) cloneBranch(info *AppResourceInfo, repoURL string, branch string) (func(), string, error) {
+	repoDir, err := ioutil.TempDir("", "user-repo-")
+	if err != nil {
+		return nil, "", fmt.Errorf("failed creating temp. directory to clone repo: %w", err)
+	}
+
+	a.Logger.Actionf("Cloning %s", repoURL)
+
+	if _, err := a.ConfigGit.Clone(context.Background(), repoDir, info.gitURL(repoURL), branch); err != nil {
+		os.RemoveAll(repoDir)
+		return nil, "", fmt.Errorf("failed cloning repo: %s: %w", repoURL, err)
+	}
+
+	return func() {
+		os.RemoveAll(repoDir)
+	}, repoDir, nil
+}
+
+// previewFiles compares the files with their current contents in a repository directory
+func previewFiles(repoDir string, files []gitprovider.CommitFile) ([]PreviewFile, error) {
+	previews := []PreviewFile{}
+
+	for _, file := range files {
+		current, err := ioutil.ReadFile(filepath.Join(repoDir, *file.Path))
+		if err != nil && !os.IsNotExist(err) {
+			return nil, fmt.Errorf("could not read %s: %w", *file.Path, err)
+		}
+
+		preview := PreviewFile{
+			Path:    *file.Path,
+			Content: []byte(*file.Content),
+			Created: os.IsNotExist(err),
+		}
+
+		fromFile := "a/" + *file.Path
+		if preview.Created {
+			fromFile = "/dev/null"
+		}
+
+		if string(current) != *file.Content {
+			preview.Diff, err = difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+				A:        difflib.SplitLines(string(current)),
+				B:        difflib.SplitLines(*file.Content),
+				FromFile: fromFile,
+				ToFile:   "b/" + *file.Path,
+				Context:  3,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("could not diff %s: %w", *file.Path, err)
+			}
+		}
+
+		previews = append(previews, preview)
+	}
+
+	return previews, nil
+}
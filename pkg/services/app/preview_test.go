@@ -0,0 +1,136 @@
+package app
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/pkg/flux"
+)
+
+const previewKustomization = `---
+apiVersion: kustomize.toolkit.fluxcd.io/v1beta1
+kind: Kustomization
+metadata:
+  name: bar
+  namespace: wego-system
+spec:
+  path: ./kustomize
+`
+
+var _ = Describe("PreviewAdd", func() {
+	var repoFiles map[string]string
+
+	BeforeEach(func() {
+		addParams = AddParams{
+			Url:            "https://github.com/foo/bar",
+			Path:           "./kustomize",
+			Branch:         "main",
+			DeploymentType: "kustomize",
+			Namespace:      "wego-system",
+			AppConfigUrl:   "https://github.com/foo/config",
+		}
+
+		repoFiles = map[string]string{}
+
+		gitProviders.GetDefaultBranchReturns("main", nil)
+		fluxClient.CreateSourceGitReturns([]byte(stagingGitSource), nil)
+		fluxClient.CreateKustomizationStub = func(name, _, _, _ string, _ flux.KustomizationOptions) ([]byte, error) {
+			return []byte(previewKustomization), nil
+		}
+
+		gitClient.CloneStub = func(_ context.Context, dir, _, _ string) (bool, error) {
+			for path, content := range repoFiles {
+				fullPath := filepath.Join(dir, path)
+				if err := os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
+					return false, err
+				}
+
+				if err := ioutil.WriteFile(fullPath, []byte(content), 0644); err != nil {
+					return false, err
+				}
+			}
+
+			return true, nil
+		}
+	})
+
+	It("lists the files written to the config repository and the objects applied", func() {
+		preview, err := appSrv.PreviewAdd(addParams)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(gitClient.CloneCallCount()).To(Equal(1))
+		_, _, url, branch := gitClient.CloneArgsForCall(0)
+		Expect(url).To(Equal("ssh://git@github.com/foo/config.git"))
+		Expect(branch).To(Equal("main"))
+
+		Expect(preview.RepoURL).To(Equal("ssh://git@github.com/foo/config.git"))
+		Expect(preview.Files).To(HaveLen(3))
+		Expect(preview.Files[0].Path).To(Equal("apps/bar/app.yaml"))
+		Expect(preview.Files[1].Path).To(Equal("targets/test-cluster/bar/bar-gitops-source.yaml"))
+		Expect(string(preview.Files[1].Content)).To(Equal(stagingGitSource))
+		Expect(preview.Files[2].Path).To(Equal("targets/test-cluster/bar/bar-gitops-deploy.yaml"))
+
+		for _, file := range preview.Files {
+			Expect(file.Created).To(BeTrue())
+			Expect(file.Diff).To(HavePrefix("--- /dev/null\n+++ b/" + file.Path))
+		}
+
+		Expect(preview.Tree()).To(Equal(`apps/
+    bar/
+        app.yaml
+targets/
+    test-cluster/
+        bar/
+            bar-gitops-deploy.yaml
+            bar-gitops-source.yaml
+`))
+
+		Expect(preview.Objects).To(HaveLen(3))
+		Expect(preview.Objects[0].GetKind()).To(Equal("GitRepository"))
+		Expect(preview.Objects[1].GetKind()).To(Equal("Kustomization"))
+	})
+
+	It("diffs the files against the contents of the branch", func() {
+		repoFiles["targets/test-cluster/bar/bar-gitops-source.yaml"] = prodGitSource
+		repoFiles["targets/test-cluster/bar/bar-gitops-deploy.yaml"] = previewKustomization
+
+		preview, err := appSrv.PreviewAdd(addParams)
+		Expect(err).NotTo(HaveOccurred())
+
+		source := preview.Files[1]
+		Expect(source.Created).To(BeFalse())
+		Expect(source.Diff).To(ContainSubstring("--- a/targets/test-cluster/bar/bar-gitops-source.yaml"))
+		Expect(source.Diff).To(ContainSubstring("-    branch: main\n+    commit: 1a2b3c\n"))
+
+		deploy := preview.Files[2]
+		Expect(deploy.Created).To(BeFalse())
+		Expect(deploy.Diff).To(BeEmpty())
+	})
+
+	It("makes no change to the repository or the cluster", func() {
+		_, err := appSrv.PreviewAdd(addParams)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(gitClient.WriteCallCount()).To(Equal(0))
+		Expect(gitClient.CommitCallCount()).To(Equal(0))
+		Expect(gitProviders.CreatePullRequestToUserRepoCallCount()).To(Equal(0))
+		Expect(kubeClient.ApplyCallCount()).To(Equal(0))
+	})
+
+	It("only lists the objects applied when the automation is only stored in the cluster", func() {
+		addParams.AppConfigUrl = "NONE"
+
+		preview, err := appSrv.PreviewAdd(addParams)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(gitClient.CloneCallCount()).To(Equal(0))
+		Expect(preview.RepoURL).To(BeEmpty())
+		Expect(preview.Files).To(BeEmpty())
+		Expect(preview.Objects).To(HaveLen(3))
+		Expect(preview.Objects[2].GetKind()).To(Equal("Application"))
+	})
+})
@@ -0,0 +1,257 @@
+package app
+
+import (
+	"bytes"
+	"crypto/md5"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
+	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/yaml"
+)
+
+type PromoteParams struct {
+	Name      string
+	Namespace string
+	// From and To are environments of the app, To coming right after From
+	From string
+	To   string
+}
+
+// The fields of the GitOps automation pinning what an environment deploys
+var (
+	gitRevisionField  = []string{"spec", "ref"}
+	chartVersionField = []string{"spec", "chart", "spec", "version"}
+)
+
+// Promote copies the git revision or the chart version deployed to an environment of an app to the next
+// environment, through a pull request changing the GitOps automation of the next environment in the config repository
+func (a *App) Promote(params PromoteParams) error {
+	ctx := a.Context
+
+	app, err := a.Kube.GetApplication(ctx, types.NamespacedName{Name: params.Name, Namespace: params.Namespace})
+	if err != nil {
+		return fmt.Errorf("could not get application %q: %w", params.Name, err)
+	}
+
+	if err := checkPromotion(app.Spec.Environments, params.From, params.To); err != nil {
+		return fmt.Errorf("cannot promote application %s: %w", params.Name, err)
+	}
+
+	clusterName, err := a.Kube.GetClusterName(ctx)
+	if err != nil {
+		return err
+	}
+
+	info := getAppResourceInfo(*app, clusterName)
+
+	repoURL := info.Spec.ConfigURL
+
+	switch info.configMode() {
+	case ConfigModeClusterOnly:
+		return fmt.Errorf("cannot promote application %s, its automation is only stored in the cluster", params.Name)
+	case ConfigModeUserRepo:
+		repoURL = info.Spec.URL
+	}
+
+	normalizedURL, err := gitproviders.NewNormalizedRepoURL(repoURL)
+	if err != nil {
+		return fmt.Errorf("error normalizing url: %w", err)
+	}
+
+	branch, err := a.GitProvider.GetDefaultBranch(normalizedURL.String())
+	if err != nil {
+		return err
+	}
+
+	repoDir, err := ioutil.TempDir("", "config-repo-")
+	if err != nil {
+		return fmt.Errorf("failed creating temp. directory to clone repo: %w", err)
+	}
+	defer os.RemoveAll(repoDir)
+
+	a.Logger.Actionf("Cloning %s", repoURL)
+
+	if _, err := a.ConfigGit.Clone(ctx, repoDir, info.gitURL(repoURL), branch); err != nil {
+		return fmt.Errorf("failed cloning config repo: %s: %w", repoURL, err)
+	}
+
+	files, err := promotedFiles(repoDir, info.forTarget(params.From), info.forTarget(params.To))
+	if err != nil {
+		return err
+	}
+
+	if len(files) == 0 {
+		a.Logger.Successf("Environment %s of app %s already deploys the same revision as %s", params.To, params.Name, params.From)
+		return nil
+	}
+
+	title := fmt.Sprintf("gitops app promote %s from %s to %s", params.Name, params.From, params.To)
+	description := fmt.Sprintf("Promoted %s from %s to %s", params.Name, params.From, params.To)
+
+	contents := [][]byte{}
+	for _, file := range files {
+		contents = append(contents, []byte(*file.Content))
+	}
+
+	newBranch := fmt.Sprintf("%s-promote-%s-%x", info.getAppHash(), params.To, md5.Sum(bytes.Join(contents, nil)))
+
+	return a.openPullRequest(repoURL, newBranch, title, description, files)
+}
+
+// checkPromotion checks that the environments are environments of the app, the second one coming right after the first one
+func checkPromotion(environments []string, from, to string) error {
+	if len(environments) == 0 {
+		return fmt.Errorf("no environments are defined, set them with --environments")
+	}
+
+	for i := 0; i < len(environments)-1; i++ {
+		if environments[i] == from {
+			if environments[i+1] != to {
+				return fmt.Errorf("%s is promoted to %s, the environments being %s", from, environments[i+1], strings.Join(environments, ", "))
+			}
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%s is not an environment promoted to another one, the environments being %s", from, strings.Join(environments, ", "))
+}
+
+// validateEnvironments checks that the environments of an app are distinct targets of its config repository
+func validateEnvironments(environments []string, configURL string) error {
+	if len(environments) == 0 {
+		return nil
+	}
+
+	if strings.ToUpper(configURL) == string(ConfigTypeNone) {
+		return fmt.Errorf("--environments cannot be used with applications whose automation is only stored in the cluster")
+	}
+
+	seen := map[string]bool{}
+
+	for _, env := range environments {
+		if env == "" || strings.Contains(env, "/") {
+			return fmt.Errorf("invalid environment %q, must be the name of a target of the config repository", env)
+		}
+
+		if seen[env] {
+			return fmt.Errorf("environment %s is given more than once", env)
+		}
+
+		seen[env] = true
+	}
+
+	return nil
+}
+
+// promotedFiles returns the GitOps automation files of the next environment deploying what the environment deploys.
+// The automation is copied when the app is not deployed to the next environment yet, otherwise only the pinned revision
+// of the git source and the chart version are. No files are returned when the next environment is up to date.
+func promotedFiles(repoDir string, from, to *AppResourceInfo) ([]gitprovider.CommitFile, error) {
+	fromSource, err := ioutil.ReadFile(filepath.Join(repoDir, from.appAutomationSourcePath()))
+	if err != nil {
+		return nil, fmt.Errorf("could not read the automation of environment %s: %w", from.targetName, err)
+	}
+
+	fromDeploy, err := ioutil.ReadFile(filepath.Join(repoDir, from.appAutomationDeployPath()))
+	if err != nil {
+		return nil, fmt.Errorf("could not read the automation of environment %s: %w", from.targetName, err)
+	}
+
+	toSource, sourceErr := ioutil.ReadFile(filepath.Join(repoDir, to.appAutomationSourcePath()))
+	toDeploy, deployErr := ioutil.ReadFile(filepath.Join(repoDir, to.appAutomationDeployPath()))
+
+	if os.IsNotExist(sourceErr) || os.IsNotExist(deployErr) {
+		return []gitprovider.CommitFile{
+			commitFile(to.appAutomationSourcePath(), fromSource),
+			commitFile(to.appAutomationDeployPath(), fromDeploy),
+		}, nil
+	}
+
+	for _, err := range []error{sourceErr, deployErr} {
+		if err != nil {
+			return nil, fmt.Errorf("could not read the automation of environment %s: %w", to.targetName, err)
+		}
+	}
+
+	files := []gitprovider.CommitFile{}
+
+	newSource, changed, err := copyManifestField(fromSource, toSource, sourcev1.GitRepositoryKind, gitRevisionField)
+	if err != nil {
+		return nil, err
+	}
+
+	if changed {
+		files = append(files, commitFile(to.appAutomationSourcePath(), newSource))
+	}
+
+	newDeploy, changed, err := copyManifestField(fromDeploy, toDeploy, helmv2.HelmReleaseKind, chartVersionField)
+	if err != nil {
+		return nil, err
+	}
+
+	if changed {
+		files = append(files, commitFile(to.appAutomationDeployPath(), newDeploy))
+	}
+
+	return files, nil
+}
+
+// copyManifestField sets a field of a manifest to its value in another manifest, removing it when unset there.
+// Manifests of other kinds are left untouched. It tells whether the manifest changed.
+func copyManifestField(from, to []byte, kind string, field []string) ([]byte, bool, error) {
+	fromObject := map[string]interface{}{}
+	if err := yaml.Unmarshal(from, &fromObject); err != nil {
+		return nil, false, fmt.Errorf("could not parse manifest: %w", err)
+	}
+
+	toObject := map[string]interface{}{}
+	if err := yaml.Unmarshal(to, &toObject); err != nil {
+		return nil, false, fmt.Errorf("could not parse manifest: %w", err)
+	}
+
+	if fromObject["kind"] != kind || toObject["kind"] != kind {
+		return to, false, nil
+	}
+
+	value, found, err := unstructured.NestedFieldCopy(fromObject, field...)
+	if err != nil {
+		return nil, false, fmt.Errorf("could not read %s of %s: %w", strings.Join(field, "."), kind, err)
+	}
+
+	current, _, _ := unstructured.NestedFieldCopy(toObject, field...)
+	if equality.Semantic.DeepEqual(value, current) {
+		return to, false, nil
+	}
+
+	if found {
+		if err := unstructured.SetNestedField(toObject, value, field...); err != nil {
+			return nil, false, fmt.Errorf("could not set %s of %s: %w", strings.Join(field, "."), kind, err)
+		}
+	} else {
+		unstructured.RemoveNestedField(toObject, field...)
+	}
+
+	data, err := yaml.Marshal(toObject)
+	if err != nil {
+		return nil, false, fmt.Errorf("could not marshal yaml: %w", err)
+	}
+
+	return append(append([]byte("---\n"), data...), '\n'), true, nil
+}
+
+func commitFile(path string, content []byte) gitprovider.CommitFile {
+	text := string(content)
+
+	return gitprovider.CommitFile{Path: &path, Content: &text}
+}
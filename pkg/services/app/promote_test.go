@@ -0,0 +1,214 @@
+package app
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	stagingGitSource = `---
+apiVersion: source.toolkit.fluxcd.io/v1beta1
+kind: GitRepository
+metadata:
+  name: bar
+  namespace: wego-system
+spec:
+  interval: 30s
+  ref:
+    commit: 1a2b3c
+  url: ssh://git@github.com/foo/bar.git
+`
+	prodGitSource = `---
+apiVersion: source.toolkit.fluxcd.io/v1beta1
+kind: GitRepository
+metadata:
+  name: bar
+  namespace: wego-system
+spec:
+  interval: 30s
+  ref:
+    branch: main
+  url: ssh://git@github.com/foo/bar.git
+`
+	kustomizationDeploy = `---
+apiVersion: kustomize.toolkit.fluxcd.io/v1beta1
+kind: Kustomization
+metadata:
+  name: bar
+  namespace: wego-system
+spec:
+  path: ./kustomize
+`
+)
+
+var _ = Describe("Promote", func() {
+	var (
+		promoteParams PromoteParams
+		existingApp   wego.Application
+		repoFiles     map[string]string
+	)
+
+	BeforeEach(func() {
+		promoteParams = PromoteParams{
+			Name:      "bar",
+			Namespace: wego.DefaultNamespace,
+			From:      "staging",
+			To:        "prod",
+		}
+
+		existingApp = wego.Application{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "bar",
+				Namespace: wego.DefaultNamespace,
+			},
+			Spec: wego.ApplicationSpec{
+				URL:            "ssh://git@github.com/foo/bar.git",
+				Branch:         "main",
+				Path:           "./kustomize",
+				SourceType:     wego.SourceTypeGit,
+				DeploymentType: wego.DeploymentTypeKustomize,
+				Environments:   []string{"dev", "staging", "prod"},
+			},
+		}
+
+		repoFiles = map[string]string{
+			".wego/targets/staging/bar/bar-gitops-source.yaml": stagingGitSource,
+			".wego/targets/staging/bar/bar-gitops-deploy.yaml": kustomizationDeploy,
+		}
+
+		kubeClient.GetApplicationStub = func(_ context.Context, name types.NamespacedName) (*wego.Application, error) {
+			app := existingApp
+			return &app, nil
+		}
+
+		gitClient.CloneStub = func(_ context.Context, dir, _, _ string) (bool, error) {
+			for path, content := range repoFiles {
+				fullPath := filepath.Join(dir, path)
+				if err := os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
+					return false, err
+				}
+
+				if err := ioutil.WriteFile(fullPath, []byte(content), 0644); err != nil {
+					return false, err
+				}
+			}
+
+			return true, nil
+		}
+
+		gitProviders.GetDefaultBranchReturns("main", nil)
+		gitProviders.CreatePullRequestToUserRepoReturns(dummyPullRequest{}, nil)
+	})
+
+	It("copies the automation when the app is not deployed to the next environment", func() {
+		Expect(appSrv.Promote(promoteParams)).To(Succeed())
+
+		Expect(gitProviders.CreatePullRequestToUserRepoCallCount()).To(Equal(1))
+
+		_, _, branch, files, _, title, _ := gitProviders.CreatePullRequestToUserRepoArgsForCall(0)
+		Expect(strings.HasPrefix(branch, "wego-")).To(BeTrue())
+		Expect(branch).To(ContainSubstring("-promote-prod-"))
+		Expect(title).To(Equal("gitops app promote bar from staging to prod"))
+		Expect(files).To(HaveLen(2))
+		Expect(*files[0].Path).To(Equal(".wego/targets/prod/bar/bar-gitops-source.yaml"))
+		Expect(*files[0].Content).To(Equal(stagingGitSource))
+		Expect(*files[1].Path).To(Equal(".wego/targets/prod/bar/bar-gitops-deploy.yaml"))
+	})
+
+	It("copies the pinned revision of the git source", func() {
+		repoFiles[".wego/targets/prod/bar/bar-gitops-source.yaml"] = prodGitSource
+		repoFiles[".wego/targets/prod/bar/bar-gitops-deploy.yaml"] = kustomizationDeploy
+
+		Expect(appSrv.Promote(promoteParams)).To(Succeed())
+
+		_, _, _, files, _, _, _ := gitProviders.CreatePullRequestToUserRepoArgsForCall(0)
+		Expect(files).To(HaveLen(1))
+		Expect(*files[0].Path).To(Equal(".wego/targets/prod/bar/bar-gitops-source.yaml"))
+		Expect(*files[0].Content).To(ContainSubstring("commit: 1a2b3c"))
+		Expect(*files[0].Content).NotTo(ContainSubstring("branch: main"))
+	})
+
+	It("does not open a pull request when the next environment is up to date", func() {
+		repoFiles[".wego/targets/prod/bar/bar-gitops-source.yaml"] = stagingGitSource
+		repoFiles[".wego/targets/prod/bar/bar-gitops-deploy.yaml"] = kustomizationDeploy
+
+		Expect(appSrv.Promote(promoteParams)).To(Succeed())
+
+		Expect(gitProviders.CreatePullRequestToUserRepoCallCount()).To(Equal(0))
+	})
+
+	It("copies the chart version of the helm release", func() {
+		existingApp.Spec.SourceType = wego.SourceTypeHelm
+		existingApp.Spec.DeploymentType = wego.DeploymentTypeHelm
+
+		helmRelease := `---
+apiVersion: helm.toolkit.fluxcd.io/v2beta1
+kind: HelmRelease
+metadata:
+  name: bar
+spec:
+  chart:
+    spec:
+      chart: loki
+      version: %s
+`
+		repoFiles[".wego/targets/staging/bar/bar-gitops-deploy.yaml"] = strings.Replace(helmRelease, "%s", "2.8.1", 1)
+		repoFiles[".wego/targets/prod/bar/bar-gitops-source.yaml"] = stagingGitSource
+		repoFiles[".wego/targets/prod/bar/bar-gitops-deploy.yaml"] = strings.Replace(helmRelease, "%s", "2.7.0", 1)
+
+		Expect(appSrv.Promote(promoteParams)).To(Succeed())
+
+		_, _, _, files, _, _, _ := gitProviders.CreatePullRequestToUserRepoArgsForCall(0)
+		Expect(files).To(HaveLen(1))
+		Expect(*files[0].Path).To(Equal(".wego/targets/prod/bar/bar-gitops-deploy.yaml"))
+		Expect(*files[0].Content).To(ContainSubstring("version: 2.8.1"))
+	})
+
+	It("fails when the app is not deployed to the environment promoted", func() {
+		repoFiles = map[string]string{}
+
+		Expect(appSrv.Promote(promoteParams)).To(MatchError(ContainSubstring("could not read the automation of environment staging")))
+	})
+
+	It("only promotes an environment to the next one", func() {
+		promoteParams.From = "dev"
+
+		Expect(appSrv.Promote(promoteParams)).To(MatchError(ContainSubstring("dev is promoted to staging")))
+		Expect(gitClient.CloneCallCount()).To(Equal(0))
+	})
+
+	It("fails when the app has no environments", func() {
+		existingApp.Spec.Environments = nil
+
+		Expect(appSrv.Promote(promoteParams)).To(MatchError(ContainSubstring("no environments are defined")))
+	})
+
+	It("fails when the automation is only stored in the cluster", func() {
+		existingApp.Spec.ConfigURL = "NONE"
+
+		Expect(appSrv.Promote(promoteParams)).To(MatchError(ContainSubstring("only stored in the cluster")))
+	})
+})
+
+var _ = Describe("validateEnvironments", func() {
+	It("accepts distinct targets", func() {
+		Expect(validateEnvironments([]string{"dev", "prod"}, "")).To(Succeed())
+	})
+
+	It("rejects duplicate targets", func() {
+		Expect(validateEnvironments([]string{"dev", "dev"}, "")).To(MatchError(ContainSubstring("given more than once")))
+	})
+
+	It("rejects apps whose automation is only stored in the cluster", func() {
+		Expect(validateEnvironments([]string{"dev"}, "NONE")).To(MatchError(ContainSubstring("only stored in the cluster")))
+	})
+})
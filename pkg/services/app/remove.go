@@ -2,27 +2,65 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io/fs"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"time"
 
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1beta1"
 	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/gitcredentials"
 	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/utils"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
 	RemoveCommitMessage = "Remove App manifests"
+
+	DefaultRemoveTimeout = 5 * time.Minute
 )
 
+// removePollInterval is how often the garbage collected objects are checked while waiting for a removal
+var removePollInterval = 2 * time.Second
+
+var ErrRemoveTimeout = errors.New("timed out waiting for the objects of the app to be deleted")
+
 type RemoveParams struct {
 	Name             string
 	Namespace        string
 	PrivateKey       string
 	DryRun           bool
 	GitProviderToken string
+	// KeepWorkloads suspends the Kustomization or HelmRelease of the app before removing it,
+	// so Flux leaves the objects it applied running in the cluster
+	KeepWorkloads bool
+	// Timeout is how long to wait for Flux to delete the objects of the app
+	Timeout time.Duration
+}
+
+// GarbageCollectedObjects returns the objects Flux deletes from the cluster when the app is removed:
+// the objects applied by a pruning Kustomization, or the objects of the release of a HelmRelease.
+// No objects are returned when the workloads are kept, or when the automation of the app is suspended.
+func (a *App) GarbageCollectedObjects(params RemoveParams) ([]unstructured.Unstructured, error) {
+	if params.KeepWorkloads {
+		return nil, nil
+	}
+
+	application, err := a.Kube.GetApplication(a.Context, types.NamespacedName{Namespace: params.Namespace, Name: params.Name})
+	if err != nil {
+		return nil, err
+	}
+
+	return garbageCollectedObjects(a.Context, a.Kube, application)
 }
 
 // Remove removes the Weave GitOps automation for an application
@@ -43,6 +81,20 @@ func (a *App) Remove(params RemoveParams) error {
 	info := getAppResourceInfo(*application, clusterName)
 	resources := info.clusterResources()
 
+	// The objects Flux garbage collects, waited for once the automation is deleted
+	var collected []unstructured.Unstructured
+
+	switch {
+	case params.KeepWorkloads:
+		if err := a.suspendAutomation(ctx, application, params.DryRun); err != nil {
+			return err
+		}
+	case !params.DryRun:
+		if collected, err = garbageCollectedObjects(ctx, a.Kube, application); err != nil {
+			return err
+		}
+	}
+
 	if info.configMode() == ConfigModeClusterOnly {
 		gvrApp, err := ResourceKindApplication.ToGVR()
 		if err != nil {
@@ -71,7 +123,11 @@ func (a *App) Remove(params RemoveParams) error {
 			return clusterDeleteError(info.appResourceName(), err)
 		}
 
-		return nil
+		if err := a.removeGitHubAppToken(ctx, info, params.DryRun); err != nil {
+			return err
+		}
+
+		return a.waitForDeletion(ctx, collected, params.Timeout)
 	}
 
 	cloneURL, branch, err := a.getConfigUrlAndBranch(info)
@@ -84,7 +140,7 @@ func (a *App) Remove(params RemoveParams) error {
 		return fmt.Errorf("error normalizing url: %w", err)
 	}
 
-	remover, err := a.cloneRepo(a.ConfigGit, normalizedUrl.String(), branch, params.DryRun)
+	remover, err := a.cloneRepo(a.ConfigGit, info.gitURL(normalizedUrl.String()), branch, params.DryRun)
 
 	if err != nil {
 		return fmt.Errorf("failed to clone configuration repo: %w", err)
@@ -112,12 +168,156 @@ func (a *App) Remove(params RemoveParams) error {
 			}
 		}
 
-		return a.commitAndPush(a.ConfigGit, RemoveCommitMessage, params.DryRun)
+		if err := a.commitAndPush(a.ConfigGit, RemoveCommitMessage, params.DryRun); err != nil {
+			return err
+		}
+
+		if err := a.removeGitHubAppToken(ctx, info, params.DryRun); err != nil {
+			return err
+		}
+
+		return a.waitForDeletion(ctx, collected, params.Timeout)
 	}
 
 	return nil
 }
 
+// removeGitHubAppToken deletes the installation token Secret issued for the GitHub App credentials of an app,
+// unless another app of the namespace authenticates with the same credentials
+func (a *App) removeGitHubAppToken(ctx context.Context, info *AppResourceInfo, dryRun bool) error {
+	if info.Spec.GitAuth != wego.GitAuthGitHubApp || dryRun {
+		return nil
+	}
+
+	apps, err := a.Kube.GetApplications(ctx, info.Namespace)
+	if err != nil {
+		return fmt.Errorf("could not list applications: %w", err)
+	}
+
+	for _, app := range apps {
+		if app.Name != info.Name && app.Spec.GitAuth == wego.GitAuthGitHubApp &&
+			app.Spec.GitCredentialsSecret == info.Spec.GitCredentialsSecret {
+			return nil
+		}
+	}
+
+	name := gitcredentials.GitHubAppTokenSecretName(info.Spec.GitCredentialsSecret)
+
+	a.Logger.Actionf("Deleting the GitHub App installation token Secret %s", name)
+
+	if err := a.Kube.DeleteByName(ctx, name, kube.GVRSecret, info.Namespace); err != nil && !apierrors.IsNotFound(err) {
+		return clusterDeleteError(name, err)
+	}
+
+	return nil
+}
+
+// garbageCollectedObjects returns the objects Flux deletes when the Kustomization or HelmRelease of an app is deleted.
+// Suspended automations, and Kustomizations which do not prune, leave their objects in the cluster.
+func garbageCollectedObjects(ctx context.Context, kubeService kube.Kube, app *wego.Application) ([]unstructured.Unstructured, error) {
+	name := types.NamespacedName{Name: app.Name, Namespace: app.Namespace}
+
+	automation := automationOf(app)
+
+	found, err := getOptionalResource(ctx, kubeService, name, automation)
+	if err != nil || !found || isSuspended(automation) {
+		return nil, err
+	}
+
+	if kust, ok := automation.(*kustomizev1.Kustomization); ok {
+		if !kust.Spec.Prune {
+			return nil, nil
+		}
+
+		return kustomizationObjects(ctx, kubeService, name)
+	}
+
+	return helmReleaseObjects(ctx, kubeService, name)
+}
+
+// suspendAutomation suspends the Kustomization or HelmRelease of an app, which Flux then deletes without
+// deleting the objects it applied
+func (a *App) suspendAutomation(ctx context.Context, app *wego.Application, dryRun bool) error {
+	name := types.NamespacedName{Name: app.Name, Namespace: app.Namespace}
+
+	automation := automationOf(app)
+
+	found, err := getOptionalResource(ctx, a.Kube, name, automation)
+	if err != nil || !found || isSuspended(automation) {
+		return err
+	}
+
+	a.Logger.Actionf("Suspending %s %s to keep the workloads of the app", kindOf(automation), name)
+
+	if dryRun {
+		return nil
+	}
+
+	patch := client.MergeFrom(automation.DeepCopyObject().(client.Object))
+
+	switch o := automation.(type) {
+	case *kustomizev1.Kustomization:
+		o.Spec.Suspend = true
+	case *helmv2.HelmRelease:
+		o.Spec.Suspend = true
+	}
+
+	if err := a.Kube.PatchResource(ctx, automation, patch); err != nil {
+		return fmt.Errorf("could not suspend %s %s: %w", kindOf(automation), name, err)
+	}
+
+	return nil
+}
+
+// waitForDeletion waits until the objects are deleted from the cluster, or replaced by new objects of the same name
+func (a *App) waitForDeletion(ctx context.Context, objects []unstructured.Unstructured, timeout time.Duration) error {
+	if len(objects) == 0 {
+		return nil
+	}
+
+	if timeout == 0 {
+		timeout = DefaultRemoveTimeout
+	}
+
+	a.Logger.Waitingf("Waiting for Flux to delete %d objects of the app", len(objects))
+
+	err := utils.WaitUntil(a.Logger, removePollInterval, timeout, func() error {
+		remaining := 0
+
+		for _, obj := range objects {
+			live, err := getLiveObject(ctx, a.Kube, obj.GroupVersionKind(), obj.GetNamespace(), obj.GetName())
+			if err != nil {
+				return err
+			}
+
+			if live != nil && live.GetUID() == obj.GetUID() {
+				remaining++
+			}
+		}
+
+		if remaining > 0 {
+			return fmt.Errorf("%d objects of the app are not deleted yet", remaining)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrRemoveTimeout, err)
+	}
+
+	a.Logger.Successf("All objects of the app are deleted")
+
+	return nil
+}
+
+func automationOf(app *wego.Application) kube.Resource {
+	if app.Spec.DeploymentType == wego.DeploymentTypeHelm {
+		return &helmv2.HelmRelease{}
+	}
+
+	return &kustomizev1.Kustomization{}
+}
+
 func (a *App) getConfigUrlAndBranch(info *AppResourceInfo) (string, string, error) {
 	cloneURL := info.Spec.ConfigURL
 	branch := info.Spec.Branch
@@ -7,8 +7,10 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1beta1"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
@@ -18,8 +20,11 @@ import (
 	"github.com/weaveworks/weave-gitops/pkg/osys/osysfakes"
 	"github.com/weaveworks/weave-gitops/pkg/runner"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/yaml"
 )
 
@@ -29,7 +34,6 @@ var (
 	application      wego.Application
 	info             *AppResourceInfo
 	appResources     []ResourceRef
-	fluxDir          string
 	createdResources map[ResourceKind]map[string]bool
 	goatPaths        map[string]bool
 	manifestsByPath  map[string][]byte = map[string][]byte{}
@@ -148,48 +152,9 @@ func removeGOATPath(path string) error {
 	return nil
 }
 
-// Set up a flux binary in a temp dir that will be used to generate flux manifests
-func setupFlux() error {
-	dir, err := ioutil.TempDir("", "a-home-dir")
-	if err != nil {
-		return err
-	}
-
-	fluxDir = dir
-	cliRunner := &runner.CLIRunner{}
-	osysClient := &osysfakes.FakeOsys{}
-	fluxClient := flux.New(osysClient, cliRunner)
-	osysClient.UserHomeDirStub = func() (string, error) {
-		return dir, nil
-	}
-	appSrv.(*App).Flux = fluxClient
-
-	fluxBin, err := ioutil.ReadFile(filepath.Join("..", "..", "flux", "bin", "flux"))
-	if err != nil {
-		return err
-	}
-
-	binPath, err := fluxClient.GetBinPath()
-	if err != nil {
-		return err
-	}
-
-	err = os.MkdirAll(binPath, 0777)
-	if err != nil {
-		return err
-	}
-
-	exePath, err := fluxClient.GetExePath()
-	if err != nil {
-		return err
-	}
-
-	err = ioutil.WriteFile(exePath, fluxBin, 0777)
-	if err != nil {
-		return err
-	}
-
-	return nil
+// Use the real flux client, which generates flux manifests in-process
+func setupFlux() {
+	appSrv.(*App).Flux = flux.New(&osysfakes.FakeOsys{}, &runner.CLIRunner{})
 }
 
 func updateAppInfoFromParams() error {
@@ -337,7 +302,7 @@ var _ = Describe("Remove", func() {
 
 	Context("Collecting resources deployed to cluster", func() {
 		var _ = BeforeEach(func() {
-			Expect(setupFlux()).To(Succeed())
+			setupFlux()
 
 			// Track the resources added to the cluster via files added to the repository
 			gitClient.WriteStub = func(path string, manifest []byte) error {
@@ -351,10 +316,6 @@ var _ = Describe("Remove", func() {
 			}
 		})
 
-		var _ = AfterEach(func() {
-			os.RemoveAll(fluxDir)
-		})
-
 		Context("Collecting resources for helm charts", func() {
 			var _ = BeforeEach(func() {
 				localAddParams = AddParams{
@@ -445,7 +406,7 @@ var _ = Describe("Remove", func() {
 
 	Context("Removing resources from cluster", func() {
 		var _ = BeforeEach(func() {
-			Expect(setupFlux()).To(Succeed())
+			setupFlux()
 
 			gitClient.WriteStub = func(path string, manifest []byte) error {
 				storeGOATPath(path, manifest)
@@ -476,10 +437,6 @@ var _ = Describe("Remove", func() {
 			}
 		})
 
-		var _ = AfterEach(func() {
-			os.RemoveAll(fluxDir)
-		})
-
 		Context("Removing resources for helm charts", func() {
 			var _ = BeforeEach(func() {
 				localAddParams = AddParams{
@@ -607,3 +564,169 @@ func GVRToResourceKind(gvr schema.GroupVersionResource) ResourceKind {
 		return ResourceKindKustomization
 	}
 }
+
+var _ = Describe("Removing the workloads of an app", func() {
+	var (
+		existingApp wego.Application
+		kust        kustomizev1.Kustomization
+		live        []unstructured.Unstructured
+	)
+
+	BeforeEach(func() {
+		existingApp = wego.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: wego.DefaultNamespace},
+			Spec: wego.ApplicationSpec{
+				URL:            "ssh://git@github.com/foo/podinfo.git",
+				Branch:         "main",
+				Path:           "./deploy",
+				ConfigURL:      string(ConfigTypeNone),
+				SourceType:     wego.SourceTypeGit,
+				DeploymentType: wego.DeploymentTypeKustomize,
+			},
+		}
+
+		kust = kustomizev1.Kustomization{
+			ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: wego.DefaultNamespace},
+			Spec:       kustomizev1.KustomizationSpec{Prune: true},
+			Status: kustomizev1.KustomizationStatus{Snapshot: &kustomizev1.Snapshot{Entries: []kustomizev1.SnapshotEntry{
+				{Namespace: "apps", Kinds: map[string]string{"apps/v1, Kind=Deployment": "Deployment"}},
+			}}},
+		}
+
+		deployment := unstructured.Unstructured{}
+		deployment.SetAPIVersion("apps/v1")
+		deployment.SetKind("Deployment")
+		deployment.SetName("podinfo")
+		deployment.SetNamespace("apps")
+		deployment.SetUID("1234")
+
+		live = []unstructured.Unstructured{deployment}
+
+		kubeClient.GetApplicationStub = func(_ context.Context, name types.NamespacedName) (*wego.Application, error) {
+			app := existingApp
+			return &app, nil
+		}
+
+		kubeClient.GetResourceStub = func(_ context.Context, name types.NamespacedName, r kube.Resource) error {
+			switch obj := r.(type) {
+			case *kustomizev1.Kustomization:
+				kust.DeepCopyInto(obj)
+			case *unstructured.Unstructured:
+				for _, l := range live {
+					if l.GetName() == name.Name && l.GetNamespace() == name.Namespace {
+						l.DeepCopyInto(obj)
+					}
+				}
+			}
+
+			return nil
+		}
+
+		kubeClient.ListResourcesStub = func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+			if l, ok := list.(*unstructured.UnstructuredList); ok {
+				l.Items = live
+			}
+
+			return nil
+		}
+
+		removeParams = RemoveParams{Name: "podinfo", Namespace: wego.DefaultNamespace, Timeout: 50 * time.Millisecond}
+
+		removePollInterval = time.Millisecond
+	})
+
+	AfterEach(func() {
+		removePollInterval = 2 * time.Second
+	})
+
+	It("lists the objects pruned by the kustomization", func() {
+		objects, err := appSrv.GarbageCollectedObjects(removeParams)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(objects).To(HaveLen(1))
+		Expect(objects[0].GetKind()).To(Equal("Deployment"))
+	})
+
+	It("lists no objects when the kustomization does not prune", func() {
+		kust.Spec.Prune = false
+
+		objects, err := appSrv.GarbageCollectedObjects(removeParams)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(objects).To(BeEmpty())
+	})
+
+	It("lists no objects when the workloads are kept", func() {
+		removeParams.KeepWorkloads = true
+
+		objects, err := appSrv.GarbageCollectedObjects(removeParams)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(objects).To(BeEmpty())
+	})
+
+	It("suspends the kustomization before removing the app when the workloads are kept", func() {
+		removeParams.KeepWorkloads = true
+
+		kubeClient.DeleteByNameStub = func(context.Context, string, schema.GroupVersionResource, string) error {
+			Expect(kubeClient.PatchResourceCallCount()).To(Equal(1))
+			return nil
+		}
+
+		Expect(appSrv.Remove(removeParams)).To(Succeed())
+
+		Expect(kubeClient.PatchResourceCallCount()).To(Equal(1))
+		_, patched, _ := kubeClient.PatchResourceArgsForCall(0)
+		Expect(patched.(*kustomizev1.Kustomization).Spec.Suspend).To(BeTrue())
+
+		Expect(kubeClient.DeleteByNameCallCount()).To(Equal(3))
+	})
+
+	It("waits until the pruned objects are deleted", func() {
+		kubeClient.DeleteByNameStub = func(context.Context, string, schema.GroupVersionResource, string) error {
+			live = nil
+			return nil
+		}
+
+		Expect(appSrv.Remove(removeParams)).To(Succeed())
+		Expect(kubeClient.PatchResourceCallCount()).To(Equal(0))
+	})
+
+	It("times out when the pruned objects are not deleted", func() {
+		Expect(appSrv.Remove(removeParams)).To(MatchError(ContainSubstring(ErrRemoveTimeout.Error())))
+	})
+
+	Context("when the app authenticates as a github app", func() {
+		var deletedSecrets []string
+
+		BeforeEach(func() {
+			existingApp.Spec.URL = "https://github.com/foo/podinfo.git"
+			existingApp.Spec.GitAuth = wego.GitAuthGitHubApp
+			existingApp.Spec.GitCredentialsSecret = "github-app"
+
+			deletedSecrets = nil
+
+			kubeClient.DeleteByNameStub = func(_ context.Context, name string, gvr schema.GroupVersionResource, _ string) error {
+				if gvr == kube.GVRSecret {
+					deletedSecrets = append(deletedSecrets, name)
+				}
+
+				live = nil
+
+				return nil
+			}
+		})
+
+		It("deletes the installation token Secret", func() {
+			Expect(appSrv.Remove(removeParams)).To(Succeed())
+			Expect(deletedSecrets).To(Equal([]string{"github-app-token"}))
+		})
+
+		It("keeps the installation token Secret used by another app", func() {
+			other := existingApp
+			other.Name = "other"
+
+			kubeClient.GetApplicationsReturns([]wego.Application{existingApp, other}, nil)
+
+			Expect(appSrv.Remove(removeParams)).To(Succeed())
+			Expect(deletedSecrets).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,225 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
+	"github.com/weaveworks/weave-gitops/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// The entries of the flux git secrets holding the deploy key
+const (
+	deployKeyPrivateEntry = "identity"
+	deployKeyPublicEntry  = "identity.pub"
+	knownHostsEntry       = "known_hosts"
+)
+
+type RotateKeysParams struct {
+	Name      string
+	Namespace string
+	// Timeout bounds the wait for the sources to reconcile with the new key
+	Timeout time.Duration
+	// Rotated holds the names of the deploy key secrets already rotated, which are skipped.
+	// The secrets rotated are added to it, so that the secrets shared by several apps are rotated once.
+	Rotated map[string]bool
+}
+
+// deployKeySecret is a flux git secret and the sources cloning its repository with it
+type deployKeySecret struct {
+	name    types.NamespacedName
+	repoURL string
+	sources []*sourcev1.GitRepository
+}
+
+// RotateKeys replaces the deploy keys of the repositories of an app. A new key is uploaded to the git provider
+// and stored in the flux git secret, then the old key is deleted from the provider once the sources
+// cloned the repository with the new key.
+func (a *App) RotateKeys(params RotateKeysParams) error {
+	ctx := a.Context
+
+	app, err := a.Kube.GetApplication(ctx, types.NamespacedName{Name: params.Name, Namespace: params.Namespace})
+	if err != nil {
+		return fmt.Errorf("could not get application %q: %w", params.Name, err)
+	}
+
+	if app.Spec.GitAuth != "" && app.Spec.GitAuth != wego.GitAuthDeployKey {
+		a.Logger.Println("App %s uses %s git auth instead of deploy keys", params.Name, app.Spec.GitAuth)
+		return nil
+	}
+
+	secrets, err := a.deployKeySecrets(ctx, app)
+	if err != nil {
+		return err
+	}
+
+	if len(secrets) == 0 {
+		a.Logger.Println("App %s does not use deploy keys", params.Name)
+		return nil
+	}
+
+	timeout := params.Timeout
+	if timeout == 0 {
+		timeout = DefaultSyncTimeout
+	}
+
+	for _, secret := range secrets {
+		if params.Rotated != nil && params.Rotated[secret.name.String()] {
+			continue
+		}
+
+		if err := a.rotateDeployKey(ctx, secret, timeout); err != nil {
+			return err
+		}
+
+		if params.Rotated != nil {
+			params.Rotated[secret.name.String()] = true
+		}
+	}
+
+	return nil
+}
+
+// deployKeySecrets returns the secrets of the git sources of an app: the source of the app repository,
+// and the source of the config repository when it is another repository
+func (a *App) deployKeySecrets(ctx context.Context, app *wego.Application) ([]*deployKeySecret, error) {
+	clusterName, err := a.Kube.GetClusterName(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	info := getAppResourceInfo(*app, clusterName)
+
+	sourceNames := []string{}
+
+	if app.Spec.SourceType != wego.SourceTypeHelm {
+		sourceNames = append(sourceNames, info.appSourceName())
+	}
+
+	if info.configMode() == ConfigModeExternalRepo {
+		sourceNames = append(sourceNames, generateResourceName(app.Spec.ConfigURL))
+	}
+
+	secrets := []*deployKeySecret{}
+	byName := map[string]*deployKeySecret{}
+
+	for _, sourceName := range sourceNames {
+		source := &sourcev1.GitRepository{}
+		name := types.NamespacedName{Name: sourceName, Namespace: app.Namespace}
+
+		found, err := getOptionalResource(ctx, a.Kube, name, source)
+		if err != nil {
+			return nil, err
+		}
+
+		// Public repositories are cloned without a deploy key
+		if !found || source.Spec.SecretRef == nil {
+			continue
+		}
+
+		secretName := types.NamespacedName{Name: source.Spec.SecretRef.Name, Namespace: app.Namespace}
+
+		secret, ok := byName[secretName.String()]
+		if !ok {
+			secret = &deployKeySecret{name: secretName, repoURL: source.Spec.URL}
+			byName[secretName.String()] = secret
+			secrets = append(secrets, secret)
+		}
+
+		secret.sources = append(secret.sources, source)
+	}
+
+	return secrets, nil
+}
+
+func (a *App) rotateDeployKey(ctx context.Context, secret *deployKeySecret, timeout time.Duration) error {
+	repo, err := gitproviders.NewNormalizedRepoURL(secret.repoURL)
+	if err != nil {
+		return fmt.Errorf("could not normalize repo url %q: %w", secret.repoURL, err)
+	}
+
+	current := &corev1.Secret{}
+
+	found, err := getOptionalResource(ctx, a.Kube, secret.name, current)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("could not find deploy key secret %s", secret.name)
+	}
+
+	oldPublicKey := current.Data[deployKeyPublicEntry]
+
+	generated, err := a.Flux.CreateSecretGit(secret.name.Name, repo.String(), secret.name.Namespace)
+	if err != nil {
+		return fmt.Errorf("could not generate deploy key: %w", err)
+	}
+
+	newSecret := &corev1.Secret{}
+	if err := yaml.Unmarshal(generated, newSecret); err != nil {
+		return fmt.Errorf("failed to unmarshal generated deploy key: %w", err)
+	}
+
+	a.Logger.Actionf("Uploading a new deploy key for %s", repo.String())
+
+	if err := a.GitProvider.UploadDeployKey(repo.Owner(), repo.RepositoryName(), secretEntry(newSecret, deployKeyPublicEntry)); err != nil {
+		return fmt.Errorf("error uploading deploy key: %w", err)
+	}
+
+	patch := client.MergeFrom(current.DeepCopy())
+
+	if current.Data == nil {
+		current.Data = map[string][]byte{}
+	}
+
+	for _, entry := range []string{deployKeyPrivateEntry, deployKeyPublicEntry, knownHostsEntry} {
+		current.Data[entry] = secretEntry(newSecret, entry)
+	}
+
+	if err := a.Kube.PatchResource(ctx, current, patch); err != nil {
+		return fmt.Errorf("could not store the new deploy key in secret %s: %w", secret.name, err)
+	}
+
+	for _, source := range secret.sources {
+		a.Logger.Waitingf("Waiting for %s %s to reconcile with the new deploy key", sourcev1.GitRepositoryKind, source.Name)
+
+		requestedAt := time.Now().Format(time.RFC3339Nano)
+
+		if err := requestReconcile(ctx, a.Kube, source, requestedAt); err != nil {
+			return err
+		}
+
+		if err := utils.WaitUntil(a.Logger, syncPollInterval, timeout, func() error {
+			return checkReconciled(ctx, a.Kube, source, requestedAt)
+		}); err != nil {
+			return fmt.Errorf("%s %s did not reconcile with the new deploy key, the old key was kept on the git provider: %w", sourcev1.GitRepositoryKind, source.Name, err)
+		}
+	}
+
+	if len(oldPublicKey) > 0 {
+		if err := a.GitProvider.DeleteDeployKey(repo.Owner(), repo.RepositoryName(), oldPublicKey); err != nil {
+			return fmt.Errorf("error deleting the old deploy key: %w", err)
+		}
+	}
+
+	a.Logger.Successf("Deploy key of %s rotated", repo.String())
+
+	return nil
+}
+
+// secretEntry reads an entry of a secret, whether it was read from the cluster or generated by flux
+func secretEntry(secret *corev1.Secret, key string) []byte {
+	if data, ok := secret.Data[key]; ok {
+		return data
+	}
+
+	return []byte(secret.StringData[key])
+}
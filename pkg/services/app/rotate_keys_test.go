@@ -0,0 +1,151 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const generatedDeployKeySecret = `apiVersion: v1
+kind: Secret
+metadata:
+  name: wego-test-cluster-repo
+  namespace: wego-system
+stringData:
+  identity: new-private-key
+  identity.pub: ssh-ed25519 NEW
+  known_hosts: github.com ssh-ed25519 HOST
+`
+
+var _ = Describe("RotateKeys", func() {
+	var (
+		application *wego.Application
+		secret      *corev1.Secret
+		requestedAt string
+		reconciles  bool
+	)
+
+	BeforeEach(func() {
+		application = &wego.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-app", Namespace: "wego-system"},
+			Spec: wego.ApplicationSpec{
+				URL:            "ssh://git@github.com/owner/repo.git",
+				ConfigURL:      "NONE",
+				SourceType:     wego.SourceTypeGit,
+				DeploymentType: wego.DeploymentTypeKustomize,
+			},
+		}
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "wego-test-cluster-repo", Namespace: "wego-system"},
+			Data:       map[string][]byte{"identity": []byte("old-private-key"), "identity.pub": []byte("ssh-ed25519 OLD")},
+		}
+		requestedAt = ""
+		reconciles = true
+
+		kubeClient.GetApplicationStub = func(ctx context.Context, name types.NamespacedName) (*wego.Application, error) {
+			return application, nil
+		}
+
+		kubeClient.GetResourceStub = func(ctx context.Context, name types.NamespacedName, r kube.Resource) error {
+			switch obj := r.(type) {
+			case *corev1.Secret:
+				secret.DeepCopyInto(obj)
+			case *sourcev1.GitRepository:
+				obj.SetName(name.Name)
+				obj.SetNamespace(name.Namespace)
+				obj.Spec.URL = application.Spec.URL
+				obj.Spec.SecretRef = &meta.LocalObjectReference{Name: secret.Name}
+				obj.Status.Conditions = []metav1.Condition{{Type: meta.ReadyCondition, Status: metav1.ConditionTrue}}
+
+				if reconciles {
+					obj.Status.LastHandledReconcileAt = requestedAt
+				}
+			}
+
+			return nil
+		}
+
+		kubeClient.PatchResourceStub = func(ctx context.Context, r kube.Resource, patch client.Patch) error {
+			if _, ok := r.(*sourcev1.GitRepository); ok {
+				requestedAt = r.GetAnnotations()[meta.ReconcileRequestAnnotation]
+			}
+
+			return nil
+		}
+
+		fluxClient.CreateSecretGitReturns([]byte(generatedDeployKeySecret), nil)
+	})
+
+	It("uploads a new key, stores it, then deletes the old key once the source reconciled", func() {
+		Expect(appSrv.RotateKeys(RotateKeysParams{Name: "my-app", Namespace: "wego-system"})).To(Succeed())
+
+		owner, repoName, key := gitProviders.UploadDeployKeyArgsForCall(0)
+		Expect(owner).To(Equal("owner"))
+		Expect(repoName).To(Equal("repo"))
+		Expect(string(key)).To(Equal("ssh-ed25519 NEW"))
+
+		Expect(kubeClient.PatchResourceCallCount()).To(Equal(2))
+
+		_, stored, _ := kubeClient.PatchResourceArgsForCall(0)
+		Expect(stored.(*corev1.Secret).Data).To(Equal(map[string][]byte{
+			"identity":     []byte("new-private-key"),
+			"identity.pub": []byte("ssh-ed25519 NEW"),
+			"known_hosts":  []byte("github.com ssh-ed25519 HOST"),
+		}))
+
+		_, source, _ := kubeClient.PatchResourceArgsForCall(1)
+		Expect(source).To(BeAssignableToTypeOf(&sourcev1.GitRepository{}))
+
+		Expect(gitProviders.DeleteDeployKeyCallCount()).To(Equal(1))
+		_, _, oldKey := gitProviders.DeleteDeployKeyArgsForCall(0)
+		Expect(string(oldKey)).To(Equal("ssh-ed25519 OLD"))
+	})
+
+	It("keeps the old key when the source does not reconcile", func() {
+		reconciles = false
+
+		defer func(interval time.Duration) { syncPollInterval = interval }(syncPollInterval)
+		syncPollInterval = time.Millisecond
+
+		err := appSrv.RotateKeys(RotateKeysParams{Name: "my-app", Namespace: "wego-system", Timeout: 10 * time.Millisecond})
+		Expect(err).To(MatchError(ContainSubstring("the old key was kept")))
+		Expect(gitProviders.DeleteDeployKeyCallCount()).To(Equal(0))
+	})
+
+	It("rotates the secrets shared by several apps once", func() {
+		rotated := map[string]bool{}
+
+		Expect(appSrv.RotateKeys(RotateKeysParams{Name: "my-app", Namespace: "wego-system", Rotated: rotated})).To(Succeed())
+		Expect(appSrv.RotateKeys(RotateKeysParams{Name: "another-app", Namespace: "wego-system", Rotated: rotated})).To(Succeed())
+
+		Expect(rotated).To(Equal(map[string]bool{"wego-system/wego-test-cluster-repo": true}))
+		Expect(gitProviders.UploadDeployKeyCallCount()).To(Equal(1))
+	})
+
+	It("does nothing for apps of public repositories", func() {
+		kubeClient.GetResourceStub = func(ctx context.Context, name types.NamespacedName, r kube.Resource) error {
+			r.SetName(name.Name)
+			return nil
+		}
+
+		Expect(appSrv.RotateKeys(RotateKeysParams{Name: "my-app", Namespace: "wego-system"})).To(Succeed())
+		Expect(gitProviders.UploadDeployKeyCallCount()).To(Equal(0))
+	})
+
+	It("does nothing for apps using git credentials", func() {
+		application.Spec.GitAuth = wego.GitAuthHTTPS
+
+		Expect(appSrv.RotateKeys(RotateKeysParams{Name: "my-app", Namespace: "wego-system"})).To(Succeed())
+		Expect(fluxClient.CreateSecretGitCallCount()).To(Equal(0))
+	})
+})
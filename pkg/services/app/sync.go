@@ -0,0 +1,261 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1beta1"
+	"github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const DefaultSyncTimeout = 5 * time.Minute
+
+// syncPollInterval is how often the objects are checked while waiting for a sync
+var syncPollInterval = 2 * time.Second
+
+var (
+	ErrAppPaused   = errors.New("app is paused")
+	ErrSyncTimeout = errors.New("timed out waiting for the sync")
+)
+
+type SyncParams struct {
+	Name      string
+	Namespace string
+	// Wait blocks until the source and the automation have handled the request and are Ready
+	Wait    bool
+	Timeout time.Duration
+}
+
+// SyncResult reports the revisions of the application before and after a sync.
+// The new revisions are only known when waiting for the sync.
+type SyncResult struct {
+	PreviousSourceRevision  string
+	SourceRevision          string
+	PreviousAppliedRevision string
+	AppliedRevision         string
+}
+
+func (a *App) Sync(params SyncParams) (*SyncResult, error) {
+	if params.Wait {
+		a.Logger.Waitingf("Waiting for app %s to sync", params.Name)
+	}
+
+	result, err := SyncApplication(a.Context, a.Kube, a.Logger, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.Wait {
+		a.Logger.Successf("App %s synced", params.Name)
+	} else {
+		a.Logger.Successf("Sync requested for app %s", params.Name)
+	}
+
+	return result, nil
+}
+
+// SyncApplication asks Flux to reconcile the source of an application, then its Kustomization or HelmRelease,
+// by setting their reconcile request annotation. The retries of the wait are reported to out.
+func SyncApplication(ctx context.Context, kubeService kube.Kube, out io.Writer, params SyncParams) (*SyncResult, error) {
+	name := types.NamespacedName{Name: params.Name, Namespace: params.Namespace}
+
+	app, err := kubeService.GetApplication(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("could not get application %q: %w", params.Name, err)
+	}
+
+	var source, automation kube.Resource = &sourcev1.GitRepository{}, &kustomizev1.Kustomization{}
+
+	if app.Spec.SourceType == wego.SourceTypeHelm {
+		source = &sourcev1.HelmRepository{}
+	}
+
+	if app.Spec.DeploymentType == wego.DeploymentTypeHelm {
+		automation = &helmv2.HelmRelease{}
+	}
+
+	for _, obj := range []kube.Resource{source, automation} {
+		found, err := getOptionalResource(ctx, kubeService, name, obj)
+		if err != nil {
+			return nil, err
+		}
+
+		if !found {
+			return nil, fmt.Errorf("could not find %s %s", kindOf(obj), name)
+		}
+	}
+
+	if isSuspended(automation) {
+		return nil, fmt.Errorf("%w: %s", ErrAppPaused, params.Name)
+	}
+
+	result := &SyncResult{
+		PreviousSourceRevision:  revisionOf(source),
+		SourceRevision:          revisionOf(source),
+		PreviousAppliedRevision: revisionOf(automation),
+		AppliedRevision:         revisionOf(automation),
+	}
+
+	timeout := params.Timeout
+	if timeout == 0 {
+		timeout = DefaultSyncTimeout
+	}
+
+	// The timeout bounds the whole sync, the automation having the time the source left
+	deadline := time.Now().Add(timeout)
+
+	for _, obj := range []kube.Resource{source, automation} {
+		requestedAt := time.Now().Format(time.RFC3339Nano)
+
+		if err := requestReconcile(ctx, kubeService, obj, requestedAt); err != nil {
+			return nil, err
+		}
+
+		if !params.Wait {
+			continue
+		}
+
+		if err := utils.WaitUntil(out, syncPollInterval, time.Until(deadline), func() error {
+			return checkReconciled(ctx, kubeService, obj, requestedAt)
+		}); err != nil {
+			return nil, fmt.Errorf("%w of %s %s: %s", ErrSyncTimeout, kindOf(obj), name, err)
+		}
+	}
+
+	if params.Wait {
+		result.SourceRevision = revisionOf(source)
+		result.AppliedRevision = revisionOf(automation)
+	}
+
+	return result, nil
+}
+
+func requestReconcile(ctx context.Context, kubeService kube.Kube, obj kube.Resource, requestedAt string) error {
+	patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	annotations[meta.ReconcileRequestAnnotation] = requestedAt
+	obj.SetAnnotations(annotations)
+
+	if err := kubeService.PatchResource(ctx, obj, patch); err != nil {
+		return fmt.Errorf("could not request reconciliation of %s %s: %w", kindOf(obj), obj.GetName(), err)
+	}
+
+	return nil
+}
+
+// checkReconciled refreshes an object and succeeds once it handled the reconcile request and is Ready
+func checkReconciled(ctx context.Context, kubeService kube.Kube, obj kube.Resource, requestedAt string) error {
+	if err := kubeService.GetResource(ctx, types.NamespacedName{Name: obj.GetName(), Namespace: obj.GetNamespace()}, obj); err != nil {
+		return err
+	}
+
+	handled, conditions := reconcileStatus(obj)
+
+	if handled != requestedAt {
+		return fmt.Errorf("%s %s has not handled the reconcile request yet", kindOf(obj), obj.GetName())
+	}
+
+	ready := apimeta.FindStatusCondition(conditions, meta.ReadyCondition)
+
+	switch {
+	case ready == nil:
+		return fmt.Errorf("%s %s has no ready condition", kindOf(obj), obj.GetName())
+	case ready.Status != metav1.ConditionTrue:
+		return fmt.Errorf("%s %s is not ready: %s", kindOf(obj), obj.GetName(), ready.Message)
+	}
+
+	return nil
+}
+
+func reconcileStatus(obj kube.Resource) (string, []metav1.Condition) {
+	switch o := obj.(type) {
+	case *sourcev1.GitRepository:
+		return o.Status.LastHandledReconcileAt, o.Status.Conditions
+	case *sourcev1.HelmRepository:
+		return o.Status.LastHandledReconcileAt, o.Status.Conditions
+	case *kustomizev1.Kustomization:
+		return o.Status.LastHandledReconcileAt, o.Status.Conditions
+	case *helmv2.HelmRelease:
+		return o.Status.LastHandledReconcileAt, o.Status.Conditions
+	}
+
+	return "", nil
+}
+
+// revisionOf returns the revision of the artifact of a source, or the revision last applied by an automation
+func revisionOf(obj kube.Resource) string {
+	switch o := obj.(type) {
+	case *sourcev1.GitRepository:
+		if o.Status.Artifact != nil {
+			return o.Status.Artifact.Revision
+		}
+	case *sourcev1.HelmRepository:
+		if o.Status.Artifact != nil {
+			return o.Status.Artifact.Revision
+		}
+	case *kustomizev1.Kustomization:
+		return o.Status.LastAppliedRevision
+	case *helmv2.HelmRelease:
+		return o.Status.LastAppliedRevision
+	}
+
+	return ""
+}
+
+func isSuspended(obj kube.Resource) bool {
+	switch o := obj.(type) {
+	case *kustomizev1.Kustomization:
+		return o.Spec.Suspend
+	case *helmv2.HelmRelease:
+		return o.Spec.Suspend
+	}
+
+	return false
+}
+
+func kindOf(obj kube.Resource) string {
+	switch obj.(type) {
+	case *sourcev1.GitRepository:
+		return sourcev1.GitRepositoryKind
+	case *sourcev1.HelmRepository:
+		return sourcev1.HelmRepositoryKind
+	case *kustomizev1.Kustomization:
+		return kustomizev1.KustomizationKind
+	case *helmv2.HelmRelease:
+		return helmv2.HelmReleaseKind
+	case *corev1.Secret:
+		return "Secret"
+	case *corev1.ConfigMap:
+		return "ConfigMap"
+	}
+
+	return obj.GetObjectKind().GroupVersionKind().Kind
+}
+
+// getOptionalResource reads an object of the cluster, telling whether it exists:
+// the kube service leaves the object empty when it is not found
+func getOptionalResource(ctx context.Context, kubeService kube.Kube, name types.NamespacedName, obj kube.Resource) (bool, error) {
+	if err := kubeService.GetResource(ctx, name, obj); err != nil {
+		return false, fmt.Errorf("could not get %s %s: %w", kindOf(obj), name, err)
+	}
+
+	return obj.GetName() != "", nil
+}
@@ -0,0 +1,172 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1beta1"
+	"github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Sync", func() {
+	var (
+		application *wego.Application
+		requested   map[string]string
+		suspended   bool
+		reconciles  bool
+	)
+
+	BeforeEach(func() {
+		application = &wego.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-app", Namespace: "wego-system"},
+			Spec:       wego.ApplicationSpec{SourceType: wego.SourceTypeGit, DeploymentType: wego.DeploymentTypeKustomize},
+		}
+		requested = map[string]string{}
+		suspended = false
+		reconciles = true
+
+		kubeClient.GetApplicationStub = func(ctx context.Context, name types.NamespacedName) (*wego.Application, error) {
+			return application, nil
+		}
+
+		// The objects handle a reconcile request as soon as it is made, moving to the "new" revision
+		kubeClient.GetResourceStub = func(ctx context.Context, name types.NamespacedName, r kube.Resource) error {
+			r.SetName(name.Name)
+			r.SetNamespace(name.Namespace)
+
+			requestedAt := requested[kindOf(r)]
+			if !reconciles {
+				requestedAt = ""
+			}
+
+			revision := "main/old"
+			if requestedAt != "" {
+				revision = "main/new"
+			}
+
+			status := meta.ReconcileRequestStatus{LastHandledReconcileAt: requestedAt}
+			conditions := []metav1.Condition{{Type: meta.ReadyCondition, Status: metav1.ConditionTrue}}
+
+			switch obj := r.(type) {
+			case *sourcev1.GitRepository:
+				obj.Status.ReconcileRequestStatus = status
+				obj.Status.Conditions = conditions
+				obj.Status.Artifact = &sourcev1.Artifact{Revision: revision}
+			case *sourcev1.HelmRepository:
+				obj.Status.ReconcileRequestStatus = status
+				obj.Status.Conditions = conditions
+			case *kustomizev1.Kustomization:
+				obj.Spec.Suspend = suspended
+				obj.Status.ReconcileRequestStatus = status
+				obj.Status.Conditions = conditions
+				obj.Status.LastAppliedRevision = revision
+			case *helmv2.HelmRelease:
+				obj.Status.ReconcileRequestStatus = status
+				obj.Status.Conditions = conditions
+				obj.Status.LastAppliedRevision = revision
+			}
+
+			return nil
+		}
+
+		kubeClient.PatchResourceStub = func(ctx context.Context, r kube.Resource, patch client.Patch) error {
+			requested[kindOf(r)] = r.GetAnnotations()[meta.ReconcileRequestAnnotation]
+			return nil
+		}
+	})
+
+	It("requests the reconciliation of the source then of the automation", func() {
+		result, err := appSrv.Sync(SyncParams{Name: "my-app", Namespace: "wego-system"})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(kubeClient.PatchResourceCallCount()).To(Equal(2))
+
+		_, source, _ := kubeClient.PatchResourceArgsForCall(0)
+		Expect(source).To(BeAssignableToTypeOf(&sourcev1.GitRepository{}))
+		Expect(source.GetAnnotations()).To(HaveKey(meta.ReconcileRequestAnnotation))
+
+		_, automation, _ := kubeClient.PatchResourceArgsForCall(1)
+		Expect(automation).To(BeAssignableToTypeOf(&kustomizev1.Kustomization{}))
+
+		Expect(result).To(Equal(&SyncResult{
+			PreviousSourceRevision:  "main/old",
+			SourceRevision:          "main/old",
+			PreviousAppliedRevision: "main/old",
+			AppliedRevision:         "main/old",
+		}))
+	})
+
+	It("waits for the new revision", func() {
+		result, err := appSrv.Sync(SyncParams{Name: "my-app", Namespace: "wego-system", Wait: true})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(result).To(Equal(&SyncResult{
+			PreviousSourceRevision:  "main/old",
+			SourceRevision:          "main/new",
+			PreviousAppliedRevision: "main/old",
+			AppliedRevision:         "main/new",
+		}))
+	})
+
+	It("syncs the helm objects of a helm app", func() {
+		application.Spec.SourceType = wego.SourceTypeHelm
+		application.Spec.DeploymentType = wego.DeploymentTypeHelm
+
+		_, err := appSrv.Sync(SyncParams{Name: "my-app", Namespace: "wego-system", Wait: true})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(requested).To(HaveKey(sourcev1.HelmRepositoryKind))
+		Expect(requested).To(HaveKey(helmv2.HelmReleaseKind))
+	})
+
+	It("fails when the app is paused", func() {
+		suspended = true
+
+		_, err := appSrv.Sync(SyncParams{Name: "my-app", Namespace: "wego-system"})
+		Expect(err).To(MatchError(ContainSubstring(ErrAppPaused.Error())))
+		Expect(kubeClient.PatchResourceCallCount()).To(Equal(0))
+	})
+
+	It("times out when the request is not handled", func() {
+		reconciles = false
+
+		defer func(interval time.Duration) { syncPollInterval = interval }(syncPollInterval)
+		syncPollInterval = time.Millisecond
+
+		_, err := appSrv.Sync(SyncParams{Name: "my-app", Namespace: "wego-system", Wait: true, Timeout: 10 * time.Millisecond})
+		Expect(err).To(MatchError(ContainSubstring(ErrSyncTimeout.Error())))
+	})
+
+	It("bounds the whole sync with the timeout", func() {
+		defer func(interval time.Duration) { syncPollInterval = interval }(syncPollInterval)
+		syncPollInterval = time.Millisecond
+
+		getResource := kubeClient.GetResourceStub
+		start := time.Now()
+
+		// The source takes most of the timeout to reconcile and the automation never does
+		kubeClient.GetResourceStub = func(ctx context.Context, name types.NamespacedName, r kube.Resource) error {
+			if _, ok := r.(*kustomizev1.Kustomization); ok && requested[kustomizev1.KustomizationKind] != "" {
+				reconciles = false
+			} else {
+				reconciles = time.Since(start) > 150*time.Millisecond
+			}
+
+			return getResource(ctx, name, r)
+		}
+
+		_, err := appSrv.Sync(SyncParams{Name: "my-app", Namespace: "wego-system", Wait: true, Timeout: 200 * time.Millisecond})
+		Expect(err).To(MatchError(ContainSubstring(ErrSyncTimeout.Error())))
+		Expect(err).To(MatchError(ContainSubstring(kustomizev1.KustomizationKind)))
+		Expect(time.Since(start)).To(BeNumerically("<", 300*time.Millisecond))
+	})
+})
@@ -0,0 +1,319 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"fmt"
+	"strings"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/helm"
+	"github.com/weaveworks/weave-gitops/pkg/utils"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	UpdateCommitMessage = "Update App manifests"
+)
+
+// UpdateParams holds the fields of an application that can be changed.
+// Empty fields leave the current value untouched.
+type UpdateParams struct {
+	Name                       string
+	Namespace                  string
+	Branch                     string
+	Path                       string
+	Chart                      string
+	HelmReleaseTargetNamespace string
+	// ChartVersion replaces the semver constraint of the versions of the chart
+	ChartVersion string
+	// Kustomization holds the settings of the Kustomization of apps deployed with kustomize
+	Kustomization KustomizationParams
+	// DependsOn replaces the applications the app depends on when SetDependsOn is true, removing them when empty
+	DependsOn    []string
+	SetDependsOn bool
+	// Environments replaces the targets of the config repository the app is promoted through
+	Environments []string
+	// ValuesFiles and SetValues are merged over the current Helm values, in this order.
+	// ValuesFrom replaces the current values references.
+	ValuesFiles []string
+	SetValues   []string
+	ValuesFrom  []string
+	// ResetValues drops the current Helm values and values references first
+	ResetValues      bool
+	DryRun           bool
+	AutoMerge        bool
+	GitProviderToken string
+}
+
+// Update changes an existing application and regenerates its GitOps automation
+func (a *App) Update(params UpdateParams) error {
+	ctx := context.Background()
+
+	clusterName, err := a.Kube.GetClusterName(ctx)
+	if err != nil {
+		return err
+	}
+
+	current, err := a.Kube.GetApplication(ctx, types.NamespacedName{Namespace: params.Namespace, Name: params.Name})
+	if err != nil {
+		return fmt.Errorf("could not get application %s: %w", params.Name, err)
+	}
+
+	updated, err := updateWegoApplication(*current, params)
+	if err != nil {
+		return fmt.Errorf("could not update application %s: %w", params.Name, err)
+	}
+
+	original, err := updateWegoApplication(*current, UpdateParams{})
+	if err != nil {
+		return err
+	}
+
+	if equality.Semantic.DeepEqual(updated.Spec, original.Spec) {
+		a.Logger.Successf("App %s is up to date", params.Name)
+		return nil
+	}
+
+	info := getAppResourceInfo(updated, clusterName)
+
+	if info.configMode() == ConfigModeUserRepo && info.Spec.Branch != current.Spec.Branch {
+		return fmt.Errorf("the branch of an application whose automation is stored in the application repository cannot be changed; remove the application and add it again")
+	}
+
+	a.printUpdateSummary(info)
+
+	appHash := info.getAppHash()
+
+	apps, err := a.Kube.GetApplications(ctx, params.Namespace)
+	if err != nil {
+		return err
+	}
+
+	for _, app := range apps {
+		if app.Name == info.Name {
+			continue
+		}
+
+		if appHash == getAppResourceInfo(app, clusterName).getAppHash() {
+			return fmt.Errorf("unable to update application, application %s already deploys the same resources", app.Name)
+		}
+	}
+
+	if err := validateDependencies(updated, apps); err != nil {
+		return err
+	}
+
+	secretRef := ""
+
+	if info.Spec.SourceType != wego.SourceTypeHelm {
+		visibility, visibilityErr := a.GitProvider.GetRepoVisibility(info.Spec.URL)
+		if visibilityErr != nil {
+			return visibilityErr
+		}
+
+		if *visibility != gitprovider.RepositoryVisibilityPublic {
+			secretRef = info.gitSecretRef(info.Spec.URL)
+		}
+	}
+
+	source, appGoat, appSpec, err := a.generateAppManifests(info, secretRef, appHash)
+	if err != nil {
+		return fmt.Errorf("could not generate application GitOps Automation manifests: %w", err)
+	}
+
+	switch info.configMode() {
+	case ConfigModeClusterOnly:
+		a.Logger.Actionf("Applying manifests to the cluster")
+
+		return a.applyToCluster(info, params.DryRun, source, appGoat, appSpec)
+	case ConfigModeUserRepo:
+		return a.updateAppInRepo(info, params, info.Spec.URL, info.Spec.Branch, appHash, source, appGoat, appSpec, func(fname string) bool {
+			return strings.Contains(fname, ".wego")
+		})
+	default:
+		configBranch, err := a.GitProvider.GetDefaultBranch(info.Spec.ConfigURL)
+		if err != nil {
+			return fmt.Errorf("could not determine default branch for config repository: %w", err)
+		}
+
+		return a.updateAppInRepo(info, params, info.Spec.ConfigURL, configBranch, appHash, source, appGoat, appSpec)
+	}
+}
+
+// updateAppInRepo replaces the application manifests stored in the config repository,
+// either by opening a pull request or by pushing a commit straight to the branch
+func (a *App) updateAppInRepo(info *AppResourceInfo, params UpdateParams, repoURL, branch, appHash string, source, appGoat, appSpec []byte, filters ...func(string) bool) error {
+	if params.DryRun {
+		return a.applyToCluster(info, params.DryRun, source, appGoat, appSpec)
+	}
+
+	if !params.AutoMerge {
+		files := appManifestFiles(info, appSpec, source, appGoat)
+		title := fmt.Sprintf("gitops app update %s", info.Name)
+		description := fmt.Sprintf("Updated yamls for %s", info.Name)
+
+		return a.openPullRequest(repoURL, updateBranchName(appHash, appSpec, source, appGoat), title, description, files)
+	}
+
+	a.Logger.Actionf("Cloning %s", repoURL)
+
+	remover, err := a.cloneRepo(a.ConfigGit, info.gitURL(repoURL), branch, params.DryRun)
+	if err != nil {
+		return fmt.Errorf("failed to clone configuration repo: %w", err)
+	}
+
+	defer remover()
+
+	a.Logger.Actionf("Writing manifests to disk")
+
+	if err := a.writeAppYaml(info, appSpec); err != nil {
+		return fmt.Errorf("failed writing app.yaml to disk: %w", err)
+	}
+
+	if err := a.writeAppGoats(info, source, appGoat); err != nil {
+		return fmt.Errorf("failed writing application gitops manifests to disk: %w", err)
+	}
+
+	return a.commitAndPush(a.ConfigGit, UpdateCommitMessage, params.DryRun, filters...)
+}
+
+func (a *App) printUpdateSummary(info *AppResourceInfo) {
+	a.Logger.Println("Updating application:\n")
+	a.Logger.Println("Name: %s", info.Name)
+	a.Logger.Println("URL: %s", info.Spec.URL)
+	a.Logger.Println("Path: %s", info.Spec.Path)
+	a.Logger.Println("Branch: %s", info.Spec.Branch)
+	a.Logger.Println("Type: %s", info.Spec.DeploymentType)
+
+	if info.Spec.HelmChartVersion != "" {
+		a.Logger.Println("Chart version: %s", info.Spec.HelmChartVersion)
+	}
+
+	if info.Spec.HelmTargetNamespace != "" {
+		a.Logger.Println("Helm release target namespace: %s", info.Spec.HelmTargetNamespace)
+	}
+
+	a.Logger.Println("")
+}
+
+// updateWegoApplication returns a clean copy of the application with the requested changes applied
+func updateWegoApplication(current wego.Application, params UpdateParams) (wego.Application, error) {
+	gvk := wego.GroupVersion.WithKind(wego.ApplicationKind)
+	app := wego.Application{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       gvk.Kind,
+			APIVersion: gvk.GroupVersion().String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      current.Name,
+			Namespace: current.Namespace,
+		},
+		Spec: current.Spec,
+	}
+
+	// Apps created before these fields existed default to git and kustomize, same as 'gitops app add'
+	if app.Spec.SourceType == "" {
+		app.Spec.SourceType = wego.SourceTypeGit
+	}
+
+	if app.Spec.DeploymentType == "" {
+		app.Spec.DeploymentType = wego.DeploymentTypeKustomize
+	}
+
+	if params.Branch != "" {
+		if app.Spec.SourceType == wego.SourceTypeHelm {
+			return app, fmt.Errorf("--branch cannot be used with applications deployed from a helm repository")
+		}
+
+		app.Spec.Branch = params.Branch
+	}
+
+	if params.Path != "" {
+		if app.Spec.SourceType == wego.SourceTypeHelm {
+			return app, fmt.Errorf("--path cannot be used with applications deployed from a helm repository; use --chart instead")
+		}
+
+		app.Spec.Path = params.Path
+	}
+
+	if params.Chart != "" {
+		if app.Spec.SourceType != wego.SourceTypeHelm {
+			return app, fmt.Errorf("--chart can only be used with applications deployed from a helm repository")
+		}
+
+		app.Spec.Path = params.Chart
+	}
+
+	if params.ChartVersion != "" {
+		if app.Spec.SourceType != wego.SourceTypeHelm {
+			return app, fmt.Errorf("--chart-version can only be used with applications deployed from a helm repository")
+		}
+
+		if err := helm.ValidateVersionConstraint(params.ChartVersion); err != nil {
+			return app, err
+		}
+
+		app.Spec.HelmChartVersion = params.ChartVersion
+	}
+
+	if params.HelmReleaseTargetNamespace != "" {
+		if app.Spec.DeploymentType != wego.DeploymentTypeHelm {
+			return app, fmt.Errorf("--helm-release-target-namespace can only be used with helm applications")
+		}
+
+		if err := utils.ValidateNamespace(params.HelmReleaseTargetNamespace); err != nil {
+			return app, err
+		}
+
+		app.Spec.HelmTargetNamespace = params.HelmReleaseTargetNamespace
+	}
+
+	if params.ResetValues || len(params.ValuesFiles) > 0 || len(params.SetValues) > 0 || len(params.ValuesFrom) > 0 {
+		if app.Spec.DeploymentType != wego.DeploymentTypeHelm {
+			return app, fmt.Errorf("--values, --set, --values-from and --reset-values can only be used with helm applications")
+		}
+
+		if params.ResetValues {
+			app.Spec.HelmValues = nil
+			app.Spec.HelmValuesFrom = nil
+		}
+
+		if err := setHelmValues(&app, params.ValuesFiles, params.SetValues, params.ValuesFrom); err != nil {
+			return app, err
+		}
+	}
+
+	if err := setKustomizationSettings(&app, params.Kustomization); err != nil {
+		return app, err
+	}
+
+	if params.SetDependsOn {
+		app.Spec.DependsOn = nil
+
+		if len(params.DependsOn) > 0 {
+			app.Spec.DependsOn = params.DependsOn
+		}
+	}
+
+	if len(params.Environments) > 0 {
+		if err := validateEnvironments(params.Environments, app.Spec.ConfigURL); err != nil {
+			return app, err
+		}
+
+		app.Spec.Environments = params.Environments
+	}
+
+	return app, nil
+}
+
+// updateBranchName returns the name of the branch holding the changes of an update pull request.
+// The app hash alone is already used by the pull request opened when the app was added, so the
+// generated manifests are hashed as well to keep successive updates on separate branches.
+func updateBranchName(appHash string, manifests ...[]byte) string {
+	return fmt.Sprintf("%s-update-%x", appHash, md5.Sum(bytes.Join(manifests, nil)))[:len(appHash)+16]
+}
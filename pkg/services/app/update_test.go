@@ -0,0 +1,316 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("Update", func() {
+	var (
+		updateParams UpdateParams
+		existingApp  wego.Application
+	)
+
+	BeforeEach(func() {
+		updateParams = UpdateParams{
+			Name:      "bar",
+			Namespace: wego.DefaultNamespace,
+			Branch:    "develop",
+		}
+
+		existingApp = wego.Application{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "bar",
+				Namespace:       wego.DefaultNamespace,
+				ResourceVersion: "42",
+			},
+			Spec: wego.ApplicationSpec{
+				URL:            "ssh://git@github.com/foo/bar.git",
+				Branch:         "main",
+				Path:           "./kustomize",
+				ConfigURL:      "NONE",
+				SourceType:     wego.SourceTypeGit,
+				DeploymentType: wego.DeploymentTypeKustomize,
+			},
+		}
+
+		kubeClient.GetApplicationStub = func(_ context.Context, name types.NamespacedName) (*wego.Application, error) {
+			app := existingApp
+			return &app, nil
+		}
+
+		kubeClient.GetApplicationsStub = func(_ context.Context, _ string) ([]wego.Application, error) {
+			return []wego.Application{existingApp}, nil
+		}
+	})
+
+	It("does nothing when the application is up to date", func() {
+		updateParams.Branch = "main"
+
+		Expect(appSrv.Update(updateParams)).To(Succeed())
+
+		Expect(fluxClient.CreateSourceGitCallCount()).To(Equal(0))
+		Expect(kubeClient.ApplyCallCount()).To(Equal(0))
+	})
+
+	It("fails when the application does not exist", func() {
+		kubeClient.GetApplicationReturns(nil, fmt.Errorf("not found"))
+
+		Expect(appSrv.Update(updateParams)).To(MatchError(ContainSubstring("could not get application bar")))
+	})
+
+	It("fails when another application already deploys the same resources", func() {
+		other := existingApp
+		other.Name = "other"
+		other.Spec.Branch = "develop"
+
+		kubeClient.GetApplicationsReturns([]wego.Application{existingApp, other}, nil)
+
+		Expect(appSrv.Update(updateParams)).To(MatchError(ContainSubstring("application other already deploys the same resources")))
+	})
+
+	It("rejects a chart for a git application", func() {
+		updateParams.Chart = "loki"
+
+		Expect(appSrv.Update(updateParams)).To(MatchError(ContainSubstring("--chart can only be used")))
+	})
+
+	It("rejects an invalid chart version constraint", func() {
+		existingApp.Spec.SourceType = wego.SourceTypeHelm
+		existingApp.Spec.DeploymentType = wego.DeploymentTypeHelm
+
+		updateParams.Branch = ""
+		updateParams.ChartVersion = "not-a-version"
+
+		Expect(appSrv.Update(updateParams)).To(MatchError(ContainSubstring("invalid chart version constraint")))
+	})
+
+	Context("config mode is clusterOnly", func() {
+		It("regenerates the manifests and applies them to the cluster", func() {
+			fluxClient.CreateSourceGitReturns([]byte("git source"), nil)
+			fluxClient.CreateKustomizationReturns([]byte("kustomization"), nil)
+
+			Expect(appSrv.Update(updateParams)).To(Succeed())
+
+			Expect(fluxClient.CreateSourceGitCallCount()).To(Equal(1))
+			name, url, branch, secretRef, namespace := fluxClient.CreateSourceGitArgsForCall(0)
+			Expect(name).To(Equal("bar"))
+			Expect(url).To(Equal("ssh://git@github.com/foo/bar.git"))
+			Expect(branch).To(Equal("develop"))
+			Expect(secretRef).To(Equal("wego-test-cluster-bar"))
+			Expect(namespace).To(Equal(wego.DefaultNamespace))
+
+			Expect(kubeClient.ApplyCallCount()).To(Equal(3))
+
+			_, sourceManifest, _ := kubeClient.ApplyArgsForCall(0)
+			Expect(sourceManifest).To(Equal([]byte("git source")))
+
+			_, appManifest, _ := kubeClient.ApplyArgsForCall(2)
+			Expect(string(appManifest)).To(ContainSubstring("branch: develop"))
+			Expect(string(appManifest)).NotTo(ContainSubstring("resourceVersion"))
+
+			info := getAppResourceInfo(existingApp, "test-cluster")
+			info.Spec.Branch = "develop"
+			Expect(string(appManifest)).To(ContainSubstring(info.getAppHash()))
+		})
+
+		It("updates the kustomization settings", func() {
+			existingApp.Spec.Kustomization = &wego.KustomizationSettings{TargetNamespace: "podinfo"}
+
+			updateParams.Branch = ""
+			updateParams.Kustomization = KustomizationParams{HealthChecks: []string{"Deployment/podinfo.podinfo"}}
+
+			Expect(appSrv.Update(updateParams)).To(Succeed())
+
+			Expect(fluxClient.CreateKustomizationCallCount()).To(Equal(1))
+			_, _, _, _, opts := fluxClient.CreateKustomizationArgsForCall(0)
+			Expect(opts.TargetNamespace).To(Equal("podinfo"))
+			Expect(opts.HealthChecks).To(HaveLen(1))
+			Expect(opts.HealthChecks[0].Kind).To(Equal("Deployment"))
+		})
+
+		It("removes the dependencies on other applications", func() {
+			existingApp.Spec.DependsOn = []string{"redis"}
+
+			updateParams.Branch = ""
+			updateParams.SetDependsOn = true
+
+			Expect(appSrv.Update(updateParams)).To(Succeed())
+
+			_, _, _, _, opts := fluxClient.CreateKustomizationArgsForCall(0)
+			Expect(opts.DependsOn).To(BeEmpty())
+		})
+
+		It("rejects a dependency cycle", func() {
+			redis := existingApp
+			redis.Name = "redis"
+			redis.Spec.Path = "./redis"
+			redis.Spec.DependsOn = []string{"bar"}
+
+			kubeClient.GetApplicationsReturns([]wego.Application{existingApp, redis}, nil)
+
+			updateParams.Branch = ""
+			updateParams.SetDependsOn = true
+			updateParams.DependsOn = []string{"redis"}
+
+			Expect(appSrv.Update(updateParams)).To(MatchError(ContainSubstring("dependency cycle")))
+		})
+
+		It("rejects kustomization settings for a helm application", func() {
+			existingApp.Spec.DeploymentType = wego.DeploymentTypeHelm
+
+			updateParams.Kustomization = KustomizationParams{TargetNamespace: "podinfo"}
+
+			Expect(appSrv.Update(updateParams)).To(MatchError(ContainSubstring("can only be used with applications deployed with kustomize")))
+		})
+
+		It("updates the helm release target namespace", func() {
+			existingApp.Spec.URL = "https://charts.kube-ops.io"
+			existingApp.Spec.Path = "loki"
+			existingApp.Spec.SourceType = wego.SourceTypeHelm
+			existingApp.Spec.DeploymentType = wego.DeploymentTypeHelm
+
+			updateParams.Branch = ""
+			updateParams.HelmReleaseTargetNamespace = "sock-shop"
+
+			Expect(appSrv.Update(updateParams)).To(Succeed())
+
+			Expect(fluxClient.CreateHelmReleaseHelmRepositoryCallCount()).To(Equal(1))
+			name, chart, namespace, targetNamespace, _ := fluxClient.CreateHelmReleaseHelmRepositoryArgsForCall(0)
+			Expect(name).To(Equal("bar"))
+			Expect(chart).To(Equal("loki"))
+			Expect(namespace).To(Equal(wego.DefaultNamespace))
+			Expect(targetNamespace).To(Equal("sock-shop"))
+		})
+	})
+
+	Context("config mode is userRepo", func() {
+		BeforeEach(func() {
+			existingApp.Spec.ConfigURL = ""
+			gitProviders.CreatePullRequestToUserRepoReturns(dummyPullRequest{}, nil)
+			updateParams.Branch = ""
+			updateParams.Path = "./other"
+		})
+
+		It("opens a pull request with the new manifests", func() {
+			Expect(appSrv.Update(updateParams)).To(Succeed())
+
+			Expect(gitClient.CloneCallCount()).To(Equal(0))
+			Expect(gitProviders.CreatePullRequestToUserRepoCallCount()).To(Equal(1))
+
+			_, _, branch, files, _, title, _ := gitProviders.CreatePullRequestToUserRepoArgsForCall(0)
+			Expect(strings.HasPrefix(branch, "wego-")).To(BeTrue())
+			Expect(branch).To(ContainSubstring("-update-"))
+			Expect(title).To(Equal("gitops app update bar"))
+			Expect(files).To(HaveLen(3))
+			Expect(*files[0].Path).To(Equal(".wego/apps/bar/app.yaml"))
+			Expect(*files[0].Content).To(ContainSubstring("path: ./other"))
+		})
+
+		It("commits the new manifests when auto merge is enabled", func() {
+			updateParams.AutoMerge = true
+
+			Expect(appSrv.Update(updateParams)).To(Succeed())
+
+			Expect(gitClient.CloneCallCount()).To(Equal(1))
+			_, _, url, branch := gitClient.CloneArgsForCall(0)
+			Expect(url).To(Equal("ssh://git@github.com/foo/bar.git"))
+			Expect(branch).To(Equal("main"))
+
+			Expect(gitClient.WriteCallCount()).To(Equal(3))
+			path, _ := gitClient.WriteArgsForCall(0)
+			Expect(path).To(Equal(".wego/apps/bar/app.yaml"))
+
+			Expect(gitClient.CommitCallCount()).To(Equal(1))
+			commit, _ := gitClient.CommitArgsForCall(0)
+			Expect(commit.Message).To(Equal(UpdateCommitMessage))
+			Expect(gitClient.PushCallCount()).To(Equal(1))
+		})
+
+		It("does not allow changing the branch", func() {
+			updateParams.Branch = "develop"
+
+			Expect(appSrv.Update(updateParams)).To(MatchError(ContainSubstring("cannot be changed")))
+		})
+	})
+
+	Context("config mode is externalRepo", func() {
+		BeforeEach(func() {
+			existingApp.Spec.ConfigURL = "ssh://git@github.com/foo/config.git"
+			gitProviders.GetDefaultBranchReturns("config-branch", nil)
+			gitProviders.CreatePullRequestToUserRepoReturns(dummyPullRequest{}, nil)
+		})
+
+		It("opens a pull request against the config repository", func() {
+			Expect(appSrv.Update(updateParams)).To(Succeed())
+
+			Expect(gitProviders.CreatePullRequestToUserRepoCallCount()).To(Equal(1))
+
+			repoRef, targetBranch, _, files, _, _, _ := gitProviders.CreatePullRequestToUserRepoArgsForCall(0)
+			Expect(repoRef.RepositoryName).To(Equal("config"))
+			Expect(targetBranch).To(Equal("config-branch"))
+			Expect(*files[1].Path).To(Equal("targets/test-cluster/bar/bar-gitops-source.yaml"))
+		})
+
+		It("opens a pull request changing the helm values", func() {
+			existingApp.Spec.URL = "https://charts.kube-ops.io"
+			existingApp.Spec.Path = "loki"
+			existingApp.Spec.SourceType = wego.SourceTypeHelm
+			existingApp.Spec.DeploymentType = wego.DeploymentTypeHelm
+			existingApp.Spec.HelmValues = &apiextensionsv1.JSON{Raw: []byte(`{"image":{"tag":"v1"},"replicaCount":1}`)}
+
+			updateParams.Branch = ""
+			updateParams.SetValues = []string{"replicaCount=3"}
+
+			Expect(appSrv.Update(updateParams)).To(Succeed())
+
+			_, _, _, _, opts := fluxClient.CreateHelmReleaseHelmRepositoryArgsForCall(0)
+			Expect(string(opts.Values.Raw)).To(Equal(`{"image":{"tag":"v1"},"replicaCount":3}`))
+
+			Expect(gitProviders.CreatePullRequestToUserRepoCallCount()).To(Equal(1))
+			_, _, _, files, _, _, _ := gitProviders.CreatePullRequestToUserRepoArgsForCall(0)
+			Expect(*files[0].Content).To(ContainSubstring("replicaCount: 3"))
+		})
+
+		It("opens a pull request bumping the chart version constraint", func() {
+			existingApp.Spec.URL = "https://charts.kube-ops.io"
+			existingApp.Spec.Path = "loki"
+			existingApp.Spec.SourceType = wego.SourceTypeHelm
+			existingApp.Spec.DeploymentType = wego.DeploymentTypeHelm
+			existingApp.Spec.HelmChartVersion = "2.7.0"
+
+			updateParams.Branch = ""
+			updateParams.ChartVersion = "2.8.1"
+
+			Expect(appSrv.Update(updateParams)).To(Succeed())
+
+			_, _, _, _, opts := fluxClient.CreateHelmReleaseHelmRepositoryArgsForCall(0)
+			Expect(opts.Version).To(Equal("2.8.1"))
+
+			Expect(gitProviders.CreatePullRequestToUserRepoCallCount()).To(Equal(1))
+			_, _, _, files, _, _, _ := gitProviders.CreatePullRequestToUserRepoArgsForCall(0)
+			Expect(*files[0].Content).To(ContainSubstring("helm_chart_version: 2.8.1"))
+		})
+
+		It("commits to the default branch of the config repository when auto merge is enabled", func() {
+			updateParams.AutoMerge = true
+
+			Expect(appSrv.Update(updateParams)).To(Succeed())
+
+			_, _, url, branch := gitClient.CloneArgsForCall(0)
+			Expect(url).To(Equal("ssh://git@github.com/foo/config.git"))
+			Expect(branch).To(Equal("config-branch"))
+
+			Expect(gitClient.CommitCallCount()).To(Equal(1))
+			Expect(gitClient.PushCallCount()).To(Equal(1))
+		})
+	})
+})
@@ -0,0 +1,65 @@
+package app
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/helm"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// ChartVersions returns the versions of the chart of an app published in its Helm repository, newest first
+func (a *App) ChartVersions(name types.NamespacedName) ([]string, error) {
+	app, err := a.Kube.GetApplication(a.Context, name)
+	if err != nil {
+		return nil, fmt.Errorf("could not get application %q: %w", name.Name, err)
+	}
+
+	switch app.Spec.SourceType {
+	case wego.SourceTypeHelm:
+		httpClient, err := a.helmRepositoryClient(app)
+		if err != nil {
+			return nil, err
+		}
+
+		return helm.ChartVersions(a.Context, httpClient, app.Spec.URL, app.Spec.Path)
+	default:
+		return nil, fmt.Errorf("application %s is not deployed from a helm repository", name.Name)
+	}
+}
+
+// helmRepositoryClient returns an HTTP client reading the Helm repository of an app with the timeout and the
+// credentials of its HelmRepository
+func (a *App) helmRepositoryClient(app *wego.Application) (*http.Client, error) {
+	name := types.NamespacedName{Name: getAppResourceInfo(*app, "").appSourceName(), Namespace: app.Namespace}
+
+	repo := &sourcev1.HelmRepository{}
+	if err := a.Kube.GetResource(a.Context, name, repo); err != nil {
+		return nil, fmt.Errorf("could not get HelmRepository %s: %w", name, err)
+	}
+
+	var timeout time.Duration
+	if repo.Spec.Timeout != nil {
+		timeout = repo.Spec.Timeout.Duration
+	}
+
+	if repo.Spec.SecretRef == nil {
+		return helm.NewRepositoryClient(nil, timeout)
+	}
+
+	secretName := types.NamespacedName{Name: repo.Spec.SecretRef.Name, Namespace: app.Namespace}
+
+	secret, err := a.Kube.GetSecret(a.Context, secretName)
+	if err != nil {
+		return nil, fmt.Errorf("could not get the credentials of HelmRepository %s: %w", name, err)
+	}
+
+	if secret == nil {
+		return nil, fmt.Errorf("secret %s of HelmRepository %s not found", secretName, name)
+	}
+
+	return helm.NewRepositoryClient(secret, timeout)
+}
@@ -0,0 +1,145 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const chartIndex = `apiVersion: v1
+entries:
+  loki:
+  - version: 2.7.0
+  - version: 2.10.0
+  - version: 2.8.1
+  - version: not-semver
+  promtail:
+  - version: 3.0.0
+`
+
+var _ = Describe("ChartVersions", func() {
+	var (
+		server      *httptest.Server
+		application *wego.Application
+		repo        *sourcev1.HelmRepository
+		secret      *corev1.Secret
+		delay       time.Duration
+		name        = types.NamespacedName{Name: "loki", Namespace: wego.DefaultNamespace}
+	)
+
+	BeforeEach(func() {
+		delay = 0
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(delay)
+
+			if secret != nil {
+				if username, password, ok := r.BasicAuth(); !ok || username != "user" || password != "pass" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+			}
+
+			if r.URL.Path != "/index.yaml" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			_, _ = w.Write([]byte(chartIndex))
+		}))
+
+		repo = &sourcev1.HelmRepository{ObjectMeta: metav1.ObjectMeta{Name: "loki", Namespace: wego.DefaultNamespace}}
+		secret = nil
+
+		kubeClient.GetResourceStub = func(_ context.Context, _ types.NamespacedName, r kube.Resource) error {
+			if hr, ok := r.(*sourcev1.HelmRepository); ok {
+				repo.DeepCopyInto(hr)
+			}
+
+			return nil
+		}
+
+		kubeClient.GetSecretStub = func(context.Context, types.NamespacedName) (*corev1.Secret, error) {
+			return secret, nil
+		}
+
+		application = &wego.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "loki", Namespace: wego.DefaultNamespace},
+			Spec: wego.ApplicationSpec{
+				URL:            server.URL + "/",
+				Path:           "loki",
+				SourceType:     wego.SourceTypeHelm,
+				DeploymentType: wego.DeploymentTypeHelm,
+			},
+		}
+
+		kubeClient.GetApplicationStub = func(context.Context, types.NamespacedName) (*wego.Application, error) {
+			return application, nil
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("lists the semantic versions of the chart, newest first", func() {
+		versions, err := appSrv.ChartVersions(name)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(versions).To(Equal([]string{"2.10.0", "2.8.1", "2.7.0"}))
+	})
+
+	It("fails when the chart is not in the repository", func() {
+		application.Spec.Path = "grafana"
+
+		_, err := appSrv.ChartVersions(name)
+		Expect(err).To(MatchError(ContainSubstring("chart grafana not found")))
+	})
+
+	It("reads the repository with the credentials of the secret of the HelmRepository", func() {
+		repo.Spec.SecretRef = &meta.LocalObjectReference{Name: "loki-auth"}
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "loki-auth", Namespace: wego.DefaultNamespace},
+			Data:       map[string][]byte{"username": []byte("user"), "password": []byte("pass")},
+		}
+
+		versions, err := appSrv.ChartVersions(name)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(versions).To(HaveLen(3))
+
+		_, secretName := kubeClient.GetSecretArgsForCall(0)
+		Expect(secretName).To(Equal(types.NamespacedName{Name: "loki-auth", Namespace: wego.DefaultNamespace}))
+	})
+
+	It("fails when the secret of the HelmRepository is not found", func() {
+		repo.Spec.SecretRef = &meta.LocalObjectReference{Name: "loki-auth"}
+
+		_, err := appSrv.ChartVersions(name)
+		Expect(err).To(MatchError(ContainSubstring("secret wego-system/loki-auth of HelmRepository wego-system/loki not found")))
+	})
+
+	It("gives up after the timeout of the HelmRepository", func() {
+		repo.Spec.Timeout = &metav1.Duration{Duration: 10 * time.Millisecond}
+		delay = 100 * time.Millisecond
+
+		_, err := appSrv.ChartVersions(name)
+		Expect(err).To(MatchError(ContainSubstring("Client.Timeout exceeded")))
+	})
+
+	It("fails for git applications", func() {
+		application.Spec.SourceType = wego.SourceTypeGit
+
+		_, err := appSrv.ChartVersions(name)
+		Expect(err).To(MatchError(ContainSubstring("is not deployed from a helm repository")))
+	})
+})
@@ -0,0 +1,181 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/flux"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// The kinds of objects Helm values are read from, as given to --values-from
+const (
+	valuesFromConfigMap = "configmap"
+	valuesFromSecret    = "secret"
+)
+
+// setHelmValues records the Helm values given on the command line on the spec of an app. The values
+// are merged over the current ones, while the values references replace the current ones when given.
+func setHelmValues(app *wego.Application, files []string, set []string, valuesFrom []string) error {
+	values, err := mergeHelmValues(app.Spec.HelmValues, files, set)
+	if err != nil {
+		return err
+	}
+
+	app.Spec.HelmValues = values
+
+	if len(valuesFrom) > 0 {
+		refs, err := parseValuesFrom(valuesFrom)
+		if err != nil {
+			return err
+		}
+
+		app.Spec.HelmValuesFrom = refs
+	}
+
+	return nil
+}
+
+// mergeHelmValues merges the values files then the --set values over the current values, later values
+// overriding earlier ones. It returns nil when there are no values at all.
+func mergeHelmValues(current *apiextensionsv1.JSON, files []string, set []string) (*apiextensionsv1.JSON, error) {
+	values := map[string]interface{}{}
+
+	if current != nil && len(current.Raw) > 0 {
+		if err := json.Unmarshal(current.Raw, &values); err != nil {
+			return nil, fmt.Errorf("could not read the current helm values: %w", err)
+		}
+	}
+
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("could not read values file %s: %w", file, err)
+		}
+
+		fileValues := map[string]interface{}{}
+		if err := yaml.Unmarshal(data, &fileValues); err != nil {
+			return nil, fmt.Errorf("could not parse values file %s: %w", file, err)
+		}
+
+		mergeValues(values, fileValues)
+	}
+
+	for _, value := range set {
+		if err := setValue(values, value); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	raw, err := json.Marshal(values)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal helm values: %w", err)
+	}
+
+	return &apiextensionsv1.JSON{Raw: raw}, nil
+}
+
+// mergeValues merges src into dst, merging nested tables and replacing any other value
+func mergeValues(dst, src map[string]interface{}) {
+	for key, value := range src {
+		srcTable, srcIsTable := value.(map[string]interface{})
+		dstTable, dstIsTable := dst[key].(map[string]interface{})
+
+		if srcIsTable && dstIsTable {
+			mergeValues(dstTable, srcTable)
+			continue
+		}
+
+		dst[key] = value
+	}
+}
+
+// setValue sets a value given as <key>=<value>, where the key is a dotted path of tables as with helm --set.
+// The value is read as YAML so that numbers and booleans keep their type.
+func setValue(values map[string]interface{}, value string) error {
+	parts := strings.SplitN(value, "=", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		return fmt.Errorf("invalid value %q, must be of the form <key>=<value>", value)
+	}
+
+	var parsed interface{}
+	if err := yaml.Unmarshal([]byte(parts[1]), &parsed); err != nil || parsed == nil {
+		parsed = parts[1]
+	}
+
+	keys := strings.Split(parts[0], ".")
+	table := values
+
+	for _, key := range keys[:len(keys)-1] {
+		next, ok := table[key].(map[string]interface{})
+		if !ok {
+			next = map[string]interface{}{}
+			table[key] = next
+		}
+
+		table = next
+	}
+
+	table[keys[len(keys)-1]] = parsed
+
+	return nil
+}
+
+// parseValuesFrom reads the objects holding Helm values given as <configmap|secret>/<name>[:<values key>]
+func parseValuesFrom(refs []string) ([]wego.HelmValuesReference, error) {
+	result := []wego.HelmValuesReference{}
+
+	for _, ref := range refs {
+		parts := strings.SplitN(ref, "/", 2)
+		if len(parts) != 2 || parts[1] == "" {
+			return nil, fmt.Errorf("invalid values reference %q, must be of the form <configmap|secret>/<name>[:<values key>]", ref)
+		}
+
+		valuesRef := wego.HelmValuesReference{Name: parts[1]}
+
+		if i := strings.Index(valuesRef.Name, ":"); i >= 0 {
+			valuesRef.Name, valuesRef.ValuesKey = valuesRef.Name[:i], valuesRef.Name[i+1:]
+		}
+
+		switch strings.ToLower(parts[0]) {
+		case valuesFromConfigMap:
+			valuesRef.Kind = "ConfigMap"
+		case valuesFromSecret:
+			valuesRef.Kind = "Secret"
+		default:
+			return nil, fmt.Errorf("invalid values reference %q, values can be read from a configmap or a secret", ref)
+		}
+
+		result = append(result, valuesRef)
+	}
+
+	return result, nil
+}
+
+// helmReleaseOptions returns the settings of the HelmRelease of an app
+func helmReleaseOptions(info *AppResourceInfo) flux.HelmReleaseOptions {
+	opts := flux.HelmReleaseOptions{
+		Values:    info.Spec.HelmValues,
+		Version:   info.Spec.HelmChartVersion,
+		DependsOn: appDependencies(info),
+	}
+
+	for _, ref := range info.Spec.HelmValuesFrom {
+		opts.ValuesFrom = append(opts.ValuesFrom, helmv2.ValuesReference{
+			Kind:      ref.Kind,
+			Name:      ref.Name,
+			ValuesKey: ref.ValuesKey,
+		})
+	}
+
+	return opts
+}
@@ -0,0 +1,66 @@
+package app
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+var _ = Describe("Helm values", func() {
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "values-")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	writeValues := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		Expect(ioutil.WriteFile(path, []byte(content), 0600)).To(Succeed())
+
+		return path
+	}
+
+	It("merges the values files then the set values over the current values", func() {
+		current := &apiextensionsv1.JSON{Raw: []byte(`{"image":{"repository":"loki","tag":"v1"},"replicaCount":1}`)}
+		first := writeValues("first.yaml", "image:\n  tag: v2\npersistence:\n  enabled: false\n")
+		second := writeValues("second.yaml", "persistence:\n  enabled: true\n")
+
+		values, err := mergeHelmValues(current, []string{first, second}, []string{"replicaCount=3", "ingress.host=loki.example.com"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(values.Raw)).To(Equal(`{"image":{"repository":"loki","tag":"v2"},"ingress":{"host":"loki.example.com"},"persistence":{"enabled":true},"replicaCount":3}`))
+	})
+
+	It("returns no values when none are given", func() {
+		values, err := mergeHelmValues(nil, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(values).To(BeNil())
+	})
+
+	It("rejects set values without a key", func() {
+		_, err := mergeHelmValues(nil, nil, []string{"=3"})
+		Expect(err).To(MatchError(ContainSubstring("must be of the form <key>=<value>")))
+	})
+
+	It("parses the values references", func() {
+		refs, err := parseValuesFrom([]string{"configmap/loki-values", "Secret/loki-secrets:overrides.yaml"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(refs).To(Equal([]wego.HelmValuesReference{
+			{Kind: "ConfigMap", Name: "loki-values"},
+			{Kind: "Secret", Name: "loki-secrets", ValuesKey: "overrides.yaml"},
+		}))
+
+		_, err = parseValuesFrom([]string{"deployment/loki"})
+		Expect(err).To(MatchError(ContainSubstring("values can be read from a configmap or a secret")))
+	})
+})
@@ -5,13 +5,17 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"os"
 
 	"github.com/weaveworks/weave-gitops/pkg/services/auth/internal"
 
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
 	"github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
 	"github.com/weaveworks/weave-gitops/pkg/flux"
 	"github.com/weaveworks/weave-gitops/pkg/git"
 	"github.com/weaveworks/weave-gitops/pkg/git/wrapper"
+	"github.com/weaveworks/weave-gitops/pkg/gitcredentials"
 	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
 	"github.com/weaveworks/weave-gitops/pkg/logger"
 	"github.com/weaveworks/weave-gitops/pkg/osys"
@@ -26,7 +30,8 @@ import (
 // BlockingCLIAuthHandler takes over the terminal experience and returns a token when the user completes the flow.
 type BlockingCLIAuthHandler func(context.Context, io.Writer) (string, error)
 
-func NewAuthCLIHandler(name gitproviders.GitProviderName) (BlockingCLIAuthHandler, error) {
+// NewAuthCLIHandler returns the CLI auth flow of the git provider. The hostname is only used by self-hosted providers.
+func NewAuthCLIHandler(name gitproviders.GitProviderName, hostname string) (BlockingCLIAuthHandler, error) {
 	switch name {
 	case gitproviders.GitProviderGitHub:
 		return NewGithubDeviceFlowHandler(http.DefaultClient), nil
@@ -37,6 +42,10 @@ func NewAuthCLIHandler(name gitproviders.GitProviderName) (BlockingCLIAuthHandle
 		}
 
 		return NewGitlabAuthFlowHandler(http.DefaultClient, authFlow), nil
+	case gitproviders.GitProviderBitbucketServer:
+		return NewBitbucketServerTokenFlowHandler(http.DefaultClient, hostname, os.Stdin), nil
+	case gitproviders.GitProviderGitea:
+		return NewGiteaTokenFlowHandler(http.DefaultClient, hostname, os.Stdin), nil
 	}
 
 	return nil, fmt.Errorf("unsupported auth provider \"%s\"", name)
@@ -45,7 +54,9 @@ func NewAuthCLIHandler(name gitproviders.GitProviderName) (BlockingCLIAuthHandle
 // GetGitProvider returns a GitProvider containing either the token stored in the <git provider>_TOKEN env var
 // or a token retrieved via the CLI auth flow
 func GetGitProvider(ctx context.Context, normalizedUrl gitproviders.NormalizedRepoURL) (gitproviders.GitProvider, error) {
-	authHandler, authErr := NewAuthCLIHandler(normalizedUrl.Provider())
+	hostname := normalizedUrl.URL().Hostname()
+
+	authHandler, authErr := NewAuthCLIHandler(normalizedUrl.Provider(), hostname)
 	if authErr != nil {
 		return nil, fmt.Errorf("could not get auth handler for provider %s: %w", normalizedUrl.Provider(), authErr)
 	}
@@ -53,12 +64,13 @@ func GetGitProvider(ctx context.Context, normalizedUrl gitproviders.NormalizedRe
 	osysClient := osys.New()
 	logger := logger.NewCLILogger(osysClient.Stdout())
 
-	return getGitProviderWithClients(ctx, normalizedUrl.Provider(), osysClient, authHandler, logger)
+	return getGitProviderWithClients(ctx, normalizedUrl.Provider(), hostname, osysClient, authHandler, logger)
 }
 
 func getGitProviderWithClients(
 	ctx context.Context,
 	providerName gitproviders.GitProviderName,
+	hostname string,
 	osysClient osys.Osys,
 	authHandler BlockingCLIAuthHandler,
 	logger logger.Logger) (gitproviders.GitProvider, error) {
@@ -84,7 +96,14 @@ func getGitProviderWithClients(
 		return nil, fmt.Errorf("could not get access token: %w", err)
 	}
 
-	provider, err := gitproviders.New(gitproviders.Config{Provider: providerName, Token: token})
+	config := gitproviders.Config{Provider: providerName, Token: token}
+
+	// GitHub and GitLab clients default to their SaaS domain
+	if providerName == gitproviders.GitProviderBitbucketServer || providerName == gitproviders.GitProviderGitea {
+		config.Hostname = hostname
+	}
+
+	provider, err := gitproviders.New(config)
 	if err != nil {
 		return nil, fmt.Errorf("error creating git provider client: %w", err)
 	}
@@ -103,6 +122,10 @@ func getTokenVarName(providerName gitproviders.GitProviderName) (string, error)
 		return "GITHUB_TOKEN", nil
 	case gitproviders.GitProviderGitLab:
 		return "GITLAB_TOKEN", nil
+	case gitproviders.GitProviderBitbucketServer:
+		return "BITBUCKET_SERVER_TOKEN", nil
+	case gitproviders.GitProviderGitea:
+		return "GITEA_TOKEN", nil
 	default:
 		return "", fmt.Errorf("unknown git provider: %q", providerName)
 	}
@@ -124,8 +147,16 @@ func (sn SecretName) NamespacedName() types.NamespacedName {
 	}
 }
 
+// GitCredentials is how an app accesses its git repositories, and the Secret holding the credentials
+// of the https and github-app git auth
+type GitCredentials struct {
+	Auth       wego.GitAuthType
+	SecretName string
+}
+
 type AuthService interface {
 	CreateGitClient(ctx context.Context, repoUrl gitproviders.NormalizedRepoURL, targetName string, namespace string) (git.Git, error)
+	CreateGitClientWithCredentials(ctx context.Context, repoUrl gitproviders.NormalizedRepoURL, targetName string, namespace string, creds GitCredentials) (git.Git, error)
 	GetGitProvider() gitproviders.GitProvider
 }
 
@@ -176,6 +207,39 @@ func (a *authSvc) CreateGitClient(ctx context.Context, repoUrl gitproviders.Norm
 	return git.New(pubKey, wrapper.NewGoGit()), nil
 }
 
+// CreateGitClientWithCredentials creates a git.Git client authenticating as the git auth of an app.
+// Deploy keys are set up as CreateGitClient does, the other git auth read the credentials from the Secret
+// of the app, exchanging the credentials of a GitHub App for an installation token.
+func (a *authSvc) CreateGitClientWithCredentials(ctx context.Context, repoUrl gitproviders.NormalizedRepoURL, targetName string, namespace string, creds GitCredentials) (git.Git, error) {
+	secretName := types.NamespacedName{Name: creds.SecretName, Namespace: namespace}
+
+	switch creds.Auth {
+	case "", wego.GitAuthDeployKey:
+		return a.CreateGitClient(ctx, repoUrl, targetName, namespace)
+	case wego.GitAuthHTTPS:
+		secret := &corev1.Secret{}
+		if err := a.k8sClient.Get(ctx, secretName, secret); err != nil {
+			return nil, fmt.Errorf("could not get git credentials secret %s: %w", secretName, err)
+		}
+
+		return git.New(&githttp.BasicAuth{
+			Username: string(secret.Data[gitcredentials.UsernameKey]),
+			Password: string(secret.Data[gitcredentials.PasswordKey]),
+		}, wrapper.NewGoGit()), nil
+	case wego.GitAuthGitHubApp:
+		issuer := &GitHubAppTokenIssuer{KubeClient: a.k8sClient}
+
+		token, _, err := issuer.Token(ctx, secretName)
+		if err != nil {
+			return nil, fmt.Errorf("could not get github app installation token: %w", err)
+		}
+
+		return git.New(&githttp.BasicAuth{Username: gitcredentials.GitHubAppTokenUsername, Password: token}, wrapper.NewGoGit()), nil
+	}
+
+	return nil, fmt.Errorf("unknown git auth %q", creds.Auth)
+}
+
 // setupDeployKey creates a git.Git client instrumented with existing or generated deploy keys.
 // This ensures that git operations are done with stored deploy keys instead of a user's local ssh-agent or equivalent.
 func (a *authSvc) setupDeployKey(ctx context.Context, name SecretName, targetName string, repo gitproviders.NormalizedRepoURL) (*ssh.PublicKeys, error) {
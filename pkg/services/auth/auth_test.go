@@ -133,7 +133,7 @@ var _ = Describe("auth", func() {
 
 			It("generates an error if an invalid provider name is passed", func() {
 				osysClient = &osysfakes.FakeOsys{}
-				_, err := getGitProviderWithClients(context.Background(), gitproviders.GitProviderName("badname"), osysClient, authHandler, logger)
+				_, err := getGitProviderWithClients(context.Background(), gitproviders.GitProviderName("badname"), "", osysClient, authHandler, logger)
 				Expect(err.Error()).To(ContainSubstring(`unknown git provider: "badname"`))
 			})
 
@@ -147,14 +147,16 @@ var _ = Describe("auth", func() {
 				})
 
 				DescribeTable("generates correct token info messages", func(providerName gitproviders.GitProviderName, msgArg string) {
-					_, err := getGitProviderWithClients(context.Background(), providerName, osysClient, authHandler, logger)
+					_, err := getGitProviderWithClients(context.Background(), providerName, "git.example.com", osysClient, authHandler, logger)
 					Expect(err).ShouldNot(HaveOccurred())
 					fmtArg, restArgs := logger.WarningfArgsForCall(0)
 					Expect(fmtArg).Should(Equal("Setting the %q environment variable to a valid token will allow ongoing use of the CLI without requiring a browser-based auth flow...\n"))
 					Expect(restArgs[0]).Should(Equal(msgArg))
 				},
 					Entry("token for GitHub", gitproviders.GitProviderGitHub, "GITHUB_TOKEN"),
-					Entry("token for GitLab", gitproviders.GitProviderGitLab, "GITLAB_TOKEN"))
+					Entry("token for GitLab", gitproviders.GitProviderGitLab, "GITLAB_TOKEN"),
+					Entry("token for Bitbucket Server", gitproviders.GitProviderBitbucketServer, "BITBUCKET_SERVER_TOKEN"),
+					Entry("token for Gitea", gitproviders.GitProviderGitea, "GITEA_TOKEN"))
 			})
 
 			Context("displays no message if token is set", func() {
@@ -167,12 +169,14 @@ var _ = Describe("auth", func() {
 				})
 
 				DescribeTable("generates no message if token set", func(providerName gitproviders.GitProviderName) {
-					_, err := getGitProviderWithClients(context.Background(), providerName, osysClient, authHandler, logger)
+					_, err := getGitProviderWithClients(context.Background(), providerName, "git.example.com", osysClient, authHandler, logger)
 					Expect(err).ShouldNot(HaveOccurred())
 					Expect(logger.WarningfCallCount()).To(Equal(0))
 				},
 					Entry("GitHub", gitproviders.GitProviderGitHub),
-					Entry("GitLab", gitproviders.GitProviderGitHub))
+					Entry("GitLab", gitproviders.GitProviderGitHub),
+					Entry("Bitbucket Server", gitproviders.GitProviderBitbucketServer),
+					Entry("Gitea", gitproviders.GitProviderGitea))
 			})
 		})
 	})
@@ -10,6 +10,20 @@ import (
 )
 
 type FakeJWTClient struct {
+	GenerateAccessJWTStub        func(time.Duration, auth.Claims) (string, error)
+	generateAccessJWTMutex       sync.RWMutex
+	generateAccessJWTArgsForCall []struct {
+		arg1 time.Duration
+		arg2 auth.Claims
+	}
+	generateAccessJWTReturns struct {
+		result1 string
+		result2 error
+	}
+	generateAccessJWTReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	GenerateJWTStub        func(time.Duration, gitproviders.GitProviderName, string) (string, error)
 	generateJWTMutex       sync.RWMutex
 	generateJWTArgsForCall []struct {
@@ -25,6 +39,61 @@ type FakeJWTClient struct {
 		result1 string
 		result2 error
 	}
+	GenerateRefreshJWTStub        func(time.Duration, auth.Claims) (string, error)
+	generateRefreshJWTMutex       sync.RWMutex
+	generateRefreshJWTArgsForCall []struct {
+		arg1 time.Duration
+		arg2 auth.Claims
+	}
+	generateRefreshJWTReturns struct {
+		result1 string
+		result2 error
+	}
+	generateRefreshJWTReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	GenerateUserJWTStub        func(time.Duration, string, []string) (string, error)
+	generateUserJWTMutex       sync.RWMutex
+	generateUserJWTArgsForCall []struct {
+		arg1 time.Duration
+		arg2 string
+		arg3 []string
+	}
+	generateUserJWTReturns struct {
+		result1 string
+		result2 error
+	}
+	generateUserJWTReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	RefreshJWTStub        func(string) (string, string, error)
+	refreshJWTMutex       sync.RWMutex
+	refreshJWTArgsForCall []struct {
+		arg1 string
+	}
+	refreshJWTReturns struct {
+		result1 string
+		result2 string
+		result3 error
+	}
+	refreshJWTReturnsOnCall map[int]struct {
+		result1 string
+		result2 string
+		result3 error
+	}
+	RevokeJWTStub        func(string) error
+	revokeJWTMutex       sync.RWMutex
+	revokeJWTArgsForCall []struct {
+		arg1 string
+	}
+	revokeJWTReturns struct {
+		result1 error
+	}
+	revokeJWTReturnsOnCall map[int]struct {
+		result1 error
+	}
 	VerifyJWTStub        func(string) (*auth.Claims, error)
 	verifyJWTMutex       sync.RWMutex
 	verifyJWTArgsForCall []struct {
@@ -42,6 +111,71 @@ type FakeJWTClient struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeJWTClient) GenerateAccessJWT(arg1 time.Duration, arg2 auth.Claims) (string, error) {
+	fake.generateAccessJWTMutex.Lock()
+	ret, specificReturn := fake.generateAccessJWTReturnsOnCall[len(fake.generateAccessJWTArgsForCall)]
+	fake.generateAccessJWTArgsForCall = append(fake.generateAccessJWTArgsForCall, struct {
+		arg1 time.Duration
+		arg2 auth.Claims
+	}{arg1, arg2})
+	stub := fake.GenerateAccessJWTStub
+	fakeReturns := fake.generateAccessJWTReturns
+	fake.recordInvocation("GenerateAccessJWT", []interface{}{arg1, arg2})
+	fake.generateAccessJWTMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJWTClient) GenerateAccessJWTCallCount() int {
+	fake.generateAccessJWTMutex.RLock()
+	defer fake.generateAccessJWTMutex.RUnlock()
+	return len(fake.generateAccessJWTArgsForCall)
+}
+
+func (fake *FakeJWTClient) GenerateAccessJWTCalls(stub func(time.Duration, auth.Claims) (string, error)) {
+	fake.generateAccessJWTMutex.Lock()
+	defer fake.generateAccessJWTMutex.Unlock()
+	fake.GenerateAccessJWTStub = stub
+}
+
+func (fake *FakeJWTClient) GenerateAccessJWTArgsForCall(i int) (time.Duration, auth.Claims) {
+	fake.generateAccessJWTMutex.RLock()
+	defer fake.generateAccessJWTMutex.RUnlock()
+	argsForCall := fake.generateAccessJWTArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeJWTClient) GenerateAccessJWTReturns(result1 string, result2 error) {
+	fake.generateAccessJWTMutex.Lock()
+	defer fake.generateAccessJWTMutex.Unlock()
+	fake.GenerateAccessJWTStub = nil
+	fake.generateAccessJWTReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJWTClient) GenerateAccessJWTReturnsOnCall(i int, result1 string, result2 error) {
+	fake.generateAccessJWTMutex.Lock()
+	defer fake.generateAccessJWTMutex.Unlock()
+	fake.GenerateAccessJWTStub = nil
+	if fake.generateAccessJWTReturnsOnCall == nil {
+		fake.generateAccessJWTReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.generateAccessJWTReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJWTClient) GenerateJWT(arg1 time.Duration, arg2 gitproviders.GitProviderName, arg3 string) (string, error) {
 	fake.generateJWTMutex.Lock()
 	ret, specificReturn := fake.generateJWTReturnsOnCall[len(fake.generateJWTArgsForCall)]
@@ -108,6 +242,270 @@ func (fake *FakeJWTClient) GenerateJWTReturnsOnCall(i int, result1 string, resul
 	}{result1, result2}
 }
 
+func (fake *FakeJWTClient) GenerateRefreshJWT(arg1 time.Duration, arg2 auth.Claims) (string, error) {
+	fake.generateRefreshJWTMutex.Lock()
+	ret, specificReturn := fake.generateRefreshJWTReturnsOnCall[len(fake.generateRefreshJWTArgsForCall)]
+	fake.generateRefreshJWTArgsForCall = append(fake.generateRefreshJWTArgsForCall, struct {
+		arg1 time.Duration
+		arg2 auth.Claims
+	}{arg1, arg2})
+	stub := fake.GenerateRefreshJWTStub
+	fakeReturns := fake.generateRefreshJWTReturns
+	fake.recordInvocation("GenerateRefreshJWT", []interface{}{arg1, arg2})
+	fake.generateRefreshJWTMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJWTClient) GenerateRefreshJWTCallCount() int {
+	fake.generateRefreshJWTMutex.RLock()
+	defer fake.generateRefreshJWTMutex.RUnlock()
+	return len(fake.generateRefreshJWTArgsForCall)
+}
+
+func (fake *FakeJWTClient) GenerateRefreshJWTCalls(stub func(time.Duration, auth.Claims) (string, error)) {
+	fake.generateRefreshJWTMutex.Lock()
+	defer fake.generateRefreshJWTMutex.Unlock()
+	fake.GenerateRefreshJWTStub = stub
+}
+
+func (fake *FakeJWTClient) GenerateRefreshJWTArgsForCall(i int) (time.Duration, auth.Claims) {
+	fake.generateRefreshJWTMutex.RLock()
+	defer fake.generateRefreshJWTMutex.RUnlock()
+	argsForCall := fake.generateRefreshJWTArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeJWTClient) GenerateRefreshJWTReturns(result1 string, result2 error) {
+	fake.generateRefreshJWTMutex.Lock()
+	defer fake.generateRefreshJWTMutex.Unlock()
+	fake.GenerateRefreshJWTStub = nil
+	fake.generateRefreshJWTReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJWTClient) GenerateRefreshJWTReturnsOnCall(i int, result1 string, result2 error) {
+	fake.generateRefreshJWTMutex.Lock()
+	defer fake.generateRefreshJWTMutex.Unlock()
+	fake.GenerateRefreshJWTStub = nil
+	if fake.generateRefreshJWTReturnsOnCall == nil {
+		fake.generateRefreshJWTReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.generateRefreshJWTReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJWTClient) GenerateUserJWT(arg1 time.Duration, arg2 string, arg3 []string) (string, error) {
+	var arg3Copy []string
+	if arg3 != nil {
+		arg3Copy = make([]string, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.generateUserJWTMutex.Lock()
+	ret, specificReturn := fake.generateUserJWTReturnsOnCall[len(fake.generateUserJWTArgsForCall)]
+	fake.generateUserJWTArgsForCall = append(fake.generateUserJWTArgsForCall, struct {
+		arg1 time.Duration
+		arg2 string
+		arg3 []string
+	}{arg1, arg2, arg3Copy})
+	stub := fake.GenerateUserJWTStub
+	fakeReturns := fake.generateUserJWTReturns
+	fake.recordInvocation("GenerateUserJWT", []interface{}{arg1, arg2, arg3Copy})
+	fake.generateUserJWTMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJWTClient) GenerateUserJWTCallCount() int {
+	fake.generateUserJWTMutex.RLock()
+	defer fake.generateUserJWTMutex.RUnlock()
+	return len(fake.generateUserJWTArgsForCall)
+}
+
+func (fake *FakeJWTClient) GenerateUserJWTCalls(stub func(time.Duration, string, []string) (string, error)) {
+	fake.generateUserJWTMutex.Lock()
+	defer fake.generateUserJWTMutex.Unlock()
+	fake.GenerateUserJWTStub = stub
+}
+
+func (fake *FakeJWTClient) GenerateUserJWTArgsForCall(i int) (time.Duration, string, []string) {
+	fake.generateUserJWTMutex.RLock()
+	defer fake.generateUserJWTMutex.RUnlock()
+	argsForCall := fake.generateUserJWTArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeJWTClient) GenerateUserJWTReturns(result1 string, result2 error) {
+	fake.generateUserJWTMutex.Lock()
+	defer fake.generateUserJWTMutex.Unlock()
+	fake.GenerateUserJWTStub = nil
+	fake.generateUserJWTReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJWTClient) GenerateUserJWTReturnsOnCall(i int, result1 string, result2 error) {
+	fake.generateUserJWTMutex.Lock()
+	defer fake.generateUserJWTMutex.Unlock()
+	fake.GenerateUserJWTStub = nil
+	if fake.generateUserJWTReturnsOnCall == nil {
+		fake.generateUserJWTReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.generateUserJWTReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJWTClient) RefreshJWT(arg1 string) (string, string, error) {
+	fake.refreshJWTMutex.Lock()
+	ret, specificReturn := fake.refreshJWTReturnsOnCall[len(fake.refreshJWTArgsForCall)]
+	fake.refreshJWTArgsForCall = append(fake.refreshJWTArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.RefreshJWTStub
+	fakeReturns := fake.refreshJWTReturns
+	fake.recordInvocation("RefreshJWT", []interface{}{arg1})
+	fake.refreshJWTMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeJWTClient) RefreshJWTCallCount() int {
+	fake.refreshJWTMutex.RLock()
+	defer fake.refreshJWTMutex.RUnlock()
+	return len(fake.refreshJWTArgsForCall)
+}
+
+func (fake *FakeJWTClient) RefreshJWTCalls(stub func(string) (string, string, error)) {
+	fake.refreshJWTMutex.Lock()
+	defer fake.refreshJWTMutex.Unlock()
+	fake.RefreshJWTStub = stub
+}
+
+func (fake *FakeJWTClient) RefreshJWTArgsForCall(i int) string {
+	fake.refreshJWTMutex.RLock()
+	defer fake.refreshJWTMutex.RUnlock()
+	argsForCall := fake.refreshJWTArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeJWTClient) RefreshJWTReturns(result1 string, result2 string, result3 error) {
+	fake.refreshJWTMutex.Lock()
+	defer fake.refreshJWTMutex.Unlock()
+	fake.RefreshJWTStub = nil
+	fake.refreshJWTReturns = struct {
+		result1 string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeJWTClient) RefreshJWTReturnsOnCall(i int, result1 string, result2 string, result3 error) {
+	fake.refreshJWTMutex.Lock()
+	defer fake.refreshJWTMutex.Unlock()
+	fake.RefreshJWTStub = nil
+	if fake.refreshJWTReturnsOnCall == nil {
+		fake.refreshJWTReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 string
+			result3 error
+		})
+	}
+	fake.refreshJWTReturnsOnCall[i] = struct {
+		result1 string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeJWTClient) RevokeJWT(arg1 string) error {
+	fake.revokeJWTMutex.Lock()
+	ret, specificReturn := fake.revokeJWTReturnsOnCall[len(fake.revokeJWTArgsForCall)]
+	fake.revokeJWTArgsForCall = append(fake.revokeJWTArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.RevokeJWTStub
+	fakeReturns := fake.revokeJWTReturns
+	fake.recordInvocation("RevokeJWT", []interface{}{arg1})
+	fake.revokeJWTMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeJWTClient) RevokeJWTCallCount() int {
+	fake.revokeJWTMutex.RLock()
+	defer fake.revokeJWTMutex.RUnlock()
+	return len(fake.revokeJWTArgsForCall)
+}
+
+func (fake *FakeJWTClient) RevokeJWTCalls(stub func(string) error) {
+	fake.revokeJWTMutex.Lock()
+	defer fake.revokeJWTMutex.Unlock()
+	fake.RevokeJWTStub = stub
+}
+
+func (fake *FakeJWTClient) RevokeJWTArgsForCall(i int) string {
+	fake.revokeJWTMutex.RLock()
+	defer fake.revokeJWTMutex.RUnlock()
+	argsForCall := fake.revokeJWTArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeJWTClient) RevokeJWTReturns(result1 error) {
+	fake.revokeJWTMutex.Lock()
+	defer fake.revokeJWTMutex.Unlock()
+	fake.RevokeJWTStub = nil
+	fake.revokeJWTReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeJWTClient) RevokeJWTReturnsOnCall(i int, result1 error) {
+	fake.revokeJWTMutex.Lock()
+	defer fake.revokeJWTMutex.Unlock()
+	fake.RevokeJWTStub = nil
+	if fake.revokeJWTReturnsOnCall == nil {
+		fake.revokeJWTReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.revokeJWTReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeJWTClient) VerifyJWT(arg1 string) (*auth.Claims, error) {
 	fake.verifyJWTMutex.Lock()
 	ret, specificReturn := fake.verifyJWTReturnsOnCall[len(fake.verifyJWTArgsForCall)]
@@ -175,8 +573,18 @@ func (fake *FakeJWTClient) VerifyJWTReturnsOnCall(i int, result1 *auth.Claims, r
 func (fake *FakeJWTClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.generateAccessJWTMutex.RLock()
+	defer fake.generateAccessJWTMutex.RUnlock()
 	fake.generateJWTMutex.RLock()
 	defer fake.generateJWTMutex.RUnlock()
+	fake.generateRefreshJWTMutex.RLock()
+	defer fake.generateRefreshJWTMutex.RUnlock()
+	fake.generateUserJWTMutex.RLock()
+	defer fake.generateUserJWTMutex.RUnlock()
+	fake.refreshJWTMutex.RLock()
+	defer fake.refreshJWTMutex.RUnlock()
+	fake.revokeJWTMutex.RLock()
+	defer fake.revokeJWTMutex.RUnlock()
 	fake.verifyJWTMutex.RLock()
 	defer fake.verifyJWTMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -0,0 +1,269 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package authfakes
+
+import (
+	"sync"
+	"time"
+
+	"github.com/weaveworks/weave-gitops/pkg/services/auth"
+)
+
+type FakeRevocationList struct {
+	IsRevokedStub        func(string) (bool, error)
+	isRevokedMutex       sync.RWMutex
+	isRevokedArgsForCall []struct {
+		arg1 string
+	}
+	isRevokedReturns struct {
+		result1 bool
+		result2 error
+	}
+	isRevokedReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	RevokeStub        func(string, time.Time) error
+	revokeMutex       sync.RWMutex
+	revokeArgsForCall []struct {
+		arg1 string
+		arg2 time.Time
+	}
+	revokeReturns struct {
+		result1 error
+	}
+	revokeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RevokeOnceStub        func(string, time.Time) error
+	revokeOnceMutex       sync.RWMutex
+	revokeOnceArgsForCall []struct {
+		arg1 string
+		arg2 time.Time
+	}
+	revokeOnceReturns struct {
+		result1 error
+	}
+	revokeOnceReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRevocationList) IsRevoked(arg1 string) (bool, error) {
+	fake.isRevokedMutex.Lock()
+	ret, specificReturn := fake.isRevokedReturnsOnCall[len(fake.isRevokedArgsForCall)]
+	fake.isRevokedArgsForCall = append(fake.isRevokedArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.IsRevokedStub
+	fakeReturns := fake.isRevokedReturns
+	fake.recordInvocation("IsRevoked", []interface{}{arg1})
+	fake.isRevokedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRevocationList) IsRevokedCallCount() int {
+	fake.isRevokedMutex.RLock()
+	defer fake.isRevokedMutex.RUnlock()
+	return len(fake.isRevokedArgsForCall)
+}
+
+func (fake *FakeRevocationList) IsRevokedCalls(stub func(string) (bool, error)) {
+	fake.isRevokedMutex.Lock()
+	defer fake.isRevokedMutex.Unlock()
+	fake.IsRevokedStub = stub
+}
+
+func (fake *FakeRevocationList) IsRevokedArgsForCall(i int) string {
+	fake.isRevokedMutex.RLock()
+	defer fake.isRevokedMutex.RUnlock()
+	argsForCall := fake.isRevokedArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeRevocationList) IsRevokedReturns(result1 bool, result2 error) {
+	fake.isRevokedMutex.Lock()
+	defer fake.isRevokedMutex.Unlock()
+	fake.IsRevokedStub = nil
+	fake.isRevokedReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRevocationList) IsRevokedReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.isRevokedMutex.Lock()
+	defer fake.isRevokedMutex.Unlock()
+	fake.IsRevokedStub = nil
+	if fake.isRevokedReturnsOnCall == nil {
+		fake.isRevokedReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.isRevokedReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRevocationList) Revoke(arg1 string, arg2 time.Time) error {
+	fake.revokeMutex.Lock()
+	ret, specificReturn := fake.revokeReturnsOnCall[len(fake.revokeArgsForCall)]
+	fake.revokeArgsForCall = append(fake.revokeArgsForCall, struct {
+		arg1 string
+		arg2 time.Time
+	}{arg1, arg2})
+	stub := fake.RevokeStub
+	fakeReturns := fake.revokeReturns
+	fake.recordInvocation("Revoke", []interface{}{arg1, arg2})
+	fake.revokeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRevocationList) RevokeCallCount() int {
+	fake.revokeMutex.RLock()
+	defer fake.revokeMutex.RUnlock()
+	return len(fake.revokeArgsForCall)
+}
+
+func (fake *FakeRevocationList) RevokeCalls(stub func(string, time.Time) error) {
+	fake.revokeMutex.Lock()
+	defer fake.revokeMutex.Unlock()
+	fake.RevokeStub = stub
+}
+
+func (fake *FakeRevocationList) RevokeArgsForCall(i int) (string, time.Time) {
+	fake.revokeMutex.RLock()
+	defer fake.revokeMutex.RUnlock()
+	argsForCall := fake.revokeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRevocationList) RevokeReturns(result1 error) {
+	fake.revokeMutex.Lock()
+	defer fake.revokeMutex.Unlock()
+	fake.RevokeStub = nil
+	fake.revokeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRevocationList) RevokeReturnsOnCall(i int, result1 error) {
+	fake.revokeMutex.Lock()
+	defer fake.revokeMutex.Unlock()
+	fake.RevokeStub = nil
+	if fake.revokeReturnsOnCall == nil {
+		fake.revokeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.revokeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRevocationList) RevokeOnce(arg1 string, arg2 time.Time) error {
+	fake.revokeOnceMutex.Lock()
+	ret, specificReturn := fake.revokeOnceReturnsOnCall[len(fake.revokeOnceArgsForCall)]
+	fake.revokeOnceArgsForCall = append(fake.revokeOnceArgsForCall, struct {
+		arg1 string
+		arg2 time.Time
+	}{arg1, arg2})
+	stub := fake.RevokeOnceStub
+	fakeReturns := fake.revokeOnceReturns
+	fake.recordInvocation("RevokeOnce", []interface{}{arg1, arg2})
+	fake.revokeOnceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRevocationList) RevokeOnceCallCount() int {
+	fake.revokeOnceMutex.RLock()
+	defer fake.revokeOnceMutex.RUnlock()
+	return len(fake.revokeOnceArgsForCall)
+}
+
+func (fake *FakeRevocationList) RevokeOnceCalls(stub func(string, time.Time) error) {
+	fake.revokeOnceMutex.Lock()
+	defer fake.revokeOnceMutex.Unlock()
+	fake.RevokeOnceStub = stub
+}
+
+func (fake *FakeRevocationList) RevokeOnceArgsForCall(i int) (string, time.Time) {
+	fake.revokeOnceMutex.RLock()
+	defer fake.revokeOnceMutex.RUnlock()
+	argsForCall := fake.revokeOnceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRevocationList) RevokeOnceReturns(result1 error) {
+	fake.revokeOnceMutex.Lock()
+	defer fake.revokeOnceMutex.Unlock()
+	fake.RevokeOnceStub = nil
+	fake.revokeOnceReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRevocationList) RevokeOnceReturnsOnCall(i int, result1 error) {
+	fake.revokeOnceMutex.Lock()
+	defer fake.revokeOnceMutex.Unlock()
+	fake.RevokeOnceStub = nil
+	if fake.revokeOnceReturnsOnCall == nil {
+		fake.revokeOnceReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.revokeOnceReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRevocationList) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.isRevokedMutex.RLock()
+	defer fake.isRevokedMutex.RUnlock()
+	fake.revokeMutex.RLock()
+	defer fake.revokeMutex.RUnlock()
+	fake.revokeOnceMutex.RLock()
+	defer fake.revokeOnceMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeRevocationList) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ auth.RevocationList = new(FakeRevocationList)
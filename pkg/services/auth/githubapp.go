@@ -0,0 +1,154 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/weaveworks/weave-gitops/pkg/gitcredentials"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultGitHubAPIURL is the address of the GitHub API installation tokens are requested from
+const DefaultGitHubAPIURL = "https://api.github.com"
+
+// GitHubAppTokenRefreshMargin is how long before their expiry installation tokens are replaced
+var GitHubAppTokenRefreshMargin = 10 * time.Minute
+
+// GitHubAppTokenIssuer keeps the installation tokens of GitHub Apps in the token Secrets read by Flux
+type GitHubAppTokenIssuer struct {
+	KubeClient client.Client
+	HTTPClient *http.Client
+	// APIURL defaults to DefaultGitHubAPIURL
+	APIURL string
+}
+
+// Token returns an installation token of the GitHub App of the Secret and when it expires.
+// A new token is requested when the one stored in the token Secret expires soon.
+func (i *GitHubAppTokenIssuer) Token(ctx context.Context, appSecretName types.NamespacedName) (string, time.Time, error) {
+	tokenSecretName := types.NamespacedName{Name: gitcredentials.GitHubAppTokenSecretName(appSecretName.Name), Namespace: appSecretName.Namespace}
+
+	tokenSecret := &corev1.Secret{}
+
+	err := i.KubeClient.Get(ctx, tokenSecretName, tokenSecret)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return "", time.Time{}, fmt.Errorf("could not get github app token secret: %w", err)
+	}
+
+	exists := err == nil
+
+	if exists {
+		expiresAt, err := time.Parse(time.RFC3339, tokenSecret.Annotations[gitcredentials.GitHubAppTokenExpiresAtAnnotation])
+		if err == nil && time.Until(expiresAt) > GitHubAppTokenRefreshMargin {
+			return string(tokenSecret.Data[gitcredentials.PasswordKey]), expiresAt, nil
+		}
+	}
+
+	appSecret := &corev1.Secret{}
+	if err := i.KubeClient.Get(ctx, appSecretName, appSecret); err != nil {
+		return "", time.Time{}, fmt.Errorf("could not get github app secret: %w", err)
+	}
+
+	token, expiresAt, err := i.installationToken(ctx, appSecret)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	tokenSecret.Name = tokenSecretName.Name
+	tokenSecret.Namespace = tokenSecretName.Namespace
+	tokenSecret.Annotations = map[string]string{gitcredentials.GitHubAppTokenExpiresAtAnnotation: expiresAt.Format(time.RFC3339)}
+	tokenSecret.Data = map[string][]byte{
+		gitcredentials.UsernameKey: []byte(gitcredentials.GitHubAppTokenUsername),
+		gitcredentials.PasswordKey: []byte(token),
+	}
+
+	if exists {
+		err = i.KubeClient.Update(ctx, tokenSecret)
+	} else {
+		err = i.KubeClient.Create(ctx, tokenSecret)
+	}
+
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("could not store github app token: %w", err)
+	}
+
+	return token, expiresAt, nil
+}
+
+// installationToken authenticates as the GitHub App and requests a token of its installation
+func (i *GitHubAppTokenIssuer) installationToken(ctx context.Context, appSecret *corev1.Secret) (string, time.Time, error) {
+	appID := string(appSecret.Data[gitcredentials.GitHubAppIDKey])
+	installationID := string(appSecret.Data[gitcredentials.GitHubAppInstallationIDKey])
+
+	if appID == "" || installationID == "" {
+		return "", time.Time{}, fmt.Errorf("github app secret %s requires %s and %s", appSecret.Name, gitcredentials.GitHubAppIDKey, gitcredentials.GitHubAppInstallationIDKey)
+	}
+
+	key, err := jwt.ParseRSAPrivateKeyFromPEM(appSecret.Data[gitcredentials.GitHubAppPrivateKeyKey])
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("could not parse %s of github app secret %s: %w", gitcredentials.GitHubAppPrivateKeyKey, appSecret.Name, err)
+	}
+
+	// GitHub rejects app tokens valid for more than 10 minutes, the issue time allows for clock drift
+	now := time.Now()
+	appToken, err := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.StandardClaims{
+		Issuer:    appID,
+		IssuedAt:  now.Add(-time.Minute).Unix(),
+		ExpiresAt: now.Add(9 * time.Minute).Unix(),
+	}).SignedString(key)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("could not sign github app token: %w", err)
+	}
+
+	apiURL := i.APIURL
+	if apiURL == "" {
+		apiURL = DefaultGitHubAPIURL
+	}
+
+	url := fmt.Sprintf("%s/app/installations/%s/access_tokens", strings.TrimSuffix(apiURL, "/"), installationID)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, nil)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+appToken)
+	req.Header.Set("Accept", "application/vnd.github.v3+json")
+
+	res, err := i.httpClient().Do(req)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("could not request github app installation token: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusCreated {
+		return "", time.Time{}, fmt.Errorf("could not request github app installation token: %s", res.Status)
+	}
+
+	body := struct {
+		Token     string      `json:"token"`
+		ExpiresAt metav1.Time `json:"expires_at"`
+	}{}
+
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return "", time.Time{}, fmt.Errorf("could not decode github app installation token: %w", err)
+	}
+
+	return body.Token, body.ExpiresAt.Time, nil
+}
+
+func (i *GitHubAppTokenIssuer) httpClient() *http.Client {
+	if i.HTTPClient == nil {
+		return http.DefaultClient
+	}
+
+	return i.HTTPClient
+}
@@ -0,0 +1,122 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/weaveworks/weave-gitops/pkg/gitcredentials"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GitHubAppTokenIssuer", func() {
+	var (
+		ctx        context.Context
+		kubeClient client.Client
+		server     *httptest.Server
+		issuer     *GitHubAppTokenIssuer
+		requests   int
+		appSecret  = types.NamespacedName{Name: "github-app", Namespace: "wego-system"}
+		tokenName  = types.NamespacedName{Name: "github-app-token", Namespace: "wego-system"}
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		requests = 0
+
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).NotTo(HaveOccurred())
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+
+			requests++
+
+			Expect(r.Method).To(Equal(http.MethodPost))
+			Expect(r.URL.Path).To(Equal("/app/installations/42/access_tokens"))
+
+			claims := &jwt.StandardClaims{}
+			_, err := jwt.ParseWithClaims(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "), claims, func(*jwt.Token) (interface{}, error) {
+				return &key.PublicKey, nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(claims.Issuer).To(Equal("7"))
+
+			w.WriteHeader(http.StatusCreated)
+			Expect(json.NewEncoder(w).Encode(map[string]string{
+				"token":      "installation-token",
+				"expires_at": time.Now().Add(time.Hour).UTC().Format(time.RFC3339),
+			})).To(Succeed())
+		}))
+
+		privateKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+		scheme := runtime.NewScheme()
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+
+		kubeClient = fake.NewClientBuilder().WithScheme(scheme).WithObjects(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: appSecret.Name, Namespace: appSecret.Namespace},
+			Data: map[string][]byte{
+				gitcredentials.GitHubAppIDKey:             []byte("7"),
+				gitcredentials.GitHubAppInstallationIDKey: []byte("42"),
+				gitcredentials.GitHubAppPrivateKeyKey:     privateKey,
+			},
+		}).Build()
+
+		issuer = &GitHubAppTokenIssuer{KubeClient: kubeClient, HTTPClient: server.Client(), APIURL: server.URL}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("stores the installation token in the token secret and reuses it", func() {
+		token, expiresAt, err := issuer.Token(ctx, appSecret)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(token).To(Equal("installation-token"))
+		Expect(expiresAt).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+
+		stored := &corev1.Secret{}
+		Expect(kubeClient.Get(ctx, tokenName, stored)).To(Succeed())
+		Expect(stored.Data).To(Equal(map[string][]byte{
+			gitcredentials.UsernameKey: []byte(gitcredentials.GitHubAppTokenUsername),
+			gitcredentials.PasswordKey: []byte("installation-token"),
+		}))
+
+		_, _, err = issuer.Token(ctx, appSecret)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(requests).To(Equal(1))
+	})
+
+	It("requests a new token when the stored one expires soon", func() {
+		Expect(kubeClient.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        tokenName.Name,
+				Namespace:   tokenName.Namespace,
+				Annotations: map[string]string{gitcredentials.GitHubAppTokenExpiresAtAnnotation: time.Now().Add(time.Minute).Format(time.RFC3339)},
+			},
+			Data: map[string][]byte{gitcredentials.PasswordKey: []byte("old-token")},
+		})).To(Succeed())
+
+		token, _, err := issuer.Token(ctx, appSecret)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(token).To(Equal("installation-token"))
+		Expect(requests).To(Equal(1))
+	})
+})
@@ -6,6 +6,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
+	"github.com/weaveworks/weave-gitops/pkg/utils"
 
 	"github.com/golang-jwt/jwt/v4"
 )
@@ -15,59 +16,211 @@ import (
 // ExpirationTime jwt token expiration time
 const ExpirationTime = time.Minute * 15
 
+// RefreshExpirationTime jwt refresh token expiration time
+const RefreshExpirationTime = time.Hour * 24
+
+// RefreshTokenType is the token type of the refresh tokens, which are only accepted by RefreshJWT
+const RefreshTokenType = "refresh"
+
 // ErrUnauthorizedToken unauthorized token error
 var ErrUnauthorizedToken = errors.New("unauthorized token")
 
-// Claims is a custom JWT claims that contains some token information
+// Claims is a custom JWT claims that contains some token information.
+// The subject of the standard claims is the name of the user the token was issued to, if any.
 type Claims struct {
 	jwt.StandardClaims
 	Provider      gitproviders.GitProviderName `json:"provider"`
 	ProviderToken string                       `json:"provider_token"`
+	Groups        []string                     `json:"groups,omitempty"`
+	TokenType     string                       `json:"token_type,omitempty"`
+}
+
+// User returns the user the token was issued to, or nil when the token does not identify a user
+func (c *Claims) User() *User {
+	if c.Subject == "" {
+		return nil
+	}
+
+	return &User{Name: c.Subject, Groups: c.Groups, Provider: c.Provider}
 }
 
 // JWTClient represents a type that has methods to generate and verify JWT tokens.
 //counterfeiter:generate . JWTClient
 type JWTClient interface {
 	GenerateJWT(expirationTime time.Duration, providerName gitproviders.GitProviderName, providerToken string) (string, error)
+	GenerateUserJWT(expirationTime time.Duration, username string, groups []string) (string, error)
+	// GenerateAccessJWT generates an access token for the identity of the claims
+	GenerateAccessJWT(expirationTime time.Duration, claims Claims) (string, error)
+	// GenerateRefreshJWT generates a refresh token for the identity of the claims
+	GenerateRefreshJWT(expirationTime time.Duration, claims Claims) (string, error)
 	VerifyJWT(accessToken string) (*Claims, error)
+	// RefreshJWT revokes the refresh token and returns a new access token and a new refresh token for its identity
+	RefreshJWT(refreshToken string) (string, string, error)
+	// RevokeJWT rejects the access or refresh token until it expires
+	RevokeJWT(token string) error
 }
 
 // NewJwtClient initialize JWTClient instance
 func NewJwtClient(secretKey string) JWTClient {
-	return &internalJWTClient{secretKey: secretKey}
+	return NewJwtClientWithKeys(NewStaticKeyRing(secretKey), NewMemoryRevocationList())
+}
+
+// NewJwtClientWithKeys initialize a JWTClient signing tokens with the keys of the key ring
+func NewJwtClientWithKeys(keys *KeyRing, revoked RevocationList) JWTClient {
+	return &internalJWTClient{keys: keys, revoked: revoked}
 }
 
 type internalJWTClient struct {
-	secretKey string
+	keys    *KeyRing
+	revoked RevocationList
 }
 
 // GenerateJWT generates and signs a new token
 func (i *internalJWTClient) GenerateJWT(expirationTime time.Duration, providerName gitproviders.GitProviderName, providerToken string) (string, error) {
 	claims := Claims{
-		StandardClaims: jwt.StandardClaims{
-			ExpiresAt: time.Now().Add(expirationTime).Unix(),
-		},
 		Provider:      providerName,
 		ProviderToken: providerToken,
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS512, claims)
+	return i.sign(expirationTime, claims)
+}
+
+// GenerateUserJWT generates and signs a new token identifying a user, such as one logged in with OIDC
+func (i *internalJWTClient) GenerateUserJWT(expirationTime time.Duration, username string, groups []string) (string, error) {
+	claims := Claims{
+		StandardClaims: jwt.StandardClaims{
+			Subject: username,
+		},
+		Groups: groups,
+	}
+
+	return i.sign(expirationTime, claims)
+}
+
+// GenerateAccessJWT generates and signs a new access token, such as one identifying a git provider user
+func (i *internalJWTClient) GenerateAccessJWT(expirationTime time.Duration, claims Claims) (string, error) {
+	claims.TokenType = ""
 
-	return token.SignedString([]byte(i.secretKey))
+	return i.sign(expirationTime, claims)
+}
+
+// GenerateRefreshJWT generates and signs a new refresh token
+func (i *internalJWTClient) GenerateRefreshJWT(expirationTime time.Duration, claims Claims) (string, error) {
+	claims.TokenType = RefreshTokenType
+
+	return i.sign(expirationTime, claims)
 }
 
 // VerifyJWT verifies the access token string and return a user claim if the token is valid
 func (i *internalJWTClient) VerifyJWT(accessToken string) (*Claims, error) {
-	token, err := jwt.ParseWithClaims(
-		accessToken,
+	claims, err := i.parse(accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.TokenType == RefreshTokenType {
+		return nil, ErrUnauthorizedToken
+	}
+
+	return claims, nil
+}
+
+// RefreshJWT exchanges a refresh token for new tokens. Refresh tokens can only be used once.
+func (i *internalJWTClient) RefreshJWT(refreshToken string) (string, string, error) {
+	claims, err := i.parse(refreshToken)
+	if err != nil {
+		return "", "", err
+	}
+
+	if claims.TokenType != RefreshTokenType {
+		return "", "", ErrUnauthorizedToken
+	}
+
+	// Checked against the stored revocations and revoked at once, so the token cannot be replayed on another replica
+	if err := i.revoked.RevokeOnce(claims.Id, time.Unix(claims.ExpiresAt, 0)); err != nil {
+		if errors.Is(err, ErrAlreadyRevoked) {
+			return "", "", ErrUnauthorizedToken
+		}
+
+		return "", "", errors.Wrap(err, "could not revoke refresh token")
+	}
+
+	claims.TokenType = ""
+
+	accessToken, err := i.sign(ExpirationTime, *claims)
+	if err != nil {
+		return "", "", err
+	}
+
+	newRefreshToken, err := i.GenerateRefreshJWT(RefreshExpirationTime, *claims)
+	if err != nil {
+		return "", "", err
+	}
+
+	return accessToken, newRefreshToken, nil
+}
+
+// RevokeJWT revokes a token. Expired tokens are rejected anyway and do not need to be revoked.
+func (i *internalJWTClient) RevokeJWT(token string) error {
+	claims, err := i.parseWith(&jwt.Parser{SkipClaimsValidation: true}, token)
+	if err != nil {
+		return err
+	}
+
+	if time.Now().Unix() > claims.ExpiresAt {
+		return nil
+	}
+
+	if claims.Id == "" {
+		return errors.New("the token cannot be revoked, it has no id")
+	}
+
+	return i.revoked.Revoke(claims.Id, time.Unix(claims.ExpiresAt, 0))
+}
+
+// sign sets the id and the expiry of the claims, then signs them with the newest key of the key ring
+func (i *internalJWTClient) sign(expirationTime time.Duration, claims Claims) (string, error) {
+	id, err := utils.GenerateRandomString(32, 48)
+	if err != nil {
+		return "", err
+	}
+
+	claims.Id = id
+	claims.ExpiresAt = time.Now().Add(expirationTime).Unix()
+
+	key := i.keys.signingKey()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS512, claims)
+	if key.ID != "" {
+		token.Header["kid"] = key.ID
+	}
+
+	return token.SignedString(key.Secret)
+}
+
+// parse verifies the signature and the expiry of a token, and that it was not revoked
+func (i *internalJWTClient) parse(tokenString string) (*Claims, error) {
+	return i.parseWith(&jwt.Parser{}, tokenString)
+}
+
+func (i *internalJWTClient) parseWith(parser *jwt.Parser, tokenString string) (*Claims, error) {
+	token, err := parser.ParseWithClaims(
+		tokenString,
 		&Claims{},
 		func(token *jwt.Token) (interface{}, error) {
 			_, ok := token.Method.(*jwt.SigningMethodHMAC)
 			if !ok {
-				return nil, errors.New("unexpected token signing method")
+				return nil, errors.New("unexpected signing method")
 			}
 
-			return []byte(i.secretKey), nil
+			kid, _ := token.Header["kid"].(string)
+
+			secret, ok := i.keys.key(kid)
+			if !ok {
+				return nil, errors.New("unknown signing key")
+			}
+
+			return secret, nil
 		},
 	)
 	if err != nil {
@@ -79,5 +232,16 @@ func (i *internalJWTClient) VerifyJWT(accessToken string) (*Claims, error) {
 		return nil, errors.New("invalid token claims")
 	}
 
+	if claims.Id != "" {
+		revoked, err := i.revoked.IsRevoked(claims.Id)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not check token revocation")
+		}
+
+		if revoked {
+			return nil, ErrUnauthorizedToken
+		}
+	}
+
 	return claims, nil
 }
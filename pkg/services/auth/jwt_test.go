@@ -1,9 +1,15 @@
 package auth
 
 import (
+	"context"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/rand"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
 
@@ -36,4 +42,134 @@ var _ = Describe("JWT tokens", func() {
 
 	})
 
+	It("identifies the user the token was issued to", func() {
+		claims := &Claims{Groups: []string{"developers"}}
+		Expect(claims.User()).To(BeNil())
+
+		claims.Subject = "jane"
+		Expect(claims.User()).To(Equal(&User{Name: "jane", Groups: []string{"developers"}}))
+	})
+
+	It("exchanges a refresh token only once", func() {
+		cli := NewJwtClient(rand.String(20))
+
+		refreshToken, err := cli.GenerateRefreshJWT(RefreshExpirationTime, Claims{Provider: gitproviders.GitProviderGitHub, ProviderToken: "token"})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = cli.VerifyJWT(refreshToken)
+		Expect(err).To(MatchError(ErrUnauthorizedToken), "refresh tokens are not access tokens")
+
+		accessToken, newRefreshToken, err := cli.RefreshJWT(refreshToken)
+		Expect(err).NotTo(HaveOccurred())
+
+		claims, err := cli.VerifyJWT(accessToken)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(claims.ProviderToken).To(Equal("token"))
+
+		_, _, err = cli.RefreshJWT(refreshToken)
+		Expect(err).To(MatchError(ErrUnauthorizedToken))
+
+		_, _, err = cli.RefreshJWT(newRefreshToken)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, _, err = cli.RefreshJWT(accessToken)
+		Expect(err).To(MatchError(ErrUnauthorizedToken), "access tokens are not refresh tokens")
+	})
+
+	It("rejects revoked tokens", func() {
+		cli := NewJwtClient(rand.String(20))
+
+		token, err := cli.GenerateUserJWT(ExpirationTime, "jane", nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(cli.RevokeJWT(token)).To(Succeed())
+
+		_, err = cli.VerifyJWT(token)
+		Expect(err).To(MatchError(ErrUnauthorizedToken))
+	})
+
+	Context("stored in Secrets", func() {
+		var (
+			kubeClient  client.Client
+			keysName    = types.NamespacedName{Name: "jwt-keys", Namespace: "wego-system"}
+			revokedName = types.NamespacedName{Name: "jwt-revocations", Namespace: "wego-system"}
+		)
+
+		BeforeEach(func() {
+			scheme := runtime.NewScheme()
+			Expect(corev1.AddToScheme(scheme)).To(Succeed())
+
+			kubeClient = fake.NewClientBuilder().WithScheme(scheme).Build()
+		})
+
+		newReplica := func() (*KeyRing, JWTClient) {
+			keys := NewSecretKeyRing(kubeClient, keysName)
+			Expect(keys.Load(context.Background())).To(Succeed())
+
+			return keys, NewJwtClientWithKeys(keys, NewSecretRevocationList(kubeClient, revokedName))
+		}
+
+		It("verifies the tokens of the old keys until they are rotated out", func() {
+			keys, cli := newReplica()
+
+			token, err := cli.GenerateUserJWT(ExpirationTime, "jane", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(keys.Rotate(context.Background(), 2)).To(Succeed())
+
+			_, err = cli.VerifyJWT(token)
+			Expect(err).NotTo(HaveOccurred())
+
+			newToken, err := cli.GenerateUserJWT(ExpirationTime, "jane", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(keys.Rotate(context.Background(), 2)).To(Succeed())
+
+			_, err = cli.VerifyJWT(token)
+			Expect(err).To(MatchError(ErrUnauthorizedToken))
+
+			_, err = cli.VerifyJWT(newToken)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("shares the keys and the revoked tokens between replicas", func() {
+			_, first := newReplica()
+			_, second := newReplica()
+
+			token, err := first.GenerateUserJWT(ExpirationTime, "jane", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = second.VerifyJWT(token)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(first.RevokeJWT(token)).To(Succeed())
+
+			// The second replica still trusts its copy of the revoked tokens
+			revocationCacheTTL = 0
+			defer func() { revocationCacheTTL = 10 * time.Second }()
+
+			_, err = second.VerifyJWT(token)
+			Expect(err).To(MatchError(ErrUnauthorizedToken))
+		})
+
+		It("does not accept a refresh token used on another replica", func() {
+			_, first := newReplica()
+			_, second := newReplica()
+
+			refreshToken, err := first.GenerateRefreshJWT(RefreshExpirationTime, Claims{})
+			Expect(err).NotTo(HaveOccurred())
+
+			// The second replica loads its copy of the revoked tokens before the refresh token is used
+			accessToken, err := first.GenerateUserJWT(ExpirationTime, "jane", nil)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = second.VerifyJWT(accessToken)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, _, err = first.RefreshJWT(refreshToken)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, _, err = second.RefreshJWT(refreshToken)
+			Expect(err).To(MatchError(ErrUnauthorizedToken))
+		})
+	})
 })
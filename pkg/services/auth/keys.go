@@ -0,0 +1,248 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// DefaultKeyRotationInterval is how often the server replaces the key signing new tokens
+	DefaultKeyRotationInterval = 24 * time.Hour
+	// DefaultKeysKept is how many signing keys remain valid. Rotated keys keep verifying
+	// the tokens they signed until DefaultKeysKept newer keys have been created.
+	DefaultKeysKept = 3
+
+	signingKeyPrefix = "key-"
+	signingKeySize   = 64
+)
+
+// SigningKey is an HMAC key signing tokens, identified by the kid header of the tokens it signed
+type SigningKey struct {
+	ID        string
+	Secret    []byte
+	CreatedAt time.Time
+}
+
+// KeyRing holds the keys tokens are signed with. The newest key signs new tokens,
+// the older ones keep verifying the tokens they signed until they are rotated out.
+// The keys are stored in a Secret, so that every replica of the server and restarts share them.
+type KeyRing struct {
+	kubeClient client.Client
+	secretName types.NamespacedName
+
+	mu   sync.RWMutex
+	keys []SigningKey
+}
+
+// NewStaticKeyRing returns a key ring holding a single key, which is never stored nor rotated
+func NewStaticKeyRing(secret string) *KeyRing {
+	return &KeyRing{keys: []SigningKey{{Secret: []byte(secret)}}}
+}
+
+// NewSecretKeyRing returns a key ring stored in the named Secret. Use Load to read the keys.
+func NewSecretKeyRing(kubeClient client.Client, secretName types.NamespacedName) *KeyRing {
+	return &KeyRing{kubeClient: kubeClient, secretName: secretName}
+}
+
+// Load reads the keys from the Secret, creating it with a first key when it does not exist
+func (k *KeyRing) Load(ctx context.Context) error {
+	if k.kubeClient == nil {
+		return nil
+	}
+
+	secret := &corev1.Secret{}
+
+	err := k.kubeClient.Get(ctx, k.secretName, secret)
+	if apierrors.IsNotFound(err) {
+		return k.create(ctx)
+	}
+
+	if err != nil {
+		return fmt.Errorf("could not get signing keys secret: %w", err)
+	}
+
+	return k.set(secret)
+}
+
+// Rotate adds a new key signing the new tokens and drops the keys older than the newest keep keys
+func (k *KeyRing) Rotate(ctx context.Context, keep int) error {
+	if k.kubeClient == nil {
+		return nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := k.kubeClient.Get(ctx, k.secretName, secret); err != nil {
+		return fmt.Errorf("could not get signing keys secret: %w", err)
+	}
+
+	key, err := newSigningKey()
+	if err != nil {
+		return err
+	}
+
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+
+	secret.Data[key.ID] = key.Secret
+
+	keys := signingKeys(secret.Data)
+	for _, old := range keys[min(keep, len(keys)):] {
+		delete(secret.Data, old.ID)
+	}
+
+	// A conflict means another replica rotated the keys first
+	if err := k.kubeClient.Update(ctx, secret); err != nil {
+		if apierrors.IsConflict(err) {
+			return k.Load(ctx)
+		}
+
+		return fmt.Errorf("could not update signing keys secret: %w", err)
+	}
+
+	return k.set(secret)
+}
+
+// RotateEvery reloads the keys every minute and rotates them once the newest key is older than the interval.
+// It returns when the context is done.
+func (k *KeyRing) RotateEvery(ctx context.Context, interval time.Duration, keep int, log logr.Logger) {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for {
+		err := k.Load(ctx)
+		if err == nil && time.Since(k.signingKey().CreatedAt) >= interval {
+			err = k.Rotate(ctx, keep)
+		}
+
+		if err != nil {
+			log.Error(err, "could not rotate signing keys")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// signingKey returns the key signing new tokens
+func (k *KeyRing) signingKey() SigningKey {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	if len(k.keys) == 0 {
+		return SigningKey{}
+	}
+
+	return k.keys[0]
+}
+
+// key returns the secret of the key with the ID, if it is still valid
+func (k *KeyRing) key(id string) ([]byte, bool) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	for _, key := range k.keys {
+		if key.ID == id {
+			return key.Secret, true
+		}
+	}
+
+	return nil, false
+}
+
+func (k *KeyRing) create(ctx context.Context) error {
+	key, err := newSigningKey()
+	if err != nil {
+		return err
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: k.secretName.Name, Namespace: k.secretName.Namespace},
+		Type:       corev1.SecretTypeOpaque,
+		Data:       map[string][]byte{key.ID: key.Secret},
+	}
+
+	if err := k.kubeClient.Create(ctx, secret); err != nil {
+		// Another replica created it first
+		if apierrors.IsAlreadyExists(err) {
+			return k.Load(ctx)
+		}
+
+		return fmt.Errorf("could not create signing keys secret: %w", err)
+	}
+
+	return k.set(secret)
+}
+
+func (k *KeyRing) set(secret *corev1.Secret) error {
+	keys := signingKeys(secret.Data)
+	if len(keys) == 0 {
+		return fmt.Errorf("no signing key in secret %s", k.secretName)
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	k.keys = keys
+
+	return nil
+}
+
+func newSigningKey() (SigningKey, error) {
+	secret := make([]byte, signingKeySize)
+	if _, err := rand.Read(secret); err != nil {
+		return SigningKey{}, fmt.Errorf("could not generate signing key: %w", err)
+	}
+
+	now := time.Now()
+
+	return SigningKey{
+		ID:        signingKeyPrefix + strconv.FormatInt(now.UnixNano(), 10),
+		Secret:    secret,
+		CreatedAt: now,
+	}, nil
+}
+
+// signingKeys returns the keys of the Secret data, newest first. The IDs of the keys hold their creation time.
+func signingKeys(data map[string][]byte) []SigningKey {
+	keys := []SigningKey{}
+
+	for id, secret := range data {
+		nanos, err := strconv.ParseInt(strings.TrimPrefix(id, signingKeyPrefix), 10, 64)
+		if err != nil || !strings.HasPrefix(id, signingKeyPrefix) {
+			continue
+		}
+
+		keys = append(keys, SigningKey{ID: id, Secret: secret, CreatedAt: time.Unix(0, nanos)})
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].CreatedAt.After(keys[j].CreatedAt)
+	})
+
+	return keys
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
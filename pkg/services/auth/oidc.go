@@ -0,0 +1,452 @@
+package auth
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/weaveworks/weave-gitops/pkg/services/auth/internal"
+	"github.com/weaveworks/weave-gitops/pkg/utils"
+	"golang.org/x/oauth2"
+)
+
+const (
+	oidcStateCookieName = "gitops_oidc_state"
+	oidcLoginTimeout    = 10 * time.Minute
+
+	// Bounds of the length of PKCE code verifiers, RFC 7636 section 4.1
+	pkceVerifierMin = 43
+	pkceVerifierMax = 128
+)
+
+// ErrInvalidIDToken is returned when the ID token of an OIDC provider cannot be trusted
+var ErrInvalidIDToken = errors.New("invalid id token")
+
+// OIDCConfig configures the login of users with an OpenID Connect provider
+type OIDCConfig struct {
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string
+	// RedirectURL is the URL the CallbackHandler is served at, as registered with the provider
+	RedirectURL string
+	// Scopes are requested on top of the openid scope
+	Scopes []string
+	// UsernameClaim and GroupsClaim are the ID token claims identifying the user, "sub" and "groups" by default
+	UsernameClaim string
+	GroupsClaim   string
+	// TokenDuration is how long the issued session tokens are valid, ExpirationTime by default
+	TokenDuration time.Duration
+}
+
+// OIDCAuthenticator logs users in with the authorization code flow of an OpenID Connect provider, using PKCE.
+// Logged in users get a session token from the JWTClient carrying their name and groups.
+type OIDCAuthenticator struct {
+	cfg       OIDCConfig
+	client    *http.Client
+	jwtClient JWTClient
+	oauth2    oauth2.Config
+	issuer    string
+	keys      *jsonWebKeySet
+
+	mu     sync.Mutex
+	logins map[string]pendingLogin
+}
+
+// pendingLogin is a login started by the LoginHandler, indexed by its state
+type pendingLogin struct {
+	verifier  internal.CodeVerifier
+	nonce     string
+	returnURL string
+	expires   time.Time
+}
+
+type oidcDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// NewOIDCAuthenticator discovers the endpoints of the OIDC provider and returns an authenticator logging users in with it
+func NewOIDCAuthenticator(ctx context.Context, client *http.Client, jwtClient JWTClient, cfg OIDCConfig) (*OIDCAuthenticator, error) {
+	if cfg.UsernameClaim == "" {
+		cfg.UsernameClaim = "sub"
+	}
+
+	if cfg.GroupsClaim == "" {
+		cfg.GroupsClaim = "groups"
+	}
+
+	if cfg.TokenDuration == 0 {
+		cfg.TokenDuration = ExpirationTime
+	}
+
+	discovery := oidcDiscovery{}
+
+	discoveryURL := strings.TrimSuffix(cfg.IssuerURL, "/") + "/.well-known/openid-configuration"
+	if err := getJSON(ctx, client, discoveryURL, &discovery); err != nil {
+		return nil, fmt.Errorf("could not discover the OIDC provider %s: %w", cfg.IssuerURL, err)
+	}
+
+	if discovery.Issuer != cfg.IssuerURL {
+		return nil, fmt.Errorf("the OIDC provider issuer %q does not match %q", discovery.Issuer, cfg.IssuerURL)
+	}
+
+	return &OIDCAuthenticator{
+		cfg:       cfg,
+		client:    client,
+		jwtClient: jwtClient,
+		oauth2: oauth2.Config{
+			ClientID:     cfg.ClientID,
+			ClientSecret: cfg.ClientSecret,
+			RedirectURL:  cfg.RedirectURL,
+			Scopes:       append([]string{"openid"}, cfg.Scopes...),
+			Endpoint: oauth2.Endpoint{
+				AuthURL:  discovery.AuthorizationEndpoint,
+				TokenURL: discovery.TokenEndpoint,
+			},
+		},
+		issuer: discovery.Issuer,
+		keys:   &jsonWebKeySet{client: client, url: discovery.JWKSURI},
+		logins: map[string]pendingLogin{},
+	}, nil
+}
+
+// LoginHandler redirects the browser to the OIDC provider.
+// The return_url query parameter is the path the browser is sent back to once logged in.
+func (a *OIDCAuthenticator) LoginHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		state, login, err := a.startLogin(r.URL.Query().Get("return_url"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		challenge, err := login.verifier.CodeChallenge()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		// Binds the login to the browser that started it
+		http.SetCookie(w, &http.Cookie{
+			Name:     oidcStateCookieName,
+			Value:    state,
+			Path:     "/",
+			Expires:  login.expires,
+			HttpOnly: true,
+			Secure:   r.TLS != nil,
+			SameSite: http.SameSiteLaxMode,
+		})
+
+		authURL := a.oauth2.AuthCodeURL(state,
+			oauth2.SetAuthURLParam("code_challenge", challenge),
+			oauth2.SetAuthURLParam("code_challenge_method", "S256"),
+			oauth2.SetAuthURLParam("nonce", login.nonce),
+		)
+
+		http.Redirect(w, r, authURL, http.StatusFound)
+	})
+}
+
+// CallbackHandler completes the logins started by the LoginHandler.
+// The session tokens are stored in cookies before the browser is sent back to the return URL.
+func (a *OIDCAuthenticator) CallbackHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		params := r.URL.Query()
+
+		if e := params.Get("error"); e != "" {
+			http.Error(w, fmt.Sprintf("login failed: %s %s", e, params.Get("error_description")), http.StatusUnauthorized)
+			return
+		}
+
+		state := params.Get("state")
+
+		cookie, err := r.Cookie(oidcStateCookieName)
+		if err != nil || cookie.Value != state {
+			http.Error(w, "invalid login state", http.StatusBadRequest)
+			return
+		}
+
+		login, ok := a.finishLogin(state)
+		if !ok {
+			http.Error(w, "the login expired, please try again", http.StatusBadRequest)
+			return
+		}
+
+		accessToken, refreshToken, err := a.exchange(r.Context(), params.Get("code"), login)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		http.SetCookie(w, &http.Cookie{Name: oidcStateCookieName, Path: "/", MaxAge: -1})
+		SetSessionCookies(w, r, accessToken, refreshToken)
+
+		http.Redirect(w, r, login.returnURL, http.StatusFound)
+	})
+}
+
+func (a *OIDCAuthenticator) startLogin(returnURL string) (string, pendingLogin, error) {
+	state, err := utils.GenerateRandomString(32, 48)
+	if err != nil {
+		return "", pendingLogin{}, err
+	}
+
+	nonce, err := utils.GenerateRandomString(32, 48)
+	if err != nil {
+		return "", pendingLogin{}, err
+	}
+
+	verifier, err := internal.NewCodeVerifier(pkceVerifierMin, pkceVerifierMax)
+	if err != nil {
+		return "", pendingLogin{}, err
+	}
+
+	login := pendingLogin{
+		verifier:  verifier,
+		nonce:     nonce,
+		returnURL: safeReturnURL(returnURL),
+		expires:   time.Now().Add(oidcLoginTimeout),
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	// Forget the logins that were never completed
+	for s, l := range a.logins {
+		if time.Now().After(l.expires) {
+			delete(a.logins, s)
+		}
+	}
+
+	a.logins[state] = login
+
+	return state, login, nil
+}
+
+func (a *OIDCAuthenticator) finishLogin(state string) (pendingLogin, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	login, ok := a.logins[state]
+	delete(a.logins, state)
+
+	if !ok || time.Now().After(login.expires) {
+		return pendingLogin{}, false
+	}
+
+	return login, true
+}
+
+// exchange trades the authorization code for an ID token and issues the session tokens of the user it identifies
+func (a *OIDCAuthenticator) exchange(ctx context.Context, code string, login pendingLogin) (string, string, error) {
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, a.client)
+
+	token, err := a.oauth2.Exchange(ctx, code, oauth2.SetAuthURLParam("code_verifier", login.verifier.RawValue()))
+	if err != nil {
+		return "", "", fmt.Errorf("could not exchange the authorization code: %w", err)
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		return "", "", fmt.Errorf("%w: the token response has no id_token", ErrInvalidIDToken)
+	}
+
+	user, err := a.verifyIDToken(ctx, rawIDToken, login.nonce)
+	if err != nil {
+		return "", "", err
+	}
+
+	accessToken, err := a.jwtClient.GenerateUserJWT(a.cfg.TokenDuration, user.Name, user.Groups)
+	if err != nil {
+		return "", "", err
+	}
+
+	claims := Claims{Groups: user.Groups}
+	claims.Subject = user.Name
+
+	refreshToken, err := a.jwtClient.GenerateRefreshJWT(RefreshExpirationTime, claims)
+	if err != nil {
+		return "", "", err
+	}
+
+	return accessToken, refreshToken, nil
+}
+
+// verifyIDToken checks the signature and the claims of an ID token and returns the user it identifies
+func (a *OIDCAuthenticator) verifyIDToken(ctx context.Context, rawIDToken, nonce string) (*User, error) {
+	claims := jwt.MapClaims{}
+
+	_, err := jwt.ParseWithClaims(rawIDToken, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
+			return nil, fmt.Errorf("unexpected signing method %v", token.Header["alg"])
+		}
+
+		kid, _ := token.Header["kid"].(string)
+
+		return a.keys.get(ctx, kid)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidIDToken, err)
+	}
+
+	switch {
+	case !claims.VerifyIssuer(a.issuer, true):
+		return nil, fmt.Errorf("%w: unexpected issuer %v", ErrInvalidIDToken, claims["iss"])
+	case !claims.VerifyAudience(a.cfg.ClientID, true):
+		return nil, fmt.Errorf("%w: not issued to client %s", ErrInvalidIDToken, a.cfg.ClientID)
+	case !claims.VerifyExpiresAt(time.Now().Unix(), true):
+		return nil, fmt.Errorf("%w: expired", ErrInvalidIDToken)
+	case claims["nonce"] != nonce:
+		return nil, fmt.Errorf("%w: unexpected nonce", ErrInvalidIDToken)
+	}
+
+	name, _ := claims[a.cfg.UsernameClaim].(string)
+	if name == "" {
+		return nil, fmt.Errorf("%w: no %s claim", ErrInvalidIDToken, a.cfg.UsernameClaim)
+	}
+
+	return &User{Name: name, Groups: claimStrings(claims[a.cfg.GroupsClaim])}, nil
+}
+
+// claimStrings reads a claim holding a string or a list of strings
+func claimStrings(claim interface{}) []string {
+	switch v := claim.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		values := []string{}
+
+		for _, value := range v {
+			if s, ok := value.(string); ok {
+				values = append(values, s)
+			}
+		}
+
+		return values
+	}
+
+	return nil
+}
+
+// safeReturnURL only lets logins send the browser back to paths of the server
+func safeReturnURL(returnURL string) string {
+	if !strings.HasPrefix(returnURL, "/") || strings.HasPrefix(returnURL, "//") || strings.HasPrefix(returnURL, "/\\") {
+		return "/"
+	}
+
+	return returnURL
+}
+
+// jsonWebKeySet holds the RSA signing keys of an OIDC provider.
+// The keys are fetched again when a token is signed by a key that is not known yet.
+type jsonWebKeySet struct {
+	client *http.Client
+	url    string
+
+	mu   sync.Mutex
+	keys map[string]*rsa.PublicKey
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+func (s *jsonWebKeySet) get(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if key := s.find(kid); key != nil {
+		return key, nil
+	}
+
+	if err := s.fetch(ctx); err != nil {
+		return nil, err
+	}
+
+	if key := s.find(kid); key != nil {
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("unknown signing key %q", kid)
+}
+
+func (s *jsonWebKeySet) find(kid string) *rsa.PublicKey {
+	// Tokens may leave the key out when the provider only has one
+	if kid == "" && len(s.keys) == 1 {
+		for _, key := range s.keys {
+			return key
+		}
+	}
+
+	return s.keys[kid]
+}
+
+func (s *jsonWebKeySet) fetch(ctx context.Context) error {
+	set := struct {
+		Keys []jsonWebKey `json:"keys"`
+	}{}
+
+	if err := getJSON(ctx, s.client, s.url, &set); err != nil {
+		return fmt.Errorf("could not get the OIDC provider signing keys: %w", err)
+	}
+
+	keys := map[string]*rsa.PublicKey{}
+
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" {
+			continue
+		}
+
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return fmt.Errorf("invalid modulus of signing key %q: %w", k.Kid, err)
+		}
+
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return fmt.Errorf("invalid exponent of signing key %q: %w", k.Kid, err)
+		}
+
+		keys[k.Kid] = &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+	}
+
+	s.keys = keys
+
+	return nil
+}
+
+func getJSON(ctx context.Context, client *http.Client, url string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned %s", url, res.Status)
+	}
+
+	return json.NewDecoder(res.Body).Decode(v)
+}
@@ -0,0 +1,235 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// oidcStandIn is a minimal OpenID Connect provider implementing the authorization code flow with PKCE
+type oidcStandIn struct {
+	*httptest.Server
+	key *rsa.PrivateKey
+	// claims are added to the ID tokens
+	claims jwt.MapClaims
+	// codes are the authorization codes given, with the code challenge and nonce of their request
+	codes map[string]url.Values
+}
+
+func newOIDCStandIn() *oidcStandIn {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	Expect(err).NotTo(HaveOccurred())
+
+	p := &oidcStandIn{key: key, claims: jwt.MapClaims{}, codes: map[string]url.Values{}}
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 p.URL,
+			"authorization_endpoint": p.URL + "/authorize",
+			"token_endpoint":         p.URL + "/token",
+			"jwks_uri":               p.URL + "/keys",
+		})
+	})
+
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"keys": []jsonWebKey{{
+			Kty: "RSA",
+			Kid: "key-1",
+			N:   base64.RawURLEncoding.EncodeToString(p.key.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(p.key.E)).Bytes()),
+		}}})
+	})
+
+	mux.HandleFunc("/authorize", func(w http.ResponseWriter, r *http.Request) {
+		params := r.URL.Query()
+		if params.Get("response_type") != "code" || params.Get("code_challenge_method") != "S256" {
+			http.Error(w, "unsupported request", http.StatusBadRequest)
+			return
+		}
+
+		code := "code-" + params.Get("state")
+		p.codes[code] = params
+
+		redirect, _ := url.Parse(params.Get("redirect_uri"))
+		redirect.RawQuery = url.Values{"code": {code}, "state": {params.Get("state")}}.Encode()
+
+		http.Redirect(w, r, redirect.String(), http.StatusFound)
+	})
+
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+
+		clientID, clientSecret, _ := r.BasicAuth()
+		if clientID != "gitops" || clientSecret != "secret" {
+			http.Error(w, `{"error":"invalid_client"}`, http.StatusUnauthorized)
+			return
+		}
+
+		request, ok := p.codes[r.PostForm.Get("code")]
+		delete(p.codes, r.PostForm.Get("code"))
+
+		hash := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+		if !ok || base64.RawURLEncoding.EncodeToString(hash[:]) != request.Get("code_challenge") {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+
+			return
+		}
+
+		claims := jwt.MapClaims{
+			"iss":   p.URL,
+			"aud":   clientID,
+			"sub":   "CiQwOGE4Njg0Yi1kYjg4",
+			"exp":   time.Now().Add(time.Hour).Unix(),
+			"nonce": request.Get("nonce"),
+		}
+		for k, v := range p.claims {
+			claims[k] = v
+		}
+
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+		token.Header["kid"] = "key-1"
+
+		idToken, err := token.SignedString(p.key)
+		Expect(err).NotTo(HaveOccurred())
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": "access-token",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"id_token":     idToken,
+		})
+	})
+
+	p.Server = httptest.NewServer(mux)
+
+	return p
+}
+
+var _ = Describe("OIDCAuthenticator", func() {
+	var (
+		provider      *oidcStandIn
+		jwtClient     JWTClient
+		authenticator *OIDCAuthenticator
+		noRedirect    *http.Client
+	)
+
+	BeforeEach(func() {
+		provider = newOIDCStandIn()
+		provider.claims["email"] = "jane@example.com"
+		provider.claims["groups"] = []string{"developers", "ops"}
+
+		jwtClient = NewJwtClient("session-secret")
+
+		var err error
+		authenticator, err = NewOIDCAuthenticator(context.Background(), http.DefaultClient, jwtClient, OIDCConfig{
+			IssuerURL:     provider.URL,
+			ClientID:      "gitops",
+			ClientSecret:  "secret",
+			RedirectURL:   "https://gitops.example.com/oauth2/callback",
+			UsernameClaim: "email",
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		noRedirect = &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		}}
+	})
+
+	AfterEach(func() {
+		provider.Close()
+	})
+
+	// login goes through the flow like a browser would, returning the response of the callback
+	login := func(returnURL string) *httptest.ResponseRecorder {
+		res := httptest.NewRecorder()
+		authenticator.LoginHandler().ServeHTTP(res, httptest.NewRequest(http.MethodGet, "/oauth2/login?return_url="+url.QueryEscape(returnURL), nil))
+		Expect(res.Code).To(Equal(http.StatusFound))
+
+		authorize, err := noRedirect.Get(res.Header().Get("Location"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(authorize.StatusCode).To(Equal(http.StatusFound))
+
+		callback := httptest.NewRequest(http.MethodGet, authorize.Header.Get("Location"), nil)
+		for _, c := range res.Result().Cookies() {
+			callback.AddCookie(c)
+		}
+
+		res = httptest.NewRecorder()
+		authenticator.CallbackHandler().ServeHTTP(res, callback)
+
+		return res
+	}
+
+	sessionToken := func(res *httptest.ResponseRecorder) string {
+		for _, c := range res.Result().Cookies() {
+			if c.Name == SessionCookieName {
+				return c.Value
+			}
+		}
+
+		return ""
+	}
+
+	It("issues a session token with the groups of the user", func() {
+		res := login("/applications")
+		Expect(res.Code).To(Equal(http.StatusFound), res.Body.String())
+		Expect(res.Header().Get("Location")).To(Equal("/applications"))
+
+		claims, err := jwtClient.VerifyJWT(sessionToken(res))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(claims.User()).To(Equal(&User{Name: "jane@example.com", Groups: []string{"developers", "ops"}}))
+	})
+
+	It("only sends the browser back to the server", func() {
+		res := login("//evil.example.com")
+		Expect(res.Header().Get("Location")).To(Equal("/"))
+	})
+
+	It("rejects callbacks from another browser", func() {
+		res := httptest.NewRecorder()
+		authenticator.LoginHandler().ServeHTTP(res, httptest.NewRequest(http.MethodGet, "/oauth2/login", nil))
+
+		authorize, err := noRedirect.Get(res.Header().Get("Location"))
+		Expect(err).NotTo(HaveOccurred())
+
+		res = httptest.NewRecorder()
+		authenticator.CallbackHandler().ServeHTTP(res, httptest.NewRequest(http.MethodGet, authorize.Header.Get("Location"), nil))
+
+		Expect(res.Code).To(Equal(http.StatusBadRequest))
+		Expect(sessionToken(res)).To(BeEmpty())
+	})
+
+	It("rejects ID tokens issued to another client", func() {
+		provider.claims["aud"] = "another-client"
+
+		res := login("/")
+		Expect(res.Code).To(Equal(http.StatusUnauthorized))
+		Expect(res.Body.String()).To(ContainSubstring(ErrInvalidIDToken.Error()))
+		Expect(sessionToken(res)).To(BeEmpty())
+	})
+
+	It("rejects ID tokens without the username claim", func() {
+		delete(provider.claims, "email")
+
+		res := login("/")
+		Expect(res.Code).To(Equal(http.StatusUnauthorized))
+		Expect(res.Body.String()).To(ContainSubstring("no email claim"))
+	})
+})
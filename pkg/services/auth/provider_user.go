@@ -0,0 +1,176 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
+)
+
+// DefaultGitLabAPIURL is the address of the GitLab API the users of GitLab tokens are looked up in
+const DefaultGitLabAPIURL = "https://gitlab.com/api/v4"
+
+var (
+	// ErrUnsupportedProvider is returned when the users of a git provider cannot be looked up
+	ErrUnsupportedProvider = errors.New("unsupported git provider")
+	// ErrNoProviderUser is returned when the token is not allowed to read its user
+	ErrNoProviderUser = errors.New("the token does not identify a user")
+)
+
+// ProviderUserClient looks up the user a git provider token was issued to.
+//counterfeiter:generate . ProviderUserClient
+type ProviderUserClient interface {
+	// GetUser returns the login of the user of the token, with the organizations and teams
+	// or the groups the user belongs to as groups
+	GetUser(ctx context.Context, providerName gitproviders.GitProviderName, token string) (*User, error)
+}
+
+// ProviderUsers looks up the users of GitHub and GitLab tokens.
+// Only the memberships the scopes of the token allow to read are returned as groups.
+type ProviderUsers struct {
+	HTTPClient *http.Client
+	// GitHubAPIURL defaults to DefaultGitHubAPIURL
+	GitHubAPIURL string
+	// GitLabAPIURL defaults to DefaultGitLabAPIURL
+	GitLabAPIURL string
+}
+
+func (p *ProviderUsers) GetUser(ctx context.Context, providerName gitproviders.GitProviderName, token string) (*User, error) {
+	switch providerName {
+	case gitproviders.GitProviderGitHub:
+		return p.githubUser(ctx, token)
+	case gitproviders.GitProviderGitLab:
+		return p.gitlabUser(ctx, token)
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrUnsupportedProvider, providerName)
+}
+
+func (p *ProviderUsers) githubUser(ctx context.Context, token string) (*User, error) {
+	apiURL := p.GitHubAPIURL
+	if apiURL == "" {
+		apiURL = DefaultGitHubAPIURL
+	}
+
+	auth := "token " + token
+
+	var user struct {
+		Login string `json:"login"`
+	}
+
+	if err := p.get(ctx, apiURL+"/user", auth, &user); err != nil {
+		return nil, fmt.Errorf("could not get github user: %w", err)
+	}
+
+	if user.Login == "" {
+		return nil, ErrNoProviderUser
+	}
+
+	var orgs []struct {
+		Login string `json:"login"`
+	}
+
+	if err := p.get(ctx, apiURL+"/user/orgs?per_page=100", auth, &orgs); err != nil {
+		return nil, fmt.Errorf("could not get github organizations: %w", err)
+	}
+
+	var teams []struct {
+		Slug         string `json:"slug"`
+		Organization struct {
+			Login string `json:"login"`
+		} `json:"organization"`
+	}
+
+	if err := p.get(ctx, apiURL+"/user/teams?per_page=100", auth, &teams); err != nil {
+		return nil, fmt.Errorf("could not get github teams: %w", err)
+	}
+
+	groups := []string{}
+	for _, o := range orgs {
+		groups = append(groups, o.Login)
+	}
+
+	for _, t := range teams {
+		groups = append(groups, t.Organization.Login+"/"+t.Slug)
+	}
+
+	return &User{Name: user.Login, Groups: groups}, nil
+}
+
+func (p *ProviderUsers) gitlabUser(ctx context.Context, token string) (*User, error) {
+	apiURL := p.GitLabAPIURL
+	if apiURL == "" {
+		apiURL = DefaultGitLabAPIURL
+	}
+
+	auth := "Bearer " + token
+
+	var user struct {
+		Username string `json:"username"`
+	}
+
+	if err := p.get(ctx, apiURL+"/user", auth, &user); err != nil {
+		return nil, fmt.Errorf("could not get gitlab user: %w", err)
+	}
+
+	if user.Username == "" {
+		return nil, ErrNoProviderUser
+	}
+
+	var groups []struct {
+		FullPath string `json:"full_path"`
+	}
+
+	if err := p.get(ctx, apiURL+"/groups?min_access_level=10&per_page=100", auth, &groups); err != nil {
+		return nil, fmt.Errorf("could not get gitlab groups: %w", err)
+	}
+
+	names := []string{}
+	for _, g := range groups {
+		names = append(names, g.FullPath)
+	}
+
+	return &User{Name: user.Username, Groups: names}, nil
+}
+
+// get decodes the JSON response of a GET request. Forbidden responses, returned when the scopes of the token
+// do not allow the request, leave the value as it is.
+func (p *ProviderUsers) get(ctx context.Context, url, auth string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", auth)
+	req.Header.Set("Accept", "application/json")
+
+	client := p.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusForbidden:
+		return nil
+	case http.StatusUnauthorized:
+		return ErrUnauthorizedToken
+	default:
+		return fmt.Errorf("request failed with status code: %v", res.StatusCode)
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(v); err != nil {
+		return fmt.Errorf("could not decode response: %w", err)
+	}
+
+	return nil
+}
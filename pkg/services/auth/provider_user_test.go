@@ -0,0 +1,80 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
+)
+
+var _ = Describe("ProviderUsers", func() {
+	var (
+		ts        *httptest.Server
+		responses map[string]string
+		users     *ProviderUsers
+	)
+
+	BeforeEach(func() {
+		responses = map[string]string{
+			"/user":          `{"login": "octocat"}`,
+			"/user/orgs":     `[{"login": "acme"}]`,
+			"/user/teams":    `[{"slug": "platform", "organization": {"login": "acme"}}]`,
+			"/api/v4/user":   `{"username": "tanuki"}`,
+			"/api/v4/groups": `[{"full_path": "acme"}, {"full_path": "acme/platform"}]`,
+		}
+
+		ts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "token gh-token" && r.Header.Get("Authorization") != "Bearer gl-token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			body, ok := responses[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+
+			_, _ = w.Write([]byte(body))
+		}))
+
+		users = &ProviderUsers{HTTPClient: ts.Client(), GitHubAPIURL: ts.URL, GitLabAPIURL: ts.URL + "/api/v4"}
+	})
+
+	AfterEach(func() {
+		ts.Close()
+	})
+
+	It("returns the organizations and teams of github users as groups", func() {
+		user, err := users.GetUser(context.Background(), gitproviders.GitProviderGitHub, "gh-token")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(user).To(Equal(&User{Name: "octocat", Groups: []string{"acme", "acme/platform"}}))
+	})
+
+	It("returns the groups of gitlab users", func() {
+		user, err := users.GetUser(context.Background(), gitproviders.GitProviderGitLab, "gl-token")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(user).To(Equal(&User{Name: "tanuki", Groups: []string{"acme", "acme/platform"}}))
+	})
+
+	It("leaves out the memberships the token may not read", func() {
+		delete(responses, "/user/teams")
+
+		user, err := users.GetUser(context.Background(), gitproviders.GitProviderGitHub, "gh-token")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(user.Groups).To(Equal([]string{"acme"}))
+	})
+
+	It("rejects invalid tokens", func() {
+		_, err := users.GetUser(context.Background(), gitproviders.GitProviderGitHub, "invalid")
+		Expect(err).To(MatchError(ErrUnauthorizedToken))
+	})
+
+	It("rejects tokens of other providers", func() {
+		_, err := users.GetUser(context.Background(), gitproviders.GitProviderGitea, "gh-token")
+		Expect(err).To(MatchError(ErrUnsupportedProvider))
+	})
+})
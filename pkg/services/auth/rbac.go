@@ -0,0 +1,65 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// User is the identity of the caller of the gitops-server
+type User struct {
+	Name   string
+	Groups []string
+	// Provider is the git provider the user logged in with, empty for the users of an OIDC provider
+	Provider gitproviders.GitProviderName
+}
+
+// Authorizer decides whether a user may act on kubernetes resources.
+//counterfeiter:generate . Authorizer
+type Authorizer interface {
+	// Authorize reports whether the user is allowed to perform the action described by the attributes
+	// in the cluster the client talks to
+	Authorize(ctx context.Context, kubeClient client.Client, user *User, attrs authorizationv1.ResourceAttributes) (bool, error)
+}
+
+// NewSubjectAccessReviewAuthorizer creates an Authorizer asking the API server through SubjectAccessReviews.
+// The prefixes are prepended to the name and the groups of the OIDC users, the way the API server does.
+// The name and the groups of git provider users are prefixed with the name of their provider, e.g. "github:".
+func NewSubjectAccessReviewAuthorizer(usernamePrefix, groupsPrefix string) Authorizer {
+	return &subjectAccessReviewAuthorizer{usernamePrefix: usernamePrefix, groupsPrefix: groupsPrefix}
+}
+
+type subjectAccessReviewAuthorizer struct {
+	usernamePrefix string
+	groupsPrefix   string
+}
+
+func (a *subjectAccessReviewAuthorizer) Authorize(ctx context.Context, kubeClient client.Client, user *User, attrs authorizationv1.ResourceAttributes) (bool, error) {
+	usernamePrefix, groupsPrefix := a.usernamePrefix, a.groupsPrefix
+	if user.Provider != "" {
+		usernamePrefix = string(user.Provider) + ":"
+		groupsPrefix = usernamePrefix
+	}
+
+	groups := []string{}
+	for _, g := range user.Groups {
+		groups = append(groups, groupsPrefix+g)
+	}
+
+	review := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:               usernamePrefix + user.Name,
+			Groups:             groups,
+			ResourceAttributes: &attrs,
+		},
+	}
+
+	if err := kubeClient.Create(ctx, review); err != nil {
+		return false, fmt.Errorf("could not review the access of user %q: %w", user.Name, err)
+	}
+
+	return review.Status.Allowed, nil
+}
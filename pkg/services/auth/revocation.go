@@ -0,0 +1,203 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// RevocationList holds the IDs of the tokens revoked before they expire.
+//counterfeiter:generate . RevocationList
+type RevocationList interface {
+	// Revoke rejects the token with the ID until it expires
+	Revoke(id string, expiresAt time.Time) error
+	// RevokeOnce revokes the token with the ID, failing with ErrAlreadyRevoked when it was revoked before.
+	// Single-use tokens are checked and revoked at once, so only one replica of the server accepts them.
+	RevokeOnce(id string, expiresAt time.Time) error
+	IsRevoked(id string) (bool, error)
+}
+
+// ErrAlreadyRevoked is returned by RevokeOnce when the token was revoked before
+var ErrAlreadyRevoked = errors.New("token already revoked")
+
+// revocationCacheTTL is how long a Secret revocation list trusts its copy of the Secret in IsRevoked
+var revocationCacheTTL = 10 * time.Second
+
+// NewMemoryRevocationList returns a revocation list only known to the current process
+func NewMemoryRevocationList() RevocationList {
+	return &memoryRevocationList{revoked: map[string]time.Time{}}
+}
+
+type memoryRevocationList struct {
+	mu      sync.Mutex
+	revoked map[string]time.Time
+}
+
+func (l *memoryRevocationList) Revoke(id string, expiresAt time.Time) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.revoked[id] = expiresAt
+	pruneRevocations(l.revoked)
+
+	return nil
+}
+
+func (l *memoryRevocationList) RevokeOnce(id string, expiresAt time.Time) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, ok := l.revoked[id]; ok {
+		return ErrAlreadyRevoked
+	}
+
+	l.revoked[id] = expiresAt
+	pruneRevocations(l.revoked)
+
+	return nil
+}
+
+func (l *memoryRevocationList) IsRevoked(id string) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	_, ok := l.revoked[id]
+
+	return ok, nil
+}
+
+// NewSecretRevocationList returns a revocation list stored in the named Secret, shared by every replica of the server.
+// The Secret maps the IDs of the revoked tokens to their expiry, the entries are dropped once the tokens expire.
+func NewSecretRevocationList(kubeClient client.Client, secretName types.NamespacedName) RevocationList {
+	return &secretRevocationList{kubeClient: kubeClient, secretName: secretName}
+}
+
+type secretRevocationList struct {
+	kubeClient client.Client
+	secretName types.NamespacedName
+
+	mu       sync.Mutex
+	revoked  map[string]time.Time
+	loadedAt time.Time
+}
+
+func (l *secretRevocationList) Revoke(id string, expiresAt time.Time) error {
+	return l.revoke(id, expiresAt, false)
+}
+
+func (l *secretRevocationList) RevokeOnce(id string, expiresAt time.Time) error {
+	return l.revoke(id, expiresAt, true)
+}
+
+// revoke reads the Secret, bypassing the copy IsRevoked trusts, and adds the token to it.
+// The update fails on a conflict when another replica changed the Secret in between, it is then retried
+// with the new content of the Secret.
+func (l *secretRevocationList) revoke(id string, expiresAt time.Time, once bool) error {
+	ctx := context.Background()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return retry.OnError(retry.DefaultRetry, isWriteConflict, func() error {
+		secret := &corev1.Secret{}
+
+		err := l.kubeClient.Get(ctx, l.secretName, secret)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("could not get revocations secret: %w", err)
+		}
+
+		exists := err == nil
+
+		revoked := revocations(secret.Data)
+		if _, ok := revoked[id]; ok && once {
+			l.revoked = revoked
+			l.loadedAt = time.Now()
+
+			return ErrAlreadyRevoked
+		}
+
+		revoked[id] = expiresAt
+		pruneRevocations(revoked)
+
+		secret.Data = map[string][]byte{}
+		for revokedID, exp := range revoked {
+			secret.Data[revokedID] = []byte(strconv.FormatInt(exp.Unix(), 10))
+		}
+
+		if exists {
+			err = l.kubeClient.Update(ctx, secret)
+		} else {
+			secret.ObjectMeta = metav1.ObjectMeta{Name: l.secretName.Name, Namespace: l.secretName.Namespace}
+			err = l.kubeClient.Create(ctx, secret)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		l.revoked = revoked
+		l.loadedAt = time.Now()
+
+		return nil
+	})
+}
+
+// isWriteConflict reports whether another replica wrote the Secret first, be it by updating or creating it
+func isWriteConflict(err error) bool {
+	return apierrors.IsConflict(err) || apierrors.IsAlreadyExists(err)
+}
+
+func (l *secretRevocationList) IsRevoked(id string) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if time.Since(l.loadedAt) > revocationCacheTTL {
+		secret := &corev1.Secret{}
+
+		err := l.kubeClient.Get(context.Background(), l.secretName, secret)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return false, fmt.Errorf("could not get revocations secret: %w", err)
+		}
+
+		l.revoked = revocations(secret.Data)
+		l.loadedAt = time.Now()
+	}
+
+	_, ok := l.revoked[id]
+
+	return ok, nil
+}
+
+func revocations(data map[string][]byte) map[string]time.Time {
+	revoked := map[string]time.Time{}
+
+	for id, exp := range data {
+		unix, err := strconv.ParseInt(string(exp), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		revoked[id] = time.Unix(unix, 0)
+	}
+
+	return revoked
+}
+
+// pruneRevocations forgets the tokens which expired, they are rejected anyway
+func pruneRevocations(revoked map[string]time.Time) {
+	for id, exp := range revoked {
+		if time.Now().After(exp) {
+			delete(revoked, id)
+		}
+	}
+}
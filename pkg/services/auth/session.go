@@ -0,0 +1,37 @@
+package auth
+
+import (
+	"net/http"
+	"time"
+)
+
+const (
+	// SessionCookieName is the cookie holding the access token of the users logged in with a browser
+	SessionCookieName = "gitops_session"
+	// RefreshCookieName is the cookie holding the refresh token of the users logged in with a browser
+	RefreshCookieName = "gitops_refresh"
+)
+
+// SetSessionCookies stores the tokens of a browser session in cookies.
+// The cookies last as long as the refresh token, the access token is refreshed when it expires.
+func SetSessionCookies(w http.ResponseWriter, r *http.Request, accessToken, refreshToken string) {
+	expires := time.Now().Add(RefreshExpirationTime)
+
+	for name, value := range map[string]string{SessionCookieName: accessToken, RefreshCookieName: refreshToken} {
+		http.SetCookie(w, &http.Cookie{
+			Name:     name,
+			Value:    value,
+			Path:     "/",
+			Expires:  expires,
+			HttpOnly: true,
+			Secure:   r.TLS != nil,
+			SameSite: http.SameSiteLaxMode,
+		})
+	}
+}
+
+// ClearSessionCookies removes the cookies of a browser session
+func ClearSessionCookies(w http.ResponseWriter) {
+	http.SetCookie(w, &http.Cookie{Name: SessionCookieName, Path: "/", MaxAge: -1})
+	http.SetCookie(w, &http.Cookie{Name: RefreshCookieName, Path: "/", MaxAge: -1})
+}
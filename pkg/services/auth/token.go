@@ -0,0 +1,85 @@
+package auth
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// personalAccessTokenFlow describes how to get a personal access token from a self-hosted git provider.
+// Weave GitOps has no OAuth application registered on those servers, so the user creates the token
+// in the web interface of the provider and pastes it in the terminal.
+type personalAccessTokenFlow struct {
+	displayName  string
+	tokenPageURL string
+	permissions  string
+	// verifyURL is an endpoint that only answers 200 to authenticated requests
+	verifyURL  string
+	authScheme string
+}
+
+// NewBitbucketServerTokenFlowHandler returns a function which asks for a Bitbucket Server personal access token.
+func NewBitbucketServerTokenFlowHandler(client *http.Client, hostname string, in io.Reader) BlockingCLIAuthHandler {
+	return newPersonalAccessTokenFlowHandler(client, in, personalAccessTokenFlow{
+		displayName:  "Bitbucket Server",
+		tokenPageURL: fmt.Sprintf("https://%s/plugins/servlet/access-tokens/manage", hostname),
+		permissions:  "project read and repository admin",
+		verifyURL:    fmt.Sprintf("https://%s/rest/api/1.0/dashboard/pull-requests?limit=1", hostname),
+		authScheme:   "Bearer",
+	})
+}
+
+// NewGiteaTokenFlowHandler returns a function which asks for a Gitea access token.
+func NewGiteaTokenFlowHandler(client *http.Client, hostname string, in io.Reader) BlockingCLIAuthHandler {
+	return newPersonalAccessTokenFlowHandler(client, in, personalAccessTokenFlow{
+		displayName:  "Gitea",
+		tokenPageURL: fmt.Sprintf("https://%s/user/settings/applications", hostname),
+		permissions:  "repository and organization read/write",
+		verifyURL:    fmt.Sprintf("https://%s/api/v1/user", hostname),
+		authScheme:   "token",
+	})
+}
+
+func newPersonalAccessTokenFlowHandler(client *http.Client, in io.Reader, flow personalAccessTokenFlow) BlockingCLIAuthHandler {
+	return func(ctx context.Context, w io.Writer) (string, error) {
+		fmt.Fprintln(w)
+		fmt.Fprintf(w, "Create a %s personal access token with %s permissions at:\n\n", flow.displayName, flow.permissions)
+		fmt.Fprintf(w, "%s\n\n", flow.tokenPageURL)
+		fmt.Fprintf(w, "Paste the token here and press enter: ")
+
+		line, err := bufio.NewReader(in).ReadString('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return "", fmt.Errorf("could not read token: %w", err)
+		}
+
+		token := strings.TrimSpace(line)
+		if token == "" {
+			return "", errors.New("no token provided")
+		}
+
+		if err := verifyPersonalAccessToken(ctx, client, flow, token); err != nil {
+			return "", fmt.Errorf("could not verify %s token: %w", flow.displayName, err)
+		}
+
+		fmt.Fprintf(w, "\n\n")
+
+		return token, nil
+	}
+}
+
+func verifyPersonalAccessToken(ctx context.Context, client *http.Client, flow personalAccessTokenFlow, token string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, flow.verifyURL, nil)
+	if err != nil {
+		return fmt.Errorf("could not create verification request: %w", err)
+	}
+
+	req.Header.Set("Authorization", flow.authScheme+" "+token)
+
+	_, err = doRequest(req, client)
+
+	return err
+}
@@ -0,0 +1,87 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Personal access token flow", func() {
+	var ts *httptest.Server
+	var client *http.Client
+	var requests []*http.Request
+
+	validToken := "sUpErSecRetToKeN"
+
+	BeforeEach(func() {
+		requests = []*http.Request{}
+		ts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r)
+
+			if r.Header.Get("Authorization") != "Bearer "+validToken && r.Header.Get("Authorization") != "token "+validToken {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte("{}"))
+		}))
+
+		client = ts.Client()
+		client.Transport = &testServerTransport{testServeUrl: ts.URL, roundTripper: client.Transport}
+	})
+
+	AfterEach(func() {
+		ts.Close()
+	})
+
+	It("verifies a Bitbucket Server token", func() {
+		var output bytes.Buffer
+
+		authHandler := NewBitbucketServerTokenFlowHandler(client, "bitbucket.example.com", strings.NewReader(validToken+"\n"))
+
+		token, err := authHandler(context.Background(), &output)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(token).To(Equal(validToken))
+
+		Expect(output.String()).To(ContainSubstring("https://bitbucket.example.com/plugins/servlet/access-tokens/manage"))
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].URL.Path).To(Equal("/rest/api/1.0/dashboard/pull-requests"))
+		Expect(requests[0].Header.Get("Authorization")).To(Equal("Bearer " + validToken))
+	})
+
+	It("verifies a Gitea token", func() {
+		var output bytes.Buffer
+
+		authHandler := NewGiteaTokenFlowHandler(client, "gitea.example.com", strings.NewReader("  "+validToken))
+
+		token, err := authHandler(context.Background(), &output)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(token).To(Equal(validToken))
+
+		Expect(output.String()).To(ContainSubstring("https://gitea.example.com/user/settings/applications"))
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].URL.Path).To(Equal("/api/v1/user"))
+		Expect(requests[0].Header.Get("Authorization")).To(Equal("token " + validToken))
+	})
+
+	It("rejects a token refused by the server", func() {
+		authHandler := NewGiteaTokenFlowHandler(client, "gitea.example.com", strings.NewReader("wrong\n"))
+
+		_, err := authHandler(context.Background(), &bytes.Buffer{})
+		Expect(err).To(MatchError(ContainSubstring("could not verify Gitea token")))
+	})
+
+	It("fails when no token is entered", func() {
+		authHandler := NewBitbucketServerTokenFlowHandler(client, "bitbucket.example.com", strings.NewReader("\n"))
+
+		_, err := authHandler(context.Background(), &bytes.Buffer{})
+		Expect(err).To(MatchError("no token provided"))
+		Expect(requests).To(BeEmpty())
+	})
+})
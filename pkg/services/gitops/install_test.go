@@ -80,7 +80,13 @@ var _ = Describe("Install", func() {
 		Expect(service).To(ContainSubstring("kind: Service"))
 		Expect(namespace).To(Equal("wego-system"))
 
-		_, deployment, namespace := kubeClient.ApplyArgsForCall(5)
+		_, clusterRole, _ := kubeClient.ApplyArgsForCall(5)
+		Expect(clusterRole).To(ContainSubstring("kind: ClusterRole"))
+
+		_, clusterRoleBinding, _ := kubeClient.ApplyArgsForCall(6)
+		Expect(clusterRoleBinding).To(ContainSubstring("kind: ClusterRoleBinding"))
+
+		_, deployment, namespace := kubeClient.ApplyArgsForCall(7)
 		Expect(deployment).To(ContainSubstring("kind: Deployment"))
 		Expect(namespace).To(Equal("wego-system"))
 
@@ -25,6 +25,12 @@ export enum SourceType {
   Helm = "Helm",
 }
 
+export enum WatchApplicationsResponseEventType {
+  Added = "Added",
+  Modified = "Modified",
+  Deleted = "Deleted",
+}
+
 export type Condition = {
   type?: string
   status?: string
@@ -45,6 +51,9 @@ export type Application = {
   kustomization?: Kustomization
   helmRelease?: HelmRelease
   source?: Source
+  conditions?: Condition[]
+  lastAppliedRevision?: string
+  clusterName?: string
 }
 
 export type Kustomization = {
@@ -93,25 +102,61 @@ export type AuthenticateRequest = {
 
 export type AuthenticateResponse = {
   token?: string
+  refreshToken?: string
+}
+
+export type RefreshTokenRequest = {
+  refreshToken?: string
+}
+
+export type RefreshTokenResponse = {
+  accessToken?: string
+  refreshToken?: string
+}
+
+export type LogoutRequest = {
+  refreshToken?: string
+}
+
+export type LogoutResponse = {
 }
 
 export type ListApplicationsRequest = {
   namespace?: string
+  clusterName?: string
 }
 
 export type ListApplicationsResponse = {
   applications?: Application[]
+  clusterErrors?: ClusterError[]
+}
+
+export type ClusterError = {
+  clusterName?: string
+  message?: string
 }
 
 export type GetApplicationRequest = {
   name?: string
   namespace?: string
+  clusterName?: string
 }
 
 export type GetApplicationResponse = {
   application?: Application
 }
 
+export type WatchApplicationsRequest = {
+  namespace?: string
+  clusterName?: string
+}
+
+export type WatchApplicationsResponse = {
+  type?: WatchApplicationsResponseEventType
+  kind?: string
+  application?: Application
+}
+
 export type Commit = {
   hash?: string
   date?: string
@@ -125,6 +170,7 @@ type BaseListCommitsRequest = {
   name?: string
   namespace?: string
   pageSize?: number
+  clusterName?: string
 }
 
 export type ListCommitsRequest = BaseListCommitsRequest
@@ -135,6 +181,102 @@ export type ListCommitsResponse = {
   nextPageToken?: number
 }
 
+export type ListEventsRequest = {
+  name?: string
+  namespace?: string
+  type?: string
+  since?: string
+  until?: string
+  clusterName?: string
+}
+
+export type Event = {
+  type?: string
+  reason?: string
+  message?: string
+  timestamp?: string
+  count?: number
+  objectKind?: string
+  objectName?: string
+  objectNamespace?: string
+  source?: string
+}
+
+export type ListEventsResponse = {
+  events?: Event[]
+}
+
+export type SyncApplicationRequest = {
+  name?: string
+  namespace?: string
+  wait?: boolean
+  timeout?: number
+  clusterName?: string
+}
+
+export type SyncApplicationResponse = {
+  previousSourceRevision?: string
+  sourceRevision?: string
+  previousAppliedRevision?: string
+  appliedRevision?: string
+}
+
+export type DiffApplicationRequest = {
+  name?: string
+  namespace?: string
+  clusterName?: string
+}
+
+export type ObjectDiff = {
+  groupVersionKind?: GroupVersionKind
+  name?: string
+  namespace?: string
+  status?: string
+  diff?: string
+}
+
+export type DriftSummary = {
+  inSync?: number
+  modified?: number
+  missing?: number
+  extra?: number
+}
+
+export type DiffApplicationResponse = {
+  objects?: ObjectDiff[]
+  summary?: DriftSummary
+}
+
+export type PauseApplicationsRequest = {
+  namespace?: string
+  all?: boolean
+  labelSelector?: string
+  reason?: string
+  clusterName?: string
+}
+
+export type PauseResult = {
+  name?: string
+  namespace?: string
+  changed?: boolean
+  error?: string
+}
+
+export type PauseApplicationsResponse = {
+  results?: PauseResult[]
+}
+
+export type UnpauseApplicationsRequest = {
+  namespace?: string
+  all?: boolean
+  labelSelector?: string
+  clusterName?: string
+}
+
+export type UnpauseApplicationsResponse = {
+  results?: PauseResult[]
+}
+
 export type GroupVersionKind = {
   group?: string
   kind?: string
@@ -154,6 +296,7 @@ export type GetReconciledObjectsReq = {
   automationNamespace?: string
   automationKind?: AutomationKind
   kinds?: GroupVersionKind[]
+  clusterName?: string
 }
 
 export type GetReconciledObjectsRes = {
@@ -163,6 +306,7 @@ export type GetReconciledObjectsRes = {
 export type GetChildObjectsReq = {
   groupVersionKind?: GroupVersionKind
   parentUid?: string
+  clusterName?: string
 }
 
 export type GetChildObjectsRes = {
@@ -186,21 +330,46 @@ export type GetGithubAuthStatusRequest = {
 export type GetGithubAuthStatusResponse = {
   accessToken?: string
   error?: string
+  refreshToken?: string
 }
 
 export class Applications {
   static Authenticate(req: AuthenticateRequest, initReq?: fm.InitReq): Promise<AuthenticateResponse> {
     return fm.fetchReq<AuthenticateRequest, AuthenticateResponse>(`/v1/authenticate/${req["providerName"]}`, {...initReq, method: "POST", body: JSON.stringify(req)})
   }
+  static RefreshToken(req: RefreshTokenRequest, initReq?: fm.InitReq): Promise<RefreshTokenResponse> {
+    return fm.fetchReq<RefreshTokenRequest, RefreshTokenResponse>(`/v1/authenticate/refresh`, {...initReq, method: "POST", body: JSON.stringify(req)})
+  }
+  static Logout(req: LogoutRequest, initReq?: fm.InitReq): Promise<LogoutResponse> {
+    return fm.fetchReq<LogoutRequest, LogoutResponse>(`/v1/logout`, {...initReq, method: "POST", body: JSON.stringify(req)})
+  }
   static ListApplications(req: ListApplicationsRequest, initReq?: fm.InitReq): Promise<ListApplicationsResponse> {
     return fm.fetchReq<ListApplicationsRequest, ListApplicationsResponse>(`/v1/applications?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
   }
   static GetApplication(req: GetApplicationRequest, initReq?: fm.InitReq): Promise<GetApplicationResponse> {
     return fm.fetchReq<GetApplicationRequest, GetApplicationResponse>(`/v1/applications/${req["name"]}?${fm.renderURLSearchParams(req, ["name"])}`, {...initReq, method: "GET"})
   }
+  static WatchApplications(req: WatchApplicationsRequest, entityNotifier?: fm.NotifyStreamEntityArrival<WatchApplicationsResponse>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<WatchApplicationsRequest, WatchApplicationsResponse>(`/v1/watch/applications?${fm.renderURLSearchParams(req, [])}`, entityNotifier, {...initReq, method: "GET"})
+  }
   static ListCommits(req: ListCommitsRequest, initReq?: fm.InitReq): Promise<ListCommitsResponse> {
     return fm.fetchReq<ListCommitsRequest, ListCommitsResponse>(`/v1/applications/${req["name"]}/commits?${fm.renderURLSearchParams(req, ["name"])}`, {...initReq, method: "GET"})
   }
+  static ListEvents(req: ListEventsRequest, initReq?: fm.InitReq): Promise<ListEventsResponse> {
+    return fm.fetchReq<ListEventsRequest, ListEventsResponse>(`/v1/applications/${req["name"]}/events?${fm.renderURLSearchParams(req, ["name"])}`, {...initReq, method: "GET"})
+  }
+  static SyncApplication(req: SyncApplicationRequest, initReq?: fm.InitReq): Promise<SyncApplicationResponse> {
+    return fm.fetchReq<SyncApplicationRequest, SyncApplicationResponse>(`/v1/applications/${req["name"]}/sync`, {...initReq, method: "POST", body: JSON.stringify(req)})
+  }
+  static DiffApplication(req: DiffApplicationRequest, initReq?: fm.InitReq): Promise<DiffApplicationResponse> {
+    return fm.fetchReq<DiffApplicationRequest, DiffApplicationResponse>(`/v1/applications/${req["name"]}/diff?${fm.renderURLSearchParams(req, ["name"])}`, {...initReq, method: "GET"})
+  }
+  static PauseApplications(req: PauseApplicationsRequest, initReq?: fm.InitReq): Promise<PauseApplicationsResponse> {
+    return fm.fetchReq<PauseApplicationsRequest, PauseApplicationsResponse>(`/v1/applications/pause`, {...initReq, method: "POST", body: JSON.stringify(req)})
+  }
+  static UnpauseApplications(req: UnpauseApplicationsRequest, initReq?: fm.InitReq): Promise<UnpauseApplicationsResponse> {
+    return fm.fetchReq<UnpauseApplicationsRequest, UnpauseApplicationsResponse>(`/v1/applications/unpause`, {...initReq, method: "POST", body: JSON.stringify(req)})
+  }
   static GetReconciledObjects(req: GetReconciledObjectsReq, initReq?: fm.InitReq): Promise<GetReconciledObjectsRes> {
     return fm.fetchReq<GetReconciledObjectsReq, GetReconciledObjectsRes>(`/v1/applications/${req["automationName"]}/reconciled_objects`, {...initReq, method: "POST", body: JSON.stringify(req)})
   }
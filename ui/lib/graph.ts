@@ -76,6 +76,7 @@ export const getChildren = async (
   const { objects } = await appsClient.GetReconciledObjects({
     automationName: app.name,
     automationNamespace: app.namespace,
+    automationKind: app.deploymentType,
     kinds,
   });
 